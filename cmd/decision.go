@@ -7,15 +7,38 @@ import (
 )
 
 func init() {
+	tagCmd := cmd.NewTagCommand(interactor.NewTagDecisionInteractor(decisionSvc, print.NewTagPresenter()), configSvc)
+	tagCmd.AddCommand(
+		cmd.NewTagMergeCommand(interactor.NewMergeTagsInteractor(decisionSvc, print.NewTagMergePresenter()), configSvc),
+		cmd.NewTagRenameCommand(interactor.NewRenameTagInteractor(decisionSvc, print.NewTagRenamePresenter()), configSvc),
+	)
+
 	rootCmd.AddCommand(
 		cmd.NewAddCommand(interactor.NewAddDecisionsInteractor(modelSvc, decisionSvc, print.NewAddPresenter()), configSvc),
+		cmd.NewArchiveCommand(interactor.NewArchiveDecisionInteractor(decisionSvc, print.NewArchivePresenter()), configSvc),
 		cmd.NewCommentCommand(interactor.NewCommentDecisionInteractor(decisionSvc, print.NewCommentPresenter()), configSvc),
 		cmd.NewDecideCommand(interactor.NewDecideInteractor(decisionSvc, print.NewDecidePresenter()), configSvc),
+		cmd.NewDueCommand(interactor.NewDueDecisionsInteractor(decisionSvc, print.NewDuePresenter()), configSvc),
 		cmd.NewEditCommand(interactor.NewEditDecisionInteractor(decisionSvc, print.NewEditPresenter()), configSvc),
+		cmd.NewGraphCommand(interactor.NewGraphDecisionsInteractor(decisionSvc, print.NewGraphPresenter()), configSvc),
 		cmd.NewLinkCommand(interactor.NewLinkDecisionsInteractor(decisionSvc, print.NewLinkPresenter()), configSvc),
 		cmd.NewListCommand(interactor.NewListDecisionsInteractor(decisionSvc, print.NewListPresenter()), configSvc),
+		cmd.NewMetadataCommand(interactor.NewMetadataDecisionInteractor(decisionSvc, print.NewMetadataPresenter()), configSvc),
+		cmd.NewNextCommand(interactor.NewNextDecisionsInteractor(decisionSvc, print.NewNextPresenter()), configSvc),
+		cmd.NewPlanCommand(interactor.NewPlanDecisionsInteractor(decisionSvc, print.NewPlanPresenter()), configSvc),
 		cmd.NewPrintCommand(interactor.NewPrintDecisionsInteractor(decisionSvc, print.NewPrintPresenter(configSvc)), configSvc),
+		cmd.NewRemoveCommand(interactor.NewRemoveDecisionInteractor(decisionSvc, print.NewRemovePresenter()), configSvc),
+		cmd.NewRenameCommand(interactor.NewRenameDecisionInteractor(decisionSvc, print.NewRenamePresenter()), configSvc),
+		cmd.NewReopenCommand(interactor.NewReopenDecisionInteractor(decisionSvc, print.NewReopenPresenter()), configSvc),
+		cmd.NewReviewCommand(interactor.NewReviewDecisionInteractor(decisionSvc, print.NewReviewPresenter()), configSvc),
 		cmd.NewReviseCommand(interactor.NewReviseDecisionInteractor(decisionSvc, print.NewRevisePresenter()), configSvc),
-		cmd.NewTagCommand(interactor.NewTagDecisionInteractor(decisionSvc, print.NewTagPresenter()), configSvc),
+		cmd.NewScoreCommand(interactor.NewScoreDecisionInteractor(decisionSvc, print.NewScorePresenter()), configSvc),
+		cmd.NewSearchCommand(interactor.NewSearchDecisionsInteractor(decisionSvc, print.NewSearchPresenter()), configSvc),
+		cmd.NewSetFieldCommand(interactor.NewSetFieldDecisionInteractor(decisionSvc, print.NewSetFieldPresenter()), configSvc),
+		cmd.NewStatusCommand(interactor.NewStatusDecisionInteractor(decisionSvc, print.NewStatusPresenter()), configSvc),
+		cmd.NewSupersedeCommand(interactor.NewSupersedeDecisionInteractor(decisionSvc, print.NewSupersedePresenter()), configSvc),
+		tagCmd,
+		cmd.NewUnlinkCommand(interactor.NewUnlinkDecisionsInteractor(decisionSvc, print.NewUnlinkPresenter()), configSvc),
+		cmd.NewUntagCommand(interactor.NewUntagDecisionInteractor(decisionSvc, print.NewUntagPresenter()), configSvc),
 	)
 }
@@ -9,10 +9,13 @@ import (
 func init() {
 	rootCmd.AddCommand(
 		cmd.NewCopyCommand(interactor.NewCopyModelInteractor(modelSvc, decisionSvc, print.NewCopyPresenter()), configSvc),
+		cmd.NewGitMergeDriverCommand(interactor.NewGitMergeDriverInteractor(modelSvc, print.NewGitMergeDriverPresenter()), configSvc),
 		cmd.NewImportCommand(interactor.NewImportModelInteractor(modelSvc, decisionSvc, print.NewImportPresenter()), configSvc),
 		cmd.NewInitCommand(interactor.NewInitModelInteractor(modelSvc, print.NewInitPresenter())),
 		cmd.NewMergeModelsCommand(interactor.NewMergeModelsInteractor(modelSvc, decisionSvc, print.NewMergePresenter())),
 		cmd.NewRebuildIndexCommand(interactor.NewRebuildIndexInteractor(modelSvc, print.NewRebuildIndexPresenter()), configSvc),
+		cmd.NewRenumberCommand(interactor.NewRenumberModelInteractor(modelSvc, decisionSvc, print.NewRenumberPresenter()), configSvc),
+		cmd.NewResolveCollisionsCommand(interactor.NewResolveCollisionsInteractor(modelSvc, decisionSvc, print.NewResolveCollisionsPresenter()), configSvc),
 		cmd.NewValidateCommand(interactor.NewModelValidateInteractor(modelSvc, print.NewModelValidatePresenter()), configSvc),
 	)
 }
@@ -2,6 +2,7 @@ package commands
 
 import (
 	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
 	"errors"
 	"fmt"
 	"regexp"
@@ -23,7 +24,8 @@ func ResolveIdOrTitle(idOrTitle string, id, title *string) error {
 		return fmt.Errorf("you must specify the decisions via --id by either providing the numbered id (e.g., 0001) or the name of the decision (e.g, 'my-decision')")
 	}
 
-	if matched, _ := regexp.MatchString(`^\d{4}$`, idOrTitle); matched {
+	// IDs such as SEC-0001 may look like titles, the interactors fall back to the title then
+	if decision.IsDecisionID(idOrTitle) {
 		*id = idOrTitle // dereference and assign
 		*title = ""     // clear title
 		return nil
@@ -35,7 +37,7 @@ func ResolveIdOrTitle(idOrTitle string, id, title *string) error {
 		return nil
 	}
 
-	return errors.New("input must be either a 4-digit ID or a title containing at least one letter")
+	return errors.New("input must be either a decision ID (e.g. 0001) or a title containing at least one letter")
 }
 
 func GetTemplateSections(template string) (map[string]string, error) {
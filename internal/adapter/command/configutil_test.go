@@ -42,6 +42,14 @@ func TestResolveIdOrTitle_ValidID(t *testing.T) {
 	assert.Empty(t, title)
 }
 
+func TestResolveIdOrTitle_PrefixedID(t *testing.T) {
+	var id, title string
+	err := ResolveIdOrTitle("SEC-0012", &id, &title)
+	assert.NoError(t, err)
+	assert.Equal(t, "SEC-0012", id)
+	assert.Empty(t, title)
+}
+
 func TestResolveIdOrTitle_ValidTitle(t *testing.T) {
 	var id, title string
 	err := ResolveIdOrTitle("my-decision", &id, &title)
@@ -61,7 +69,7 @@ func TestResolveIdOrTitle_InvalidFormat(t *testing.T) {
 	var id, title string
 	err := ResolveIdOrTitle("####", &id, &title)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "input must be either a 4-digit ID")
+	assert.Contains(t, err.Error(), "input must be either a decision ID")
 }
 
 func TestGetTemplateSections_Nygard(t *testing.T) {
@@ -0,0 +1,48 @@
+package decision
+
+import (
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewArchiveCommand(input inputport.DecisionArchive, config domain.ConfigService) *cobra.Command {
+	var modelPath, idOrTitle, id, title string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "archive",
+		Short: "Moves a decision to the model's archive folder",
+		Long: `Sets the status of a decision to 'archived' and moves it (and its rule file, if any)
+to the 'archive' folder of the model. The decision is removed from the index and all links
+of other decisions pointing to it are deleted. Its ID is not reused for new decisions.
+
+A decision that supersedes other decisions is only archived with --force. The superseded
+decisions keep their status, their notice names the archived decision without linking to it.
+
+Examples:
+  adg archive --id 0003
+  adg archive --id my-decision`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := util.ResolveIdOrTitle(idOrTitle, &id, &title)
+			if err != nil {
+				return err
+			}
+
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			return input.Archive(modelPath, id, title, force)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or title of the decision to archive (e.g. 0001, 'my-decision')")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Archive the decision even if it supersedes other decisions")
+
+	return cmd
+}
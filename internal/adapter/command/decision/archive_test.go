@@ -0,0 +1,51 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewArchiveCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionArchive)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Archive", "resolvedPath", "0003", "", false).Return(nil)
+
+	cmd := NewArchiveCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0003"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewArchiveCommand_ByTitle(t *testing.T) {
+	mockInput := new(in_mocks.DecisionArchive)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockInput.On("Archive", "custom", "", "my-decision", false).Return(nil)
+
+	cmd := NewArchiveCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--model", "custom", "--id", "my-decision"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewArchiveCommand_MissingID(t *testing.T) {
+	mockInput := new(in_mocks.DecisionArchive)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewArchiveCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "you must specify the decisions via --id")
+}
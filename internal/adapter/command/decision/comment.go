@@ -12,25 +12,40 @@ import (
 
 func NewCommentCommand(input inputport.DecisionComment, config domain.ConfigService) *cobra.Command {
 	var modelPath, idOrTitle, id, title, text, authorFlag string
+	var replyTo, editID, deleteID int
 
 	cmd := &cobra.Command{
 		Use:   "comment [comment-text...]",
 		Short: "Add a comment to a decision",
-		Long: `Adds a comment to the specified decision.
+		Long: `Adds a comment to the specified decision, or edits or deletes an existing one.
 
 You can provide the comment text either as positional arguments or via the --text flag.
+Comments are numbered; the numbers stay the same when other comments are deleted.
+--reply-to adds the comment as a reply to another comment. Deleting a comment keeps
+its replies, they are attached to the comment the deleted one replied to.
 
 Examples:
   adg comment --id 0001 This is my comment text
   adg comment --id 0001 --text "This is my comment text"
-  adg comment --id my-decision Great decision about architecture`,
+  adg comment --id my-decision Great decision about architecture
+  adg comment --id 0001 --reply-to 2 Agreed, see the benchmark
+  adg comment --id 0001 --edit 3 Corrected comment text
+  adg comment --id 0001 --delete 3`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// If no --text flag provided, use positional arguments
 			if text == "" && len(args) > 0 {
 				text = joinArgs(args)
 			}
 
-			if text == "" {
+			editing, deleting := cmd.Flags().Changed("edit"), cmd.Flags().Changed("delete")
+			replying := cmd.Flags().Changed("reply-to")
+			if (editing && deleting) || (replying && (editing || deleting)) {
+				return fmt.Errorf("only one of --reply-to, --edit and --delete can be used at a time")
+			}
+			if deleting && text != "" {
+				return fmt.Errorf("--delete does not take a comment text")
+			}
+			if !deleting && text == "" {
 				return fmt.Errorf("comment text must be provided (via arguments or --text flag)")
 			}
 
@@ -44,6 +59,13 @@ Examples:
 				return err
 			}
 
+			switch {
+			case deleting:
+				return input.DeleteComment(modelPath, id, title, deleteID)
+			case editing:
+				return input.EditComment(modelPath, id, title, editID, text)
+			}
+
 			author := authorFlag
 			if author == "" {
 				author = config.GetAuthor()
@@ -52,7 +74,7 @@ Examples:
 				return fmt.Errorf("author must be provided using --author or set in config")
 			}
 
-			return input.Comment(modelPath, id, title, author, text)
+			return input.Comment(modelPath, id, title, author, text, replyTo)
 		},
 	}
 
@@ -60,6 +82,9 @@ Examples:
 	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or title of the decision to comment on (e.g. 0001, 'my-decision')")
 	cmd.Flags().StringVar(&text, "text", "", "Text content of the comment (optional if using positional arguments)")
 	cmd.Flags().StringVar(&authorFlag, "author", "", "Name of the commenter (overrides config)")
+	cmd.Flags().IntVar(&replyTo, "reply-to", 0, "Number of the comment to reply to")
+	cmd.Flags().IntVar(&editID, "edit", 0, "Number of the comment to replace with the given text")
+	cmd.Flags().IntVar(&deleteID, "delete", 0, "Number of the comment to delete")
 
 	return cmd
 }
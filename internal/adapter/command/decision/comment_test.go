@@ -18,7 +18,7 @@ func TestNewCommentCommand_Success(t *testing.T) {
 	mockConfig.On("IsLoaded").Return(true)
 	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
 
-	mockInput.On("Comment", "resolvedPath", "0001", "", "auto-author", "Great decision").Return(nil)
+	mockInput.On("Comment", "resolvedPath", "0001", "", "auto-author", "Great decision", 0).Return(nil)
 
 	cmd := NewCommentCommand(mockInput, mockConfig)
 	cmd.SetArgs([]string{
@@ -52,7 +52,7 @@ func TestNewCommentCommand_WithPositionalArgs(t *testing.T) {
 	mockConfig.On("IsLoaded").Return(true)
 	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
 
-	mockInput.On("Comment", "resolvedPath", "0001", "", "auto-author", "This is my comment text").Return(nil)
+	mockInput.On("Comment", "resolvedPath", "0001", "", "auto-author", "This is my comment text", 0).Return(nil)
 
 	cmd := NewCommentCommand(mockInput, mockConfig)
 	cmd.SetArgs([]string{
@@ -72,7 +72,7 @@ func TestNewCommentCommand_FlagsOverrideArgs(t *testing.T) {
 	mockConfig.On("IsLoaded").Return(true)
 	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
 
-	mockInput.On("Comment", "resolvedPath", "0001", "", "auto-author", "Flag text").Return(nil)
+	mockInput.On("Comment", "resolvedPath", "0001", "", "auto-author", "Flag text", 0).Return(nil)
 
 	cmd := NewCommentCommand(mockInput, mockConfig)
 	// When --text is provided, positional args should be ignored
@@ -112,7 +112,7 @@ func TestNewCommentCommand_InputReturnsError(t *testing.T) {
 	mockConfig.On("IsLoaded").Return(true)
 	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
 
-	mockInput.On("Comment", "resolvedPath", "0001", "", "alice", "bad comment").Return(errors.New("failure"))
+	mockInput.On("Comment", "resolvedPath", "0001", "", "alice", "bad comment", 0).Return(errors.New("failure"))
 
 	cmd := NewCommentCommand(mockInput, mockConfig)
 	cmd.SetArgs([]string{
@@ -124,3 +124,54 @@ func TestNewCommentCommand_InputReturnsError(t *testing.T) {
 	err := cmd.Execute()
 	assert.EqualError(t, err, "failure")
 }
+
+func TestNewCommentCommand_Reply(t *testing.T) {
+	mockInput := new(in_mocks.DecisionComment)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("GetAuthor").Return("auto-author")
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Comment", "resolvedPath", "0001", "", "auto-author", "Agreed", 2).Return(nil)
+
+	cmd := NewCommentCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--reply-to", "2", "Agreed"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewCommentCommand_EditAndDelete(t *testing.T) {
+	mockInput := new(in_mocks.DecisionComment)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("EditComment", "resolvedPath", "0001", "", 3, "Fixed text").Return(nil)
+	mockInput.On("DeleteComment", "resolvedPath", "0001", "", 4).Return(nil)
+
+	cmd := NewCommentCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--edit", "3", "Fixed", "text"})
+	assert.NoError(t, cmd.Execute())
+
+	cmd = NewCommentCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--delete", "4"})
+	assert.NoError(t, cmd.Execute())
+
+	mockInput.AssertExpectations(t)
+	mockInput.AssertNotCalled(t, "Comment")
+}
+
+func TestNewCommentCommand_ConflictingFlags(t *testing.T) {
+	mockInput := new(in_mocks.DecisionComment)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewCommentCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--edit", "3", "--delete", "3"})
+	assert.EqualError(t, cmd.Execute(), "only one of --reply-to, --edit and --delete can be used at a time")
+
+	cmd = NewCommentCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--delete", "3", "some text"})
+	assert.EqualError(t, cmd.Execute(), "--delete does not take a comment text")
+}
@@ -10,15 +10,32 @@ import (
 )
 
 func NewDecideCommand(input inputport.DecisionDecide, config domain.ConfigService) *cobra.Command {
-	var modelPath, idOrTitle, id, title, option, reason, author string
-	var enforce bool
+	var modelPath, idOrTitle, id, title, reason, author, reviewBy string
+	var options []string
+	var enforce, recommend bool
 	var err error
 
 	cmd := &cobra.Command{
 		Use:   "decide",
-		Short: "Marks a decision as decided by selecting one of its options",
+		Short: "Marks a decision as decided by selecting one or more of its options",
 		Long: `Decide finalizes a decision by selecting a specific option and marking the decision as decided.
-You must provide --id to identify the decision.`,
+You must provide --id to identify the decision. Repeat --option to decide for a combination of
+options; the outcome then lists each chosen option. The numbers of the chosen options are stored
+as 'chosen_options' in the metadata.
+
+--review-by sets the date the decision is due for review (see 'adg review' and 'adg due').
+Without it the date is computed from the review_interval in model.yaml, if one is set.
+
+With --recommend the options are ranked by their weighted score in the decision matrix (see 'adg score').
+Without --option the ranking is only shown. With --option the chosen option is recorded and the
+recommended option is noted in the outcome.
+
+Examples:
+  adg decide --id 0002 --option 1 --rationale "cheapest option"
+  adg decide --id 0002 --option 1 --option 3 --rationale "caching and CDN complement each other"
+  adg decide --id 0002 --option 2 --review-by 2026-12-31
+  adg decide --id 0002 --recommend
+  adg decide --id 0002 --recommend --option 2`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			modelPath, err = util.ResolveModelPathOrDefault(modelPath, config)
 			if err != nil {
@@ -34,19 +51,21 @@ You must provide --id to identify the decision.`,
 				author = config.GetAuthor()
 			}
 
-			if option == "" {
+			if len(options) == 0 && !recommend {
 				return fmt.Errorf("--option must be provided (either its name or a positive integer (1-based index)")
 			}
 
-			return input.Decide(modelPath, id, title, option, reason, author, enforce)
+			return input.Decide(modelPath, id, title, options, reason, author, reviewBy, enforce, recommend)
 		},
 	}
 
 	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if configured)")
 	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or title of the decision to decide, e.g., 0001, 'my-decision'")
-	cmd.Flags().StringVar(&option, "option", "", "Name or the number of the option being selected, e.g., 'first-option' or '1' (required)")
+	cmd.Flags().StringArrayVar(&options, "option", nil, "Name or the number of the option being selected, e.g., 'first-option' or '1' (required, can be repeated)")
 	cmd.Flags().StringVar(&reason, "rationale", "", "Optional rationale or explanation for the selected option")
+	cmd.Flags().StringVar(&reviewBy, "review-by", "", "Date the decision is due for review (YYYY-MM-DD), defaults to the model's review interval")
 	cmd.Flags().StringVar(&author, "author", "", "Name of the person deciding (overrides config)")
+	cmd.Flags().BoolVar(&recommend, "recommend", false, "Rank the options by their weighted score and propose the best one")
 	cmd.Flags().BoolVarP(&enforce, "force", "f", false, "If an option name is provided which does not exist in the decision, using --force will automatically add it as an option and use it for the decision.")
 
 	return cmd
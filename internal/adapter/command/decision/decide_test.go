@@ -17,7 +17,7 @@ func TestNewDecideCommand_Success(t *testing.T) {
 	mockConfig.On("IsLoaded").Return(true)
 	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
 
-	mockInput.On("Decide", "resolvedPath", "0001", "", "2", "best option", "jane", false).Return(nil)
+	mockInput.On("Decide", "resolvedPath", "0001", "", []string{"2"}, "best option", "jane", "", false, false).Return(nil)
 
 	cmd := NewDecideCommand(mockInput, mockConfig)
 	cmd.SetArgs([]string{
@@ -55,7 +55,7 @@ func TestNewDecideCommand_InputFails(t *testing.T) {
 	mockConfig.On("IsLoaded").Return(true)
 	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
 
-	mockInput.On("Decide", "resolvedPath", "0001", "", "A", "", "kate", false).Return(errors.New("decision error"))
+	mockInput.On("Decide", "resolvedPath", "0001", "", []string{"A"}, "", "kate", "", false, false).Return(errors.New("decision error"))
 
 	cmd := NewDecideCommand(mockInput, mockConfig)
 	cmd.SetArgs([]string{
@@ -67,3 +67,38 @@ func TestNewDecideCommand_InputFails(t *testing.T) {
 	err := cmd.Execute()
 	assert.EqualError(t, err, "decision error")
 }
+
+func TestNewDecideCommand_RecommendWithoutOption(t *testing.T) {
+	mockInput := new(in_mocks.DecisionDecide)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("GetAuthor").Return("jane")
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	mockInput.On("Decide", "resolvedPath", "0002", "", []string(nil), "", "jane", "", false, true).Return(nil)
+
+	cmd := NewDecideCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0002", "--recommend"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewDecideCommand_MultipleOptions(t *testing.T) {
+	mockInput := new(in_mocks.DecisionDecide)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockConfig.On("GetAuthor").Return("jane")
+	mockInput.On("Decide", "resolvedPath", "0001", "", []string{"1", "Use a CDN"}, "", "jane", "", false, false).Return(nil)
+
+	cmd := NewDecideCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--option", "1", "--option", "Use a CDN"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
@@ -0,0 +1,44 @@
+package decision
+
+import (
+	"fmt"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewDueCommand(input inputport.DecisionDue, config domain.ConfigService) *cobra.Command {
+	var modelPath string
+	var within int
+
+	cmd := &cobra.Command{
+		Use:   "due",
+		Short: "Lists decided decisions that are due for review",
+		Long: `Lists the decided decisions whose review_by date has passed or, with --within, will pass in
+the given number of days. The decisions are ordered by their review date.
+
+Examples:
+  adg due
+  adg due --within 30`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			if within < 0 {
+				return fmt.Errorf("--within must not be negative")
+			}
+
+			return input.Due(modelPath, within)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the model directory (optional if configured)")
+	cmd.Flags().IntVar(&within, "within", 0, "Also list decisions due for review in the next N days")
+
+	return cmd
+}
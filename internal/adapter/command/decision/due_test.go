@@ -0,0 +1,40 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDueCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionDue)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Due", "resolvedPath", 30).Return(nil)
+
+	cmd := NewDueCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--within", "30"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewDueCommand_NegativeWithin(t *testing.T) {
+	mockInput := new(in_mocks.DecisionDue)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewDueCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--within", "-1"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, "--within must not be negative")
+}
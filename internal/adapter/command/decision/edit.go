@@ -4,20 +4,57 @@ import (
 	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
 	"github.com/adr/ad-guidance-tool/internal/application/inputport"
 	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+	decision "github.com/adr/ad-guidance-tool/internal/domain/decision"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
 
 func NewEditCommand(input inputport.DecisionEdit, config domain.ConfigService) *cobra.Command {
 	var modelPath, idOrTitle, id, title string
-	var question, criteria string
-	var options []string
+	var question, criteria, questionReplace, criteriaReplace string
+	var options, renameOptions, removeOptions, optionOrder []string
+	var optionDescriptions, pros, cons []string
+	var criteriaItems, criterionWeights []string
+	var sections, sectionReplacements, removeSections []string
 	var err error
 
 	cmd := &cobra.Command{
 		Use:   "edit",
 		Short: "Edit a decision file",
+		Long: `Edits the content of a decision.
+
+Text given with --question or --criteria is appended to the section, --question-replace and
+--criteria-replace replace the section instead.
+
+Options can be added, renamed, removed and reordered. Options are referenced by their number
+or title as they are before the edit. The remaining options are renumbered and links in the
+outcome are updated to the new numbers.
+
+Each option can have a description and a list of pros and cons, which are rendered below
+the option. A new description replaces the existing one, pros and cons are appended.
+
+Numbered criteria with a weight (default 1) can be added with --criterion. They are the
+columns of the decision matrix that is filled with 'adg score'.
+
+Any other section, such as consequences or assumptions, is edited with --section and
+--section-replace given as <anchor>:<text>. Missing sections are created, sections without
+an anchor in their header are matched by their header text (e.g. 'pros-and-cons' for
+"## Pros and Cons").
+
+Examples:
+  adg edit --id 0001 --question "Which database should we use?"
+  adg edit --id 0001 --criteria-replace "Performance and operating cost"
+  adg edit --id 0001 --option PostgreSQL --option MySQL
+  adg edit --id 0001 --rename-option "2:MariaDB"
+  adg edit --id 0001 --remove-option 3 --reorder-options 2,1
+  adg edit --id 0001 --option-description "1:Open source relational database" --pro "1:cheap" --con "1:operating effort"
+  adg edit --id 0001 --criterion Performance --criterion Cost --criterion-weight "Performance:3"
+  adg edit --id 0001 --section "consequences:Backups must be set up"
+  adg edit --id 0001 --section-replace "assumptions:The load stays below 100 requests per second"
+  adg edit --id 0001 --remove-section assumptions`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			modelPath, err = util.ResolveModelPathOrDefault(modelPath, config)
 			if err != nil {
@@ -29,34 +66,117 @@ func NewEditCommand(input inputport.DecisionEdit, config domain.ConfigService) *
 				return err
 			}
 
-			// validate: must be editing something
-			if question == "" && criteria == "" && len(options) == 0 {
-				return fmt.Errorf("at least one of --question, --option, or --criteria must be provided")
+			if question != "" && questionReplace != "" {
+				return fmt.Errorf("--question and --question-replace cannot be used together")
+			}
+			if criteria != "" && criteriaReplace != "" {
+				return fmt.Errorf("--criteria and --criteria-replace cannot be used together")
 			}
 
-			// prepare pointer args
-			var qPtr, cPtr *string
-			var oPtr *[]string
+			edit := decision.ContentEdit{
+				AddOptions:    options,
+				RemoveOptions: removeOptions,
+				OptionOrder:   optionOrder,
+			}
 
 			if question != "" {
-				qPtr = &question
+				edit.Question = &question
+			}
+			if questionReplace != "" {
+				edit.Question = &questionReplace
+				edit.ReplaceQuestion = true
 			}
 			if criteria != "" {
-				cPtr = &criteria
+				edit.Criteria = &criteria
 			}
-			if len(options) > 0 {
-				oPtr = &options
+			if criteriaReplace != "" {
+				edit.Criteria = &criteriaReplace
+				edit.ReplaceCriteria = true
+			}
+
+			for _, rename := range renameOptions {
+				option, newTitle, ok := strings.Cut(rename, ":")
+				if !ok {
+					return fmt.Errorf("invalid --rename-option %q, expected <option>:<new title>", rename)
+				}
+				edit.RenameOptions = append(edit.RenameOptions, decision.OptionRename{Option: option, Title: newTitle})
 			}
 
-			return input.Edit(modelPath, id, title, qPtr, oPtr, cPtr)
+			if edit.DescribeOptions, err = parseOptionTexts("option-description", optionDescriptions); err != nil {
+				return err
+			}
+			if edit.AddPros, err = parseOptionTexts("pro", pros); err != nil {
+				return err
+			}
+			if edit.AddCons, err = parseOptionTexts("con", cons); err != nil {
+				return err
+			}
+
+			edit.AddCriteria = criteriaItems
+			for _, value := range criterionWeights {
+				criterion, weight, ok := strings.Cut(value, ":")
+				number, convErr := strconv.Atoi(strings.TrimSpace(weight))
+				if !ok || convErr != nil {
+					return fmt.Errorf("invalid --criterion-weight %q, expected <criterion>:<weight>", value)
+				}
+				edit.CriterionWeight = append(edit.CriterionWeight, decision.CriterionWeight{Criterion: criterion, Weight: number})
+			}
+
+			for _, flag := range []struct {
+				name    string
+				values  []string
+				replace bool
+			}{{"section", sections, false}, {"section-replace", sectionReplacements, true}} {
+				for _, value := range flag.values {
+					anchor, text, ok := strings.Cut(value, ":")
+					if !ok {
+						return fmt.Errorf("invalid --%s %q, expected <anchor>:<text>", flag.name, value)
+					}
+					edit.Sections = append(edit.Sections, decision.SectionEdit{Anchor: strings.TrimSpace(anchor), Text: text, Replace: flag.replace})
+				}
+			}
+			edit.RemoveSections = removeSections
+
+			// validate: must be editing something
+			if edit.IsEmpty() {
+				return fmt.Errorf("at least one of --question, --option, --criteria or --section (or one of their replace, rename, remove, reorder, description, pro, con or criterion variants) must be provided")
+			}
+
+			return input.Edit(modelPath, id, title, edit)
 		},
 	}
 
 	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
 	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or title of the decision to edit (e.g. 0001, 'my-decision')")
 	cmd.Flags().StringVar(&question, "question", "", "Edit the Question section")
+	cmd.Flags().StringVar(&questionReplace, "question-replace", "", "Replace the content of the Question section")
 	cmd.Flags().StringArrayVar(&options, "option", nil, "Add one or more options (use multiple --option flags to specify multiple options at once or repeat command)")
+	cmd.Flags().StringArrayVar(&renameOptions, "rename-option", nil, "Rename an option, given as <option>:<new title> (e.g. '2:MariaDB')")
+	cmd.Flags().StringArrayVar(&removeOptions, "remove-option", nil, "Remove an option by number or title")
+	cmd.Flags().StringSliceVar(&optionOrder, "reorder-options", nil, "New order of the options by number or title (e.g. 3,1,2); unlisted options follow in their current order")
+	cmd.Flags().StringArrayVar(&optionDescriptions, "option-description", nil, "Set the description of an option, given as <option>:<text> (e.g. '1:Open source database')")
+	cmd.Flags().StringArrayVar(&pros, "pro", nil, "Add a pro to an option, given as <option>:<text> (e.g. '2:cheap')")
+	cmd.Flags().StringArrayVar(&cons, "con", nil, "Add a con to an option, given as <option>:<text> (e.g. '2:slow')")
 	cmd.Flags().StringVar(&criteria, "criteria", "", "Edit the Criterion section")
+	cmd.Flags().StringVar(&criteriaReplace, "criteria-replace", "", "Replace the content of the Criterion section")
+	cmd.Flags().StringArrayVar(&criteriaItems, "criterion", nil, "Add a numbered criterion for the decision matrix (can be repeated)")
+	cmd.Flags().StringArrayVar(&criterionWeights, "criterion-weight", nil, "Set the weight of a criterion, given as <criterion>:<weight> (e.g. '2:3')")
+	cmd.Flags().StringArrayVar(&sections, "section", nil, "Append text to any section, given as <anchor>:<text> (e.g. 'consequences:Backups are needed')")
+	cmd.Flags().StringArrayVar(&sectionReplacements, "section-replace", nil, "Replace the content of any section, given as <anchor>:<text>")
+	cmd.Flags().StringArrayVar(&removeSections, "remove-section", nil, "Remove a section by its anchor")
 
 	return cmd
 }
+
+// parses values of the form <option>:<text>
+func parseOptionTexts(flag string, values []string) ([]decision.OptionText, error) {
+	var texts []decision.OptionText
+	for _, value := range values {
+		option, text, ok := strings.Cut(value, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid --%s %q, expected <option>:<text>", flag, value)
+		}
+		texts = append(texts, decision.OptionText{Option: option, Text: text})
+	}
+	return texts, nil
+}
@@ -1,6 +1,7 @@
 package decision
 
 import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
 	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
 	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
 	"errors"
@@ -16,7 +17,7 @@ func TestNewEditCommand_Success(t *testing.T) {
 
 	mockConfig.On("IsLoaded").Return(true)
 	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
-	mockInput.On("Edit", "resolvedPath", "0001", "", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockInput.On("Edit", "resolvedPath", "0001", "", mock.Anything).Return(nil)
 
 	cmd := NewEditCommand(mockInput, mockConfig)
 	cmd.SetArgs([]string{
@@ -41,7 +42,7 @@ func TestNewEditCommand_MissingFields(t *testing.T) {
 	})
 
 	err := cmd.Execute()
-	assert.EqualError(t, err, "at least one of --question, --option, or --criteria must be provided")
+	assert.EqualError(t, err, "at least one of --question, --option, --criteria or --section (or one of their replace, rename, remove, reorder, description, pro, con or criterion variants) must be provided")
 }
 
 func TestNewEditCommand_EditFails(t *testing.T) {
@@ -50,7 +51,7 @@ func TestNewEditCommand_EditFails(t *testing.T) {
 
 	mockConfig.On("IsLoaded").Return(true)
 	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
-	mockInput.On("Edit", "resolvedPath", "0001", "", mock.Anything, mock.Anything, mock.Anything).
+	mockInput.On("Edit", "resolvedPath", "0001", "", mock.Anything).
 		Return(errors.New("edit failed"))
 
 	cmd := NewEditCommand(mockInput, mockConfig)
@@ -62,3 +63,176 @@ func TestNewEditCommand_EditFails(t *testing.T) {
 	err := cmd.Execute()
 	assert.EqualError(t, err, "edit failed")
 }
+
+func TestNewEditCommand_ReplaceAndOptionOperations(t *testing.T) {
+	mockInput := new(in_mocks.DecisionEdit)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	question := "New question"
+	expected := decision.ContentEdit{
+		Question:        &question,
+		ReplaceQuestion: true,
+		RenameOptions:   []decision.OptionRename{{Option: "2", Title: "MariaDB: managed"}},
+		RemoveOptions:   []string{"3"},
+		OptionOrder:     []string{"2", "1"},
+	}
+	mockInput.On("Edit", "resolvedPath", "0001", "", expected).Return(nil)
+
+	cmd := NewEditCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{
+		"--id", "0001",
+		"--question-replace", "New question",
+		"--rename-option", "2:MariaDB: managed",
+		"--remove-option", "3",
+		"--reorder-options", "2,1",
+	})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewEditCommand_AppendAndReplaceConflict(t *testing.T) {
+	mockInput := new(in_mocks.DecisionEdit)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewEditCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--criteria", "a", "--criteria-replace", "b"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, "--criteria and --criteria-replace cannot be used together")
+}
+
+func TestNewEditCommand_InvalidRenameOption(t *testing.T) {
+	mockInput := new(in_mocks.DecisionEdit)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewEditCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--rename-option", "MariaDB"})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "expected <option>:<new title>")
+}
+
+func TestNewEditCommand_OptionDetails(t *testing.T) {
+	mockInput := new(in_mocks.DecisionEdit)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Edit", "resolvedPath", "0001", "", decision.ContentEdit{
+		DescribeOptions: []decision.OptionText{{Option: "1", Text: "Open source: relational"}},
+		AddPros:         []decision.OptionText{{Option: "2", Text: "cheap"}},
+		AddCons:         []decision.OptionText{{Option: "2", Text: "slow"}, {Option: "MySQL", Text: "licensing"}},
+	}).Return(nil)
+
+	cmd := NewEditCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{
+		"--id", "0001",
+		"--option-description", "1:Open source: relational",
+		"--pro", "2:cheap",
+		"--con", "2:slow",
+		"--con", "MySQL:licensing",
+	})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewEditCommand_InvalidPro(t *testing.T) {
+	mockInput := new(in_mocks.DecisionEdit)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewEditCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--pro", "cheap"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, `invalid --pro "cheap", expected <option>:<text>`)
+}
+
+func TestNewEditCommand_Criteria(t *testing.T) {
+	mockInput := new(in_mocks.DecisionEdit)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Edit", "resolvedPath", "0001", "", decision.ContentEdit{
+		AddCriteria:     []string{"Performance", "Cost"},
+		CriterionWeight: []decision.CriterionWeight{{Criterion: "Performance", Weight: 3}},
+	}).Return(nil)
+
+	cmd := NewEditCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--criterion", "Performance", "--criterion", "Cost", "--criterion-weight", "Performance:3"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewEditCommand_InvalidCriterionWeight(t *testing.T) {
+	mockInput := new(in_mocks.DecisionEdit)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewEditCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--criterion-weight", "1:high"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, `invalid --criterion-weight "1:high", expected <criterion>:<weight>`)
+}
+
+func TestNewEditCommand_Sections(t *testing.T) {
+	mockInput := new(in_mocks.DecisionEdit)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Edit", "resolvedPath", "0001", "", decision.ContentEdit{
+		Sections: []decision.SectionEdit{
+			{Anchor: "consequences", Text: "Backups: needed"},
+			{Anchor: "assumptions", Text: "Low load", Replace: true},
+		},
+		RemoveSections: []string{"notes"},
+	}).Return(nil)
+
+	cmd := NewEditCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{
+		"--id", "0001",
+		"--section", "consequences:Backups: needed",
+		"--section-replace", "assumptions:Low load",
+		"--remove-section", "notes",
+	})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewEditCommand_InvalidSection(t *testing.T) {
+	mockInput := new(in_mocks.DecisionEdit)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewEditCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--section", "consequences"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, `invalid --section "consequences", expected <anchor>:<text>`)
+}
@@ -0,0 +1,76 @@
+package decision
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// decisionFilters holds the filter flags shared by the commands that select decisions like 'list'.
+type decisionFilters struct {
+	tags, statuses                                          []string
+	deciders, consulted, informed, created, decided, fields []string
+	where                                                   []string
+	titlePattern, idFilter                                  string
+}
+
+func (f *decisionFilters) register(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&f.tags, "tag", nil, "Filter decisions by one or more tags")
+	cmd.Flags().StringSliceVar(&f.statuses, "status", nil, "Filter decisions by one or more statuses")
+	cmd.Flags().StringVar(&f.titlePattern, "title", "", "Regex pattern to match titles")
+	cmd.Flags().StringVar(&f.idFilter, "id", "", "Match specific IDs or ranges (e.g. 0002,0004-0006)")
+	cmd.Flags().StringSliceVar(&f.deciders, "decider", nil, "Filter decisions by one or more deciders")
+	cmd.Flags().StringSliceVar(&f.consulted, "consulted", nil, "Filter decisions by one or more consulted people")
+	cmd.Flags().StringSliceVar(&f.informed, "informed", nil, "Filter decisions by one or more informed people")
+	cmd.Flags().StringSliceVar(&f.created, "created", nil, "Filter decisions created on a date or in a range (e.g. 2025-01-01..2025-06-30, 2025-01-01..)")
+	cmd.Flags().StringSliceVar(&f.decided, "decided", nil, "Filter decisions decided on a date or in a range (e.g. ..2025-06-30)")
+	cmd.Flags().StringArrayVar(&f.fields, "field", nil, "Filter decisions by a custom field, given as <field>=<value> (can be repeated)")
+	cmd.Flags().StringArrayVar(&f.where, "where", nil, "Filter decisions by a query, e.g. \"status = open and tag = security\" (can be repeated)")
+}
+
+// build returns the filters in the form expected by DecisionService.FilterDecisions.
+func (f *decisionFilters) build() (map[string][]string, error) {
+	filters := make(map[string][]string)
+
+	if len(f.tags) > 0 {
+		filters["tag"] = f.tags
+	}
+	if len(f.statuses) > 0 {
+		filters["status"] = f.statuses
+	}
+	if f.titlePattern != "" {
+		filters["title"] = []string{f.titlePattern}
+	}
+	if f.idFilter != "" {
+		filters["id"] = []string{f.idFilter}
+	}
+	if len(f.deciders) > 0 {
+		filters["decider"] = f.deciders
+	}
+	if len(f.consulted) > 0 {
+		filters["consulted"] = f.consulted
+	}
+	if len(f.informed) > 0 {
+		filters["informed"] = f.informed
+	}
+	if len(f.created) > 0 {
+		filters["created"] = f.created
+	}
+	if len(f.decided) > 0 {
+		filters["decided"] = f.decided
+	}
+	for _, field := range f.fields {
+		if name, _, ok := strings.Cut(field, "="); !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid --field %q, expected <field>=<value>", field)
+		}
+	}
+	if len(f.fields) > 0 {
+		filters["field"] = f.fields
+	}
+	if len(f.where) > 0 {
+		filters["where"] = f.where
+	}
+
+	return filters, nil
+}
@@ -0,0 +1,72 @@
+package decision
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+var graphFormats = []string{"dot", "mermaid", "plantuml"}
+
+func NewGraphCommand(input inputport.DecisionGraph, config domain.ConfigService) *cobra.Command {
+	var filterFlags decisionFilters
+	var modelPath, format, focus, focusID, focusTitle string
+	var depth int
+
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Renders the links between decisions as a Graphviz, Mermaid or PlantUML diagram",
+		Long: `Renders the links between the decisions of a model as a diagram. Every decision is a node
+colored by its status and every link an edge labeled with its type. The diagram is written to
+stdout in the DOT language of Graphviz (default), as a Mermaid flowchart or as PlantUML.
+
+The filters are the same as for 'adg list'. With --focus only the decisions within --depth links
+of the given decision are shown.
+
+Examples:
+  adg graph > decisions.dot
+  adg graph --format mermaid --status decided
+  adg graph --format plantuml --focus 0004 --depth 2`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			format = strings.ToLower(format)
+			if !slices.Contains(graphFormats, format) {
+				return fmt.Errorf("unsupported format %q (allowed: %s)", format, strings.Join(graphFormats, ", "))
+			}
+
+			if focus != "" {
+				if err := util.ResolveIdOrTitle(focus, &focusID, &focusTitle); err != nil {
+					return err
+				}
+			}
+			if depth < 1 {
+				return fmt.Errorf("--depth must be at least 1")
+			}
+
+			filters, err := filterFlags.build()
+			if err != nil {
+				return err
+			}
+
+			return input.Graph(modelPath, filters, focusID, focusTitle, depth, format)
+		},
+	}
+
+	filterFlags.register(cmd)
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+	cmd.Flags().StringVar(&format, "format", "dot", "Output format: dot, mermaid or plantuml")
+	cmd.Flags().StringVar(&focus, "focus", "", "ID or title of a decision to show only its neighbourhood")
+	cmd.Flags().IntVar(&depth, "depth", 1, "Number of links to follow from the focus decision")
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+package decision
+
+import (
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGraphCommand_Defaults(t *testing.T) {
+	mockInput := new(in_mocks.DecisionGraph)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Graph", "resolvedPath", map[string][]string{}, "", "", 1, "dot").Return(nil)
+
+	cmd := NewGraphCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewGraphCommand_FocusAndFilters(t *testing.T) {
+	mockInput := new(in_mocks.DecisionGraph)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Graph", "resolvedPath", map[string][]string{
+		"status": {"decided"},
+		"where":  {"tag = api"},
+	}, "0004", "", 2, "mermaid").Return(nil)
+
+	cmd := NewGraphCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--format", "Mermaid", "--focus", "0004", "--depth", "2", "--status", "decided", "--where", "tag = api"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewGraphCommand_InvalidFlags(t *testing.T) {
+	mockInput := new(in_mocks.DecisionGraph)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewGraphCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--format", "svg"})
+	assert.EqualError(t, cmd.Execute(), `unsupported format "svg" (allowed: dot, mermaid, plantuml)`)
+
+	cmd = NewGraphCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--focus", "0001", "--depth", "0"})
+	assert.EqualError(t, cmd.Execute(), "--depth must be at least 1")
+
+	mockInput.AssertNotCalled(t, "Graph")
+}
@@ -4,6 +4,7 @@ import (
 	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
 	"github.com/adr/ad-guidance-tool/internal/application/inputport"
 	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+	decision "github.com/adr/ad-guidance-tool/internal/domain/decision"
 	"fmt"
 	"strings"
 
@@ -30,7 +31,39 @@ Default behavior:
 Custom tag behavior:
   - You may provide --tag (and optionally --reverse-tag) to use a custom relationship.
   - You may not use "precedes" or "succeeds" explicitly as --tag or --reverse-tag.
-    These are reserved for the default implicit mode.`,
+    These are reserved for the default implicit mode.
+  - If only one of --tag and --reverse-tag is given, the other one is the inverse declared
+    for the link type in the model settings, or the same tag if no link type is declared.
+
+Link types:
+  Custom link types are declared under link_types in the model.yaml of a model:
+
+    link_types:
+      depends on:
+        inverse: required by
+        acyclic: true
+      relates to:
+        symmetric: true
+      replaces:
+        inverse: replaced by
+        cardinality: one-to-one
+
+  A link that does not match the inverse, would create a cycle of an acyclic link type or
+  exceeds the cardinality (<sources per target>-to-<targets per source>) is rejected.
+
+Decisions of other models:
+  --to may name a decision of another model as <alias>:<id>, e.g. platform:0012. The aliases
+  are declared under models in the model.yaml of the model, with paths relative to the model:
+
+    models:
+      platform: ../platform
+
+  Such links need a custom --tag and are only stored on the source decision.
+
+Examples:
+  adg link --from 0001 --to 0002
+  adg link --from 0003 --to 0001 --tag "depends on"
+  adg link --from 0004 --to platform:0012 --tag "depends on"`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			modelPath, err = util.ResolveModelPathOrDefault(modelPath, config)
 			if err != nil {
@@ -41,7 +74,9 @@ Custom tag behavior:
 				return fmt.Errorf("you must specify the decisions via --from by either providing the numbered id (e.g., 0001) or the name of the decision (e.g, 'my-decision')")
 			}
 
-			if err := util.ResolveIdOrTitle(toIdOrTitle, &toID, &toTitle); err != nil {
+			if decision.IsReference(toIdOrTitle) {
+				toID = toIdOrTitle
+			} else if err := util.ResolveIdOrTitle(toIdOrTitle, &toID, &toTitle); err != nil {
 				return fmt.Errorf("you must specify the decisions via --to by either providing the numbered id (e.g., 0001) or the name of the decision (e.g, 'my-decision')")
 			}
 
@@ -55,15 +90,13 @@ Custom tag behavior:
 				return fmt.Errorf(`you cannot use "precedes" or "succeeds" as custom tags; omit --tag and --reverse-tag to use them implicitly`)
 			}
 
-			// Tag logic
+			// Tag logic, a missing tag is derived from the link types of the model
 			finalTag := tag
 			finalReverseTag := reverseTag
 
 			if tag == "" && reverseTag == "" {
 				finalTag = "precedes"
 				finalReverseTag = "succeeds"
-			} else if tag != "" && reverseTag == "" {
-				finalReverseTag = tag
 			}
 
 			return input.Link(modelPath, fromID, fromTitle, toID, toTitle, finalTag, finalReverseTag)
@@ -72,7 +105,7 @@ Custom tag behavior:
 
 	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
 	cmd.Flags().StringVar(&fromIdOrTitle, "from", "", "ID or title of the source decision (e.g. 0001, 'my-decision')")
-	cmd.Flags().StringVar(&toIdOrTitle, "to", "", "ID or title of the target decision (e.g. 0002, 'other-decision')")
+	cmd.Flags().StringVar(&toIdOrTitle, "to", "", "ID or title of the target decision, or <alias>:<id> for a decision of another model (e.g. 0002, 'other-decision', platform:0012)")
 	cmd.Flags().StringVar(&tag, "tag", "", `Custom link tag (e.g. "invalidated by"). Cannot be "precedes" or "succeeds".`)
 	cmd.Flags().StringVar(&reverseTag, "reverse-tag", "", `Optional reverse tag (e.g. "invalidates"). Cannot be "precedes" or "succeeds".`)
 
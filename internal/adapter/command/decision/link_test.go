@@ -47,6 +47,38 @@ func TestNewLinkCommand_SuccessWithCustomTags(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestNewLinkCommand_TagWithoutReverseTag(t *testing.T) {
+	mockInput := new(in_mocks.DecisionLink)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Link", "resolvedPath", "", "A", "", "B", "depends on", "").Return(nil)
+
+	cmd := NewLinkCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--from", "A", "--to", "B", "--tag", "depends on"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewLinkCommand_ReferenceToOtherModel(t *testing.T) {
+	mockInput := new(in_mocks.DecisionLink)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Link", "resolvedPath", "0001", "", "platform:0012", "", "depends on", "").Return(nil)
+
+	cmd := NewLinkCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--from", "0001", "--to", "platform:0012", "--tag", "depends on"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
 func TestNewLinkCommand_RejectsReservedTags(t *testing.T) {
 	mockInput := new(in_mocks.DecisionLink)
 	mockConfig := new(svc_mocks.ConfigService)
@@ -9,45 +9,50 @@ import (
 )
 
 func NewListCommand(input inputport.DecisionList, config domain.ConfigService) *cobra.Command {
-	var tags []string
-	var statuses []string
-	var format, titlePattern, idFilter string
+	var filterFlags decisionFilters
+	var format string
 	var modelPath string
+	var showAll bool
 
 	cmd := &cobra.Command{
 		Use:   "list",
-		Short: "Lists decisions in the model, optionally filtering by tag, status, title, or ID",
+		Short: "Lists decisions in the model, optionally filtering by tag, status, title, ID, people, dates or custom fields",
+		Long: `Lists decisions in the model, optionally filtering by tag, status, title, ID, people, dates or custom fields.
+
+A decision is listed if it matches every given filter. Repeating a filter such as --tag lists
+decisions matching any of its values.
+
+--where accepts a query combining comparisons with and, or, not and parentheses. A comparison
+has the form <field> <operator> <value> with the operators =, !=, <, <=, >, >= and ~ (regex).
+Fields are id, title, status, tags, created, decided_at, review_by, deciders, consulted,
+informed, links, links.<type> and the custom fields of the model. A field without operator
+matches decisions where it is set, and 'today' can be compared against the built-in dates.
+Values containing spaces or operators must be quoted.
+
+Examples:
+  adg list --status open --tag security
+  adg list --where "status = open and (tag = security or risk >= 3)"
+  adg list --where "not links and created >= 2025-01-01"
+  adg list --where "review_by < today"`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
 			if err != nil {
 				return err
 			}
 
-			filters := make(map[string][]string)
-
-			if len(tags) > 0 {
-				filters["tag"] = tags
-			}
-			if len(statuses) > 0 {
-				filters["status"] = statuses
-			}
-			if titlePattern != "" {
-				filters["title"] = []string{titlePattern}
-			}
-			if idFilter != "" {
-				filters["id"] = []string{idFilter}
+			filters, err := filterFlags.build()
+			if err != nil {
+				return err
 			}
 
-			return input.ListDecisions(modelPath, filters, format)
+			return input.ListDecisions(modelPath, filters, format, showAll)
 		},
 	}
 
-	cmd.Flags().StringSliceVar(&tags, "tag", nil, "Filter decisions by one or more tags")
-	cmd.Flags().StringSliceVar(&statuses, "status", nil, "Filter decisions by one or more statuses")
+	filterFlags.register(cmd)
 	cmd.Flags().StringVar(&format, "format", "simple", "Output format: simple, yaml, json, or md")
-	cmd.Flags().StringVar(&titlePattern, "title", "", "Regex pattern to match titles")
-	cmd.Flags().StringVar(&idFilter, "id", "", "Match specific IDs or ranges (e.g. 0002,0004-0006)")
 	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (overrides config)")
+	cmd.Flags().BoolVar(&showAll, "all", false, "Include superseded decisions")
 
 	return cmd
 }
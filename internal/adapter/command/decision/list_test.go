@@ -19,7 +19,7 @@ func TestNewListCommand_SuccessWithAllFilters(t *testing.T) {
 		"status": {"open"},
 		"title":  {"core"},
 		"id":     {"0001"},
-	}, "yaml").Return(nil)
+	}, "yaml", false).Return(nil)
 
 	cmd := NewListCommand(mockInput, mockConfig)
 	cmd.SetArgs([]string{
@@ -34,13 +34,73 @@ func TestNewListCommand_SuccessWithAllFilters(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestNewListCommand_PeopleAndDateFilters(t *testing.T) {
+	mockInput := new(in_mocks.DecisionList)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("ListDecisions", "resolvedPath", map[string][]string{
+		"decider":   {"alice", "bob"},
+		"consulted": {"carol"},
+		"informed":  {"team"},
+		"created":   {"2025-01-01.."},
+		"decided":   {"..2025-06-30"},
+	}, "simple", false).Return(nil)
+
+	cmd := NewListCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{
+		"--decider", "alice,bob",
+		"--consulted", "carol",
+		"--informed", "team",
+		"--created", "2025-01-01..",
+		"--decided", "..2025-06-30",
+	})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewListCommand_FieldFilter(t *testing.T) {
+	mockInput := new(in_mocks.DecisionList)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("ListDecisions", "resolvedPath", map[string][]string{
+		"field": {"risk=high", "component=api,web"},
+	}, "simple", false).Return(nil)
+
+	cmd := NewListCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--field", "risk=high", "--field", "component=api,web"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewListCommand_InvalidFieldFilter(t *testing.T) {
+	mockInput := new(in_mocks.DecisionList)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewListCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--field", "risk"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, `invalid --field "risk", expected <field>=<value>`)
+}
+
 func TestNewListCommand_MinimalValidInput(t *testing.T) {
 	mockInput := new(in_mocks.DecisionList)
 	mockConfig := new(svc_mocks.ConfigService)
 
 	mockConfig.On("IsLoaded").Return(true)
 	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
-	mockInput.On("ListDecisions", "resolvedPath", map[string][]string{}, "simple").Return(nil)
+	mockInput.On("ListDecisions", "resolvedPath", map[string][]string{}, "simple", false).Return(nil)
 
 	cmd := NewListCommand(mockInput, mockConfig)
 	cmd.SetArgs([]string{})
@@ -49,6 +109,22 @@ func TestNewListCommand_MinimalValidInput(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestNewListCommand_AllIncludesSuperseded(t *testing.T) {
+	mockInput := new(in_mocks.DecisionList)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("ListDecisions", "resolvedPath", map[string][]string{}, "simple", true).Return(nil)
+
+	cmd := NewListCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--all"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
 func TestNewListCommand_ConfigResolutionFails(t *testing.T) {
 	mockInput := new(in_mocks.DecisionList)
 	mockConfig := new(svc_mocks.ConfigService)
@@ -62,3 +138,22 @@ func TestNewListCommand_ConfigResolutionFails(t *testing.T) {
 	err := cmd.Execute()
 	assert.Error(t, err)
 }
+
+func TestNewListCommand_Where(t *testing.T) {
+	mockInput := new(in_mocks.DecisionList)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("ListDecisions", "resolvedPath", map[string][]string{
+		"tag":   {"security"},
+		"where": {"status = open or risk >= 3", "not links"},
+	}, "simple", false).Return(nil)
+
+	cmd := NewListCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--tag", "security", "--where", "status = open or risk >= 3", "--where", "not links"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
@@ -0,0 +1,78 @@
+package decision
+
+import (
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+
+	"github.com/spf13/cobra"
+)
+
+func NewMetadataCommand(input inputport.DecisionMetadata, config domain.ConfigService) *cobra.Command {
+	var modelPath, idOrTitle, id, title string
+	var created, decidedAt, reviewBy string
+	var deciders, consulted, informed []string
+
+	cmd := &cobra.Command{
+		Use:   "metadata",
+		Short: "Shows or changes the dates, deciders and RACI roles of a decision",
+		Long: `Shows or changes the metadata of a decision.
+
+'created' is set when a decision is added or revised, 'decided_at' and the deciding author are set
+by 'adg decide' and 'review_by' by 'adg decide' and 'adg review'. Dates use the format YYYY-MM-DD.
+The lists of deciders, consulted and informed people are replaced by the given values; pass an
+empty value to clear a field.
+Without any change flag the current metadata is shown.
+
+Examples:
+  adg metadata --id 0002
+  adg metadata --id 0002 --deciders alice,bob --consulted "platform team" --informed everyone
+  adg metadata --id 0002 --created 2025-03-01 --decided-at ""
+  adg metadata --id 0002 --review-by 2027-01-31`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := util.ResolveIdOrTitle(idOrTitle, &id, &title)
+			if err != nil {
+				return err
+			}
+
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			var edit decision.MetadataEdit
+			if cmd.Flags().Changed("created") {
+				edit.Created = &created
+			}
+			if cmd.Flags().Changed("decided-at") {
+				edit.DecidedAt = &decidedAt
+			}
+			if cmd.Flags().Changed("review-by") {
+				edit.ReviewBy = &reviewBy
+			}
+			if cmd.Flags().Changed("deciders") {
+				edit.Deciders = &deciders
+			}
+			if cmd.Flags().Changed("consulted") {
+				edit.Consulted = &consulted
+			}
+			if cmd.Flags().Changed("informed") {
+				edit.Informed = &informed
+			}
+
+			return input.Metadata(modelPath, id, title, edit)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or title of the decision (e.g. 0001, 'my-decision')")
+	cmd.Flags().StringVar(&created, "created", "", "Date the decision was created (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&decidedAt, "decided-at", "", "Date the decision was made (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&reviewBy, "review-by", "", "Date the decision is due for review (YYYY-MM-DD)")
+	cmd.Flags().StringSliceVar(&deciders, "deciders", nil, "People who make the decision (comma separated)")
+	cmd.Flags().StringSliceVar(&consulted, "consulted", nil, "People who are consulted (comma separated)")
+	cmd.Flags().StringSliceVar(&informed, "informed", nil, "People who are kept informed (comma separated)")
+
+	return cmd
+}
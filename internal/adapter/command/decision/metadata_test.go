@@ -0,0 +1,71 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMetadataCommand_ShowOnly(t *testing.T) {
+	mockInput := new(in_mocks.DecisionMetadata)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Metadata", "resolvedPath", "0002", "", decision.MetadataEdit{}).Return(nil)
+
+	cmd := NewMetadataCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0002"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewMetadataCommand_SetsAndClearsFields(t *testing.T) {
+	mockInput := new(in_mocks.DecisionMetadata)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	created := "2025-03-01"
+	decidedAt := ""
+	deciders := []string{"alice", "bob"}
+	informed := []string{}
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Metadata", "resolvedPath", "0002", "", decision.MetadataEdit{
+		Created:   &created,
+		DecidedAt: &decidedAt,
+		Deciders:  &deciders,
+		Informed:  &informed,
+	}).Return(nil)
+
+	cmd := NewMetadataCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0002", "--created", "2025-03-01", "--decided-at", "", "--deciders", "alice,bob", "--informed", ""})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewMetadataCommand_ReviewBy(t *testing.T) {
+	mockInput := new(in_mocks.DecisionMetadata)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	reviewBy := "2027-01-31"
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Metadata", "resolvedPath", "0002", "", decision.MetadataEdit{ReviewBy: &reviewBy}).Return(nil)
+
+	cmd := NewMetadataCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0002", "--review-by", "2027-01-31"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
@@ -0,0 +1,40 @@
+package decision
+
+import (
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewNextCommand(input inputport.DecisionNext, config domain.ConfigService) *cobra.Command {
+	var modelPath string
+
+	cmd := &cobra.Command{
+		Use:   "next",
+		Short: "Lists the open decisions that can be decided now and the ones that are blocked",
+		Long: `Lists the decisions that still await a decision and whose predecessors, following the
+precedes/succeeds links, are all settled. Decisions with a predecessor that is not decided
+yet are listed as blocked together with the decisions they are waiting for.
+
+A decision awaits a decision as long as the lifecycle allows moving it to the decided status.
+Rejected, deprecated and superseded predecessors therefore do not block.
+
+Examples:
+  adg next
+  adg next --model models/platform`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			return input.Next(modelPath)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+
+	return cmd
+}
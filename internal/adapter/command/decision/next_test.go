@@ -0,0 +1,40 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNextCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionNext)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockInput.On("Next", "models").Return(nil)
+
+	cmd := NewNextCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--model", "models"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewNextCommand_DefaultModel(t *testing.T) {
+	mockInput := new(in_mocks.DecisionNext)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Next", "resolvedPath").Return(nil)
+
+	cmd := NewNextCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
@@ -0,0 +1,40 @@
+package decision
+
+import (
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewPlanCommand(input inputport.DecisionPlan, config domain.ConfigService) *cobra.Command {
+	var modelPath string
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Suggests an order for the open decisions, grouped into waves",
+		Long: `Suggests an order in which the open decisions of the model can be made. The decisions are
+grouped into waves: the first wave can be decided now, every later wave once the decisions
+of the waves before it are decided. The order follows the precedes/succeeds links.
+
+Decisions that are part of or depend on a cycle of links cannot be planned and are listed
+separately.
+
+Examples:
+  adg plan
+  adg plan --model models/platform`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			return input.Plan(modelPath)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+
+	return cmd
+}
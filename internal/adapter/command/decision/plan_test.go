@@ -0,0 +1,40 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPlanCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionPlan)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockInput.On("Plan", "models").Return(nil)
+
+	cmd := NewPlanCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--model", "models"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewPlanCommand_DefaultModel(t *testing.T) {
+	mockInput := new(in_mocks.DecisionPlan)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Plan", "resolvedPath").Return(nil)
+
+	cmd := NewPlanCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
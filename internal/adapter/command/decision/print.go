@@ -12,13 +12,27 @@ import (
 // todo: rename all related functions and files to view
 func NewPrintCommand(input inputport.DecisionPrint, config domain.ConfigService) *cobra.Command {
 	var err error
-	var modelPath string
-	var idsOrTitles, ids, titles []string
-	var printQuestion, printOptions, printCriteria, printComments, printOutcome bool
+	var modelPath, format string
+	var idsOrTitles, ids, titles, namedSections []string
+	var printQuestion, printOptions, printCriteria, printComments, printOutcome, printLinks bool
 
 	cmd := &cobra.Command{
 		Use:   "view",
 		Short: "Show the full or partial content of one or more decision files",
+		Long: `Shows the full or partial content of one or more decision files.
+
+Without a section flag all sections are shown in the order of the file, including sections
+such as the decision matrix or custom ones like consequences. --section selects sections by
+their anchor. --links shows the linked decisions with their title and status, including
+decisions of other models linked as <alias>:<id>.
+
+With --format json or yaml the content is printed as structured data, including the
+description, pros and cons of each option.
+
+Examples:
+  adg view --id 0001
+  adg view --id 0001 --options --format json
+  adg view --id 0001 --question --section consequences`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			modelPath, err = util.ResolveModelPathOrDefault(modelPath, config)
 			if err != nil {
@@ -41,23 +55,29 @@ func NewPrintCommand(input inputport.DecisionPrint, config domain.ConfigService)
 				return fmt.Errorf("at least one --id or --title must be provided")
 			}
 
-			if !printQuestion && !printOptions && !printCriteria && !printComments && !printOutcome {
-				printQuestion = true
-				printOptions = true
-				printCriteria = true
-				printComments = true
-				printOutcome = true
+			// without a selection all sections are printed
+			var sections map[string]bool
+			if printQuestion || printOptions || printCriteria || printComments || printOutcome || printLinks || len(namedSections) > 0 {
+				sections = map[string]bool{
+					"question": printQuestion,
+					"options":  printOptions,
+					"criteria": printCriteria,
+					"comments": printComments,
+					"outcome":  printOutcome,
+					"links":    printLinks,
+				}
+				for _, anchor := range namedSections {
+					sections[anchor] = true
+				}
 			}
 
-			sections := map[string]bool{
-				"question": printQuestion,
-				"options":  printOptions,
-				"criteria": printCriteria,
-				"comments": printComments,
-				"outcome":  printOutcome,
+			switch format {
+			case "text", "json", "yaml":
+			default:
+				return fmt.Errorf("unknown format %q, use text, json or yaml", format)
 			}
 
-			return input.Print(modelPath, ids, titles, sections)
+			return input.Print(modelPath, ids, titles, sections, format)
 		},
 	}
 
@@ -69,6 +89,9 @@ func NewPrintCommand(input inputport.DecisionPrint, config domain.ConfigService)
 	cmd.Flags().BoolVar(&printCriteria, "criteria", false, "Print the Criteria section")
 	cmd.Flags().BoolVar(&printComments, "comments", false, "Print the Comments section")
 	cmd.Flags().BoolVar(&printOutcome, "outcome", false, "Print the Outcome section")
+	cmd.Flags().BoolVar(&printLinks, "links", false, "Print the linked decisions")
+	cmd.Flags().StringSliceVar(&namedSections, "section", nil, "Print the sections with the given anchors (e.g. consequences,decision-matrix)")
+	cmd.Flags().StringVar(&format, "format", "text", "Output format: text, json or yaml")
 
 	return cmd
 }
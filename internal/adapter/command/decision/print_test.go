@@ -14,13 +14,7 @@ func TestNewPrintCommand_AllSectionsDefaulted(t *testing.T) {
 
 	mockConfig.On("IsLoaded").Return(true)
 	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
-	mockInput.On("Print", "resolvedPath", []string{"0001"}, []string{"My Decision"}, map[string]bool{
-		"question": true,
-		"options":  true,
-		"criteria": true,
-		"comments": true,
-		"outcome":  true,
-	}).Return(nil)
+	mockInput.On("Print", "resolvedPath", []string{"0001"}, []string{"My Decision"}, map[string]bool(nil), "text").Return(nil)
 
 	cmd := NewPrintCommand(mockInput, mockConfig)
 	cmd.SetArgs([]string{
@@ -43,7 +37,8 @@ func TestNewPrintCommand_CustomSectionsSelected(t *testing.T) {
 		"criteria": false,
 		"comments": false,
 		"outcome":  false,
-	}).Return(nil)
+		"links":    false,
+	}, "text").Return(nil)
 
 	cmd := NewPrintCommand(mockInput, mockConfig)
 	cmd.SetArgs([]string{
@@ -54,6 +49,56 @@ func TestNewPrintCommand_CustomSectionsSelected(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestNewPrintCommand_NamedSectionsSelected(t *testing.T) {
+	mockInput := new(in_mocks.DecisionPrint)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Print", "resolvedPath", []string{"0001"}, []string(nil), map[string]bool{
+		"question":        false,
+		"options":         false,
+		"criteria":        false,
+		"comments":        false,
+		"outcome":         false,
+		"links":           false,
+		"consequences":    true,
+		"decision-matrix": true,
+	}, "text").Return(nil)
+
+	cmd := NewPrintCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{
+		"--id", "0001", "--section", "consequences,decision-matrix",
+	})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewPrintCommand_LinksSelected(t *testing.T) {
+	mockInput := new(in_mocks.DecisionPrint)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Print", "resolvedPath", []string{"0001"}, []string(nil), map[string]bool{
+		"question": false,
+		"options":  false,
+		"criteria": false,
+		"comments": false,
+		"outcome":  false,
+		"links":    true,
+	}, "text").Return(nil)
+
+	cmd := NewPrintCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--links"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
 func TestNewPrintCommand_ErrorWhenNoIdsProvided(t *testing.T) {
 	mockInput := new(in_mocks.DecisionPrint)
 	mockConfig := new(svc_mocks.ConfigService)
@@ -81,3 +126,18 @@ func TestNewPrintCommand_ModelPathResolutionFails(t *testing.T) {
 	err := cmd.Execute()
 	assert.Error(t, err)
 }
+
+func TestNewPrintCommand_UnknownFormat(t *testing.T) {
+	mockInput := new(in_mocks.DecisionPrint)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewPrintCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "--format", "xml"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, `unknown format "xml", use text, json or yaml`)
+	mockInput.AssertNotCalled(t, "Print")
+}
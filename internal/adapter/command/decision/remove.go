@@ -0,0 +1,49 @@
+package decision
+
+import (
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewRemoveCommand(input inputport.DecisionRemove, config domain.ConfigService) *cobra.Command {
+	var modelPath, idOrTitle, id, title string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Deletes a decision and removes all links pointing to it",
+		Long: `Deletes a decision file (and its rule file, if any) and removes it from the index.
+
+All links of other decisions pointing to the removed decision are deleted as well.
+Use 'adg archive' instead to keep the decision file for later reference.
+
+A decision that supersedes other decisions is only removed with --force. The superseded
+decisions keep their status, their notice names the removed decision without linking to it.
+
+Examples:
+  adg remove --id 0003
+  adg remove --id my-decision`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := util.ResolveIdOrTitle(idOrTitle, &id, &title)
+			if err != nil {
+				return err
+			}
+
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			return input.Remove(modelPath, id, title, force)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or title of the decision to remove (e.g. 0001, 'my-decision')")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Remove the decision even if it supersedes other decisions")
+
+	return cmd
+}
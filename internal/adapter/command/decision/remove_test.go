@@ -0,0 +1,65 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRemoveCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionRemove)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Remove", "resolvedPath", "0003", "", false).Return(nil)
+
+	cmd := NewRemoveCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0003"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewRemoveCommand_ByTitle(t *testing.T) {
+	mockInput := new(in_mocks.DecisionRemove)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockInput.On("Remove", "custom", "", "my-decision", false).Return(nil)
+
+	cmd := NewRemoveCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--model", "custom", "--id", "my-decision"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewRemoveCommand_Force(t *testing.T) {
+	mockInput := new(in_mocks.DecisionRemove)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockInput.On("Remove", "custom", "0003", "", true).Return(nil)
+
+	cmd := NewRemoveCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--model", "custom", "--id", "0003", "--force"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewRemoveCommand_MissingID(t *testing.T) {
+	mockInput := new(in_mocks.DecisionRemove)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewRemoveCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "you must specify the decisions via --id")
+}
@@ -0,0 +1,51 @@
+package decision
+
+import (
+	"fmt"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewRenameCommand(input inputport.DecisionRename, config domain.ConfigService) *cobra.Command {
+	var modelPath, idOrTitle, id, title string
+
+	cmd := &cobra.Command{
+		Use:   "rename <new-title...>",
+		Short: "Changes the title of a decision and renames its file",
+		Long: `Changes the title of a decision.
+
+The decision file and its rule file (if any) are renamed to match the new title while
+staying in their folder, the index is updated and the adr header of the rule file is rewritten.
+
+Examples:
+  adg rename --id 0004 "Use PostgreSQL as primary database"
+  adg rename --id old-title new title`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			newTitle := joinArgs(args)
+			if newTitle == "" {
+				return fmt.Errorf("the new title must be provided as argument")
+			}
+
+			err := util.ResolveIdOrTitle(idOrTitle, &id, &title)
+			if err != nil {
+				return err
+			}
+
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			return input.Rename(modelPath, id, title, newTitle)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or current title of the decision to rename (e.g. 0001, 'my-decision')")
+
+	return cmd
+}
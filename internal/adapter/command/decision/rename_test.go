@@ -0,0 +1,51 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRenameCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionRename)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Rename", "resolvedPath", "0004", "", "New title").Return(nil)
+
+	cmd := NewRenameCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0004", "New title"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewRenameCommand_JoinsArguments(t *testing.T) {
+	mockInput := new(in_mocks.DecisionRename)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockInput.On("Rename", "custom", "", "old-title", "new title").Return(nil)
+
+	cmd := NewRenameCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--model", "custom", "--id", "old-title", "new", "title"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewRenameCommand_MissingTitle(t *testing.T) {
+	mockInput := new(in_mocks.DecisionRename)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewRenameCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0004"})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "the new title must be provided")
+}
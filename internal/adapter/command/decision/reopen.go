@@ -0,0 +1,56 @@
+package decision
+
+import (
+	"fmt"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewReopenCommand(input inputport.DecisionReopen, config domain.ConfigService) *cobra.Command {
+	var modelPath, idOrTitle, id, title, reason, authorFlag string
+
+	cmd := &cobra.Command{
+		Use:   "reopen",
+		Short: "Reopens a decided decision in place",
+		Long: `Reopens a decided decision without creating a copy, so its ID and references stay the same.
+
+The current outcome is moved to the 'Previous Outcomes' section, the status is set back to
+the initial status of the model's lifecycle and a comment with the reason is added.
+Use 'adg revise' instead to create a new decision based on the existing one.
+
+Examples:
+  adg reopen --id 0005 --reason "Chose the wrong option"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := util.ResolveIdOrTitle(idOrTitle, &id, &title)
+			if err != nil {
+				return err
+			}
+
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			author := authorFlag
+			if author == "" {
+				author = config.GetAuthor()
+			}
+			if author == "" {
+				return fmt.Errorf("author must be provided using --author or set in config")
+			}
+
+			return input.Reopen(modelPath, id, title, reason, author)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the model directory (optional if configured)")
+	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or title of the decision to reopen (e.g. 0001, 'my-decision')")
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason for reopening the decision")
+	cmd.Flags().StringVar(&authorFlag, "author", "", "Name of the person reopening the decision (overrides config)")
+
+	return cmd
+}
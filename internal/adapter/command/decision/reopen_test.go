@@ -0,0 +1,42 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReopenCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionReopen)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockConfig.On("GetAuthor").Return("alice")
+	mockInput.On("Reopen", "resolvedPath", "0005", "", "wrong option", "alice").Return(nil)
+
+	cmd := NewReopenCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0005", "--reason", "wrong option"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewReopenCommand_MissingAuthor(t *testing.T) {
+	mockInput := new(in_mocks.DecisionReopen)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockConfig.On("GetAuthor").Return("")
+
+	cmd := NewReopenCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0005"})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "author must be provided")
+}
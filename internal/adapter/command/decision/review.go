@@ -0,0 +1,63 @@
+package decision
+
+import (
+	"fmt"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewReviewCommand(input inputport.DecisionReview, config domain.ConfigService) *cobra.Command {
+	var modelPath, idOrTitle, id, title, result, note, next, authorFlag string
+
+	cmd := &cobra.Command{
+		Use:   "review",
+		Short: "Records the review of a decided decision and sets its next review date",
+		Long: `Records that a decided decision was reviewed.
+
+The result is either 'still-valid' or 'needs-revision'. The review is logged as a comment and the
+review_by date is moved forward by the review_interval in model.yaml (e.g. 'review_interval: 6m'),
+or set to the date given with --next. Use 'adg due' to list the decisions that are due for review.
+
+Examples:
+  adg review --id 0004 --result still-valid
+  adg review --id 0004 --result needs-revision --note "new pricing model" --next 2026-06-30`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := util.ResolveIdOrTitle(idOrTitle, &id, &title)
+			if err != nil {
+				return err
+			}
+
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			if result == "" {
+				return fmt.Errorf("--result must be provided (still-valid or needs-revision)")
+			}
+
+			author := authorFlag
+			if author == "" {
+				author = config.GetAuthor()
+			}
+			if author == "" {
+				return fmt.Errorf("author must be provided using --author or set in config")
+			}
+
+			return input.Review(modelPath, id, title, result, note, next, author)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the model directory (optional if configured)")
+	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or title of the decision to review (e.g. 0001, 'my-decision')")
+	cmd.Flags().StringVar(&result, "result", "", "Result of the review: still-valid or needs-revision")
+	cmd.Flags().StringVar(&note, "note", "", "Optional note added to the review comment")
+	cmd.Flags().StringVar(&next, "next", "", "Date of the next review (YYYY-MM-DD), defaults to the model's review interval")
+	cmd.Flags().StringVar(&authorFlag, "author", "", "Name of the reviewer (overrides config)")
+
+	return cmd
+}
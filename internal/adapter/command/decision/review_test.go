@@ -0,0 +1,42 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReviewCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionReview)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockConfig.On("GetAuthor").Return("alice")
+	mockInput.On("Review", "resolvedPath", "0004", "", "needs-revision", "new pricing", "2026-06-30", "alice").Return(nil)
+
+	cmd := NewReviewCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0004", "--result", "needs-revision", "--note", "new pricing", "--next", "2026-06-30"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewReviewCommand_MissingResult(t *testing.T) {
+	mockInput := new(in_mocks.DecisionReview)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewReviewCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0004"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, "--result must be provided (still-valid or needs-revision)")
+	mockInput.AssertNotCalled(t, "Review")
+}
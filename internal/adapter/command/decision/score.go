@@ -0,0 +1,58 @@
+package decision
+
+import (
+	"fmt"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewScoreCommand(input inputport.DecisionScore, config domain.ConfigService) *cobra.Command {
+	var modelPath, idOrTitle, id, title, option, criterion string
+	var value int
+
+	cmd := &cobra.Command{
+		Use:   "score",
+		Short: "Scores an option of a decision against one of its criteria",
+		Long: `Scores an option of a decision against one of its numbered criteria (see 'adg edit --criterion').
+
+Scores range from 0 to 5. The scores are stored in the metadata of the decision and rendered
+as a decision matrix with the weighted score of each option. Use 'adg decide --recommend' to
+rank the options by their weighted score.
+
+Examples:
+  adg score --id 0002 --option 1 --criterion 2 --value 4
+  adg score --id 0002 --option PostgreSQL --criterion Performance --value 5`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := util.ResolveIdOrTitle(idOrTitle, &id, &title)
+			if err != nil {
+				return err
+			}
+
+			if option == "" || criterion == "" {
+				return fmt.Errorf("both --option and --criterion must be provided")
+			}
+			if !cmd.Flags().Changed("value") {
+				return fmt.Errorf("the score must be provided via --value")
+			}
+
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			return input.Score(modelPath, id, title, option, criterion, value)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or title of the decision to score (e.g. 0001, 'my-decision')")
+	cmd.Flags().StringVar(&option, "option", "", "Number or title of the option to score")
+	cmd.Flags().StringVar(&criterion, "criterion", "", "Number or title of the criterion to score against")
+	cmd.Flags().IntVar(&value, "value", 0, "Score between 0 and 5")
+
+	return cmd
+}
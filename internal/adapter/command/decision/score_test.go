@@ -0,0 +1,48 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewScoreCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionScore)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Score", "resolvedPath", "0002", "", "1", "2", 0).Return(nil)
+
+	cmd := NewScoreCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0002", "--option", "1", "--criterion", "2", "--value", "0"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewScoreCommand_MissingValue(t *testing.T) {
+	mockInput := new(in_mocks.DecisionScore)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewScoreCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0002", "--option", "1", "--criterion", "2"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, "the score must be provided via --value")
+}
+
+func TestNewScoreCommand_MissingCriterion(t *testing.T) {
+	mockInput := new(in_mocks.DecisionScore)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewScoreCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0002", "--option", "1", "--value", "3"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, "both --option and --criterion must be provided")
+}
@@ -0,0 +1,55 @@
+package decision
+
+import (
+	"fmt"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+
+	"github.com/spf13/cobra"
+)
+
+func NewSearchCommand(input inputport.DecisionSearch, config domain.ConfigService) *cobra.Command {
+	var modelPath string
+	var sections, tags []string
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "search <terms>...",
+		Short: "Searches the text of all decisions in the model",
+		Long: `Searches the title and sections of all decisions in the model and lists the decisions
+containing every term, best matches first, with a snippet of each matching section.
+
+Terms are matched as whole words regardless of case; a trailing * matches words starting with
+the term. Matches in the title, question and outcome count more than matches elsewhere.
+The search index is cached in the model directory and updated for changed files on every search.
+
+Examples:
+  adg search kafka
+  adg search "message broker" --section options --section outcome
+  adg search auth* --tag security`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			if limit < 0 {
+				return fmt.Errorf("--limit must not be negative")
+			}
+
+			query := decision.SearchQuery{Terms: args, Sections: sections, Tags: tags}
+			return input.Search(modelPath, query, limit)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+	cmd.Flags().StringSliceVar(&sections, "section", nil, "Only search the sections with these anchors (e.g. question, options, comments)")
+	cmd.Flags().StringSliceVar(&tags, "tag", nil, "Only search decisions with one of these tags")
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of decisions to show, 0 shows all")
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSearchCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionSearch)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Search", "resolvedPath", decision.SearchQuery{
+		Terms:    []string{"message broker", "kafka"},
+		Sections: []string{"options", "outcome"},
+		Tags:     []string{"backend"},
+	}, 5).Return(nil)
+
+	cmd := NewSearchCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"message broker", "kafka", "--section", "options,outcome", "--tag", "backend", "--limit", "5"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewSearchCommand_RequiresTerms(t *testing.T) {
+	mockInput := new(in_mocks.DecisionSearch)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewSearchCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	mockInput.AssertNotCalled(t, "Search")
+}
+
+func TestNewSearchCommand_NegativeLimit(t *testing.T) {
+	mockInput := new(in_mocks.DecisionSearch)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewSearchCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"kafka", "--limit", "-1"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, "--limit must not be negative")
+}
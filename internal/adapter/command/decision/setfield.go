@@ -0,0 +1,63 @@
+package decision
+
+import (
+	"fmt"
+	"strings"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewSetFieldCommand(input inputport.DecisionSetField, config domain.ConfigService) *cobra.Command {
+	var modelPath, idOrTitle, id, title string
+
+	cmd := &cobra.Command{
+		Use:   "set-field <field=value...>",
+		Short: "Sets custom metadata fields of a decision declared in the model settings",
+		Long: `Sets one or more custom metadata fields of a decision.
+
+The fields, their types (string, number, boolean, date or list), allowed values and whether
+they are required are declared under 'fields' in the model.yaml file of the model.
+Values of list fields are comma separated. An empty value removes the field.
+
+Examples:
+  adg set-field --id 0003 risk=high
+  adg set-field --id 0003 cost_center=4711 components=api,frontend
+  adg set-field --id 0003 component=`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("at least one field must be provided as <field>=<value>")
+			}
+
+			values := make(map[string]string, len(args))
+			for _, arg := range args {
+				name, value, ok := strings.Cut(arg, "=")
+				name = strings.TrimSpace(name)
+				if !ok || name == "" {
+					return fmt.Errorf("invalid field assignment %q, expected <field>=<value>", arg)
+				}
+				values[name] = value
+			}
+
+			err := util.ResolveIdOrTitle(idOrTitle, &id, &title)
+			if err != nil {
+				return err
+			}
+
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			return input.SetFields(modelPath, id, title, values)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or title of the decision (e.g. 0001, 'my-decision')")
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSetFieldCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionSetField)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("SetFields", "resolvedPath", "0003", "", map[string]string{"risk": "high", "component": ""}).Return(nil)
+
+	cmd := NewSetFieldCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0003", "risk=high", "component="})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewSetFieldCommand_InvalidAssignment(t *testing.T) {
+	mockInput := new(in_mocks.DecisionSetField)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewSetFieldCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0003", "risk"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, `invalid field assignment "risk", expected <field>=<value>`)
+}
+
+func TestNewSetFieldCommand_NoFields(t *testing.T) {
+	mockInput := new(in_mocks.DecisionSetField)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewSetFieldCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0003"})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, "at least one field must be provided as <field>=<value>")
+}
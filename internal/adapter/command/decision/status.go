@@ -0,0 +1,62 @@
+package decision
+
+import (
+	"fmt"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewStatusCommand(input inputport.DecisionStatus, config domain.ConfigService) *cobra.Command {
+	var modelPath, idOrTitle, id, title, status, reason, authorFlag string
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Changes the status of a decision following the model's lifecycle",
+		Long: `Changes the status of a decision.
+
+Only transitions allowed by the model's lifecycle are accepted. The lifecycle can be
+configured per model in a 'model.yaml' file next to the index. Every status change is
+recorded as a comment on the decision.
+
+Examples:
+  adg status --id 0007 --to deprecated
+  adg status --id 0007 --to superseded --reason "Replaced by 0012"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if status == "" {
+				return fmt.Errorf("the new status must be provided via --to")
+			}
+
+			err := util.ResolveIdOrTitle(idOrTitle, &id, &title)
+			if err != nil {
+				return err
+			}
+
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			author := authorFlag
+			if author == "" {
+				author = config.GetAuthor()
+			}
+			if author == "" {
+				return fmt.Errorf("author must be provided using --author or set in config")
+			}
+
+			return input.ChangeStatus(modelPath, id, title, status, reason, author)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the model directory (optional if configured)")
+	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or title of the decision (e.g. 0001, 'my-decision')")
+	cmd.Flags().StringVar(&status, "to", "", "New status of the decision")
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason for the status change (added to the comment)")
+	cmd.Flags().StringVar(&authorFlag, "author", "", "Name of the person changing the status (overrides config)")
+
+	return cmd
+}
@@ -0,0 +1,70 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStatusCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionStatus)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockConfig.On("GetAuthor").Return("alice")
+	mockInput.On("ChangeStatus", "resolvedPath", "0007", "", "deprecated", "outdated", "alice").Return(nil)
+
+	cmd := NewStatusCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0007", "--to", "deprecated", "--reason", "outdated"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewStatusCommand_AuthorFlagOverridesConfig(t *testing.T) {
+	mockInput := new(in_mocks.DecisionStatus)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("ChangeStatus", "resolvedPath", "", "my-decision", "rejected", "", "bob").Return(nil)
+
+	cmd := NewStatusCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "my-decision", "--to", "rejected", "--author", "bob"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+	mockConfig.AssertNotCalled(t, "GetAuthor")
+}
+
+func TestNewStatusCommand_MissingAuthor(t *testing.T) {
+	mockInput := new(in_mocks.DecisionStatus)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockConfig.On("GetAuthor").Return("")
+
+	cmd := NewStatusCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0007", "--to", "deprecated"})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "author must be provided")
+}
+
+func TestNewStatusCommand_MissingTo(t *testing.T) {
+	mockInput := new(in_mocks.DecisionStatus)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewStatusCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0007"})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "the new status must be provided via --to")
+}
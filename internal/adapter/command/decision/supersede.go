@@ -0,0 +1,72 @@
+package decision
+
+import (
+	"fmt"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewSupersedeCommand(input inputport.DecisionSupersede, config domain.ConfigService) *cobra.Command {
+	var modelPath, idOrTitle, id, title, byIdOrTitle, byID, byTitle, newTitle, reason, authorFlag string
+
+	cmd := &cobra.Command{
+		Use:   "supersede",
+		Short: "Marks a decision as superseded by another decision",
+		Long: `Marks a decision as superseded and links it to the decision replacing it.
+
+The replacement is either an existing decision (--by) or a new decision created
+in the same step (--new). The superseded decision gets a "superseded by" link, the
+replacement a "supersedes" link, and a notice pointing to the replacement is added
+at the top of the superseded decision. The status change is recorded as a comment.
+
+Superseded decisions are hidden by 'adg list' unless --all is given.
+
+Examples:
+  adg supersede --id 0003 --by 0011
+  adg supersede --id 0003 --new "use managed database" --reason "Operations cost"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (byIdOrTitle == "") == (newTitle == "") {
+				return fmt.Errorf("exactly one of --by or --new must be provided")
+			}
+
+			err := util.ResolveIdOrTitle(idOrTitle, &id, &title)
+			if err != nil {
+				return err
+			}
+
+			if byIdOrTitle != "" {
+				if err := util.ResolveIdOrTitle(byIdOrTitle, &byID, &byTitle); err != nil {
+					return fmt.Errorf("you must specify the replacement via --by by either providing the numbered id (e.g., 0001) or the name of the decision (e.g, 'my-decision')")
+				}
+			}
+
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			author := authorFlag
+			if author == "" {
+				author = config.GetAuthor()
+			}
+			if author == "" {
+				return fmt.Errorf("author must be provided using --author or set in config")
+			}
+
+			return input.Supersede(modelPath, id, title, byID, byTitle, newTitle, reason, author)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the model directory (optional if configured)")
+	cmd.Flags().StringVar(&idOrTitle, "id", "", "ID or title of the decision to supersede (e.g. 0001, 'my-decision')")
+	cmd.Flags().StringVar(&byIdOrTitle, "by", "", "ID or title of the existing decision replacing it")
+	cmd.Flags().StringVar(&newTitle, "new", "", "Title of a new decision to create as the replacement")
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason for superseding the decision (added to the comment)")
+	cmd.Flags().StringVar(&authorFlag, "author", "", "Name of the person superseding the decision (overrides config)")
+
+	return cmd
+}
@@ -0,0 +1,71 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSupersedeCommand_ByExistingDecision(t *testing.T) {
+	mockInput := new(in_mocks.DecisionSupersede)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockConfig.On("GetAuthor").Return("alice")
+	mockInput.On("Supersede", "resolvedPath", "0003", "", "0011", "", "", "", "alice").Return(nil)
+
+	cmd := NewSupersedeCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0003", "--by", "0011"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewSupersedeCommand_WithNewDecision(t *testing.T) {
+	mockInput := new(in_mocks.DecisionSupersede)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Supersede", "resolvedPath", "0003", "", "", "", "use managed database", "cost", "bob").Return(nil)
+
+	cmd := NewSupersedeCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0003", "--new", "use managed database", "--reason", "cost", "--author", "bob"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewSupersedeCommand_RequiresExactlyOneReplacement(t *testing.T) {
+	mockInput := new(in_mocks.DecisionSupersede)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewSupersedeCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0003"})
+	assert.ErrorContains(t, cmd.Execute(), "exactly one of --by or --new")
+
+	cmd = NewSupersedeCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0003", "--by", "0011", "--new", "replacement"})
+	assert.ErrorContains(t, cmd.Execute(), "exactly one of --by or --new")
+}
+
+func TestNewSupersedeCommand_MissingAuthor(t *testing.T) {
+	mockInput := new(in_mocks.DecisionSupersede)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockConfig.On("GetAuthor").Return("")
+
+	cmd := NewSupersedeCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0003", "--by", "0011"})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "author must be provided")
+}
@@ -21,6 +21,7 @@ func NewTagCommand(input inputport.DecisionTag, config domain.ConfigService) *co
 		Long: `Categorizes a decision by adding one or more tags to its metadata.
 
 You can provide tags either as positional arguments or via the --tag flag.
+Use the subcommands 'rename' and 'merge' to change tags across all decisions of the model.
 
 Examples:
   adg tag --id 0001 architecture urgent
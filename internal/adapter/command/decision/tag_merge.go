@@ -0,0 +1,46 @@
+package decision
+
+import (
+	"fmt"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewTagMergeCommand(input inputport.DecisionTagMerge, config domain.ConfigService) *cobra.Command {
+	var modelPath, into string
+
+	cmd := &cobra.Command{
+		Use:   "merge <tags...> --into <tag>",
+		Short: "Merges several tags into one tag in every decision of the model",
+		Long: `Replaces each of the given tags with the target tag in every decision of the model
+and updates the index. The target tag does not need to exist yet.
+
+Examples:
+  adg tag merge sec secu --into security`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("at least one tag to merge must be specified")
+			}
+
+			if into == "" {
+				return fmt.Errorf("the target tag must be provided via --into")
+			}
+
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			return input.MergeTags(modelPath, args, into)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+	cmd.Flags().StringVar(&into, "into", "", "Tag that replaces all merged tags")
+
+	return cmd
+}
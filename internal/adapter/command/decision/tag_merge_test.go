@@ -0,0 +1,37 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTagMergeCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionTagMerge)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("MergeTags", "resolvedPath", []string{"sec", "secu"}, "security").Return(nil)
+
+	cmd := NewTagMergeCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"sec", "secu", "--into", "security"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewTagMergeCommand_MissingInto(t *testing.T) {
+	mockInput := new(in_mocks.DecisionTagMerge)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewTagMergeCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"sec", "secu"})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "--into")
+}
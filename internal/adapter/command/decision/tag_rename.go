@@ -0,0 +1,42 @@
+package decision
+
+import (
+	"fmt"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewTagRenameCommand(input inputport.DecisionTagRename, config domain.ConfigService) *cobra.Command {
+	var modelPath string
+
+	cmd := &cobra.Command{
+		Use:   "rename <old-tag> <new-tag>",
+		Short: "Renames a tag in every decision of the model",
+		Long: `Renames a tag in every decision of the model and updates the index.
+
+If a decision already carries the new tag, the old tag is simply removed from it.
+
+Examples:
+  adg tag rename sec security`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return fmt.Errorf("the old and the new tag must be provided, e.g. 'adg tag rename sec security'")
+			}
+
+			modelPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			return input.RenameTag(modelPath, args[0], args[1])
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+
+	return cmd
+}
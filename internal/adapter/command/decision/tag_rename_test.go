@@ -0,0 +1,56 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTagRenameCommand_ValidExecution(t *testing.T) {
+	mockInput := new(in_mocks.DecisionTagRename)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("RenameTag", "resolvedPath", "perf", "performance").Return(nil)
+
+	cmd := NewTagRenameCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"perf", "performance"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewTagRenameCommand_WrongArgumentCount(t *testing.T) {
+	mockInput := new(in_mocks.DecisionTagRename)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	cmd := NewTagRenameCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"perf"})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "the old and the new tag must be provided")
+}
+
+func TestTagCommand_DispatchesToRenameSubcommand(t *testing.T) {
+	mockTag := new(in_mocks.DecisionTag)
+	mockRename := new(in_mocks.DecisionTagRename)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockRename.On("RenameTag", "resolvedPath", "perf", "performance").Return(nil)
+
+	cmd := NewTagCommand(mockTag, mockConfig)
+	cmd.AddCommand(NewTagRenameCommand(mockRename, mockConfig))
+	cmd.SetArgs([]string{"rename", "perf", "performance"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockRename.AssertExpectations(t)
+	mockTag.AssertNotCalled(t, "Tag")
+}
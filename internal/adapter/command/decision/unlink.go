@@ -0,0 +1,69 @@
+package decision
+
+import (
+	"fmt"
+
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewUnlinkCommand(input inputport.DecisionUnlink, config domain.ConfigService) *cobra.Command {
+	var modelPath, fromIdOrTitle, fromID, fromTitle, toIdOrTitle, toID, toTitle string
+	var tag, reverseTag string
+	var err error
+
+	cmd := &cobra.Command{
+		Use:   "unlink",
+		Short: "Removes links between two decisions",
+		Long: `Removes the link from a source decision (--from) to a target decision (--to) together with its reverse entry.
+
+Default behavior:
+  - If no --tag or --reverse-tag is provided, every link between the two decisions is removed in both directions.
+
+Tag behavior:
+  - With --tag, only links with this tag are removed. "precedes" and "succeeds" may be used here.
+  - The reverse entry defaults to "succeeds" for "precedes" (and vice versa), to the inverse declared for the
+    link type in the model settings and to the same tag otherwise.
+    Use --reverse-tag if the link was created with a different reverse tag.
+
+Examples:
+  adg unlink --from 0001 --to 0002
+  adg unlink --from 0001 --to 0002 --tag precedes
+  adg unlink --from 0003 --to 0011 --tag "superseded by" --reverse-tag supersedes`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modelPath, err = util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			if err := util.ResolveIdOrTitle(fromIdOrTitle, &fromID, &fromTitle); err != nil {
+				return fmt.Errorf("you must specify the decisions via --from by either providing the numbered id (e.g., 0001) or the name of the decision (e.g, 'my-decision')")
+			}
+
+			if err := util.ResolveIdOrTitle(toIdOrTitle, &toID, &toTitle); err != nil {
+				return fmt.Errorf("you must specify the decisions via --to by either providing the numbered id (e.g., 0001) or the name of the decision (e.g, 'my-decision')")
+			}
+
+			if (fromID == "" && fromTitle == "") || (toID == "" && toTitle == "") {
+				return fmt.Errorf("must provide both --from and --to with either ID or title")
+			}
+
+			if tag == "" && reverseTag != "" {
+				return fmt.Errorf("--reverse-tag can only be used together with --tag")
+			}
+
+			return input.Unlink(modelPath, fromID, fromTitle, toID, toTitle, tag, reverseTag)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model (optional if set in config)")
+	cmd.Flags().StringVar(&fromIdOrTitle, "from", "", "ID or title of the source decision (e.g. 0001, 'my-decision')")
+	cmd.Flags().StringVar(&toIdOrTitle, "to", "", "ID or title of the target decision (e.g. 0002, 'other-decision')")
+	cmd.Flags().StringVar(&tag, "tag", "", `Tag of the link to remove (e.g. "precedes" or "invalidated by"). Removes all links if omitted.`)
+	cmd.Flags().StringVar(&reverseTag, "reverse-tag", "", `Tag of the reverse link to remove (e.g. "invalidates"). Defaults to the inverse of --tag.`)
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUnlinkCommand_WithoutTag(t *testing.T) {
+	mockInput := new(in_mocks.DecisionUnlink)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Unlink", "resolvedPath", "0001", "", "0002", "", "", "").Return(nil)
+
+	cmd := NewUnlinkCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--from", "0001", "--to", "0002"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewUnlinkCommand_TagWithoutReverseTag(t *testing.T) {
+	mockInput := new(in_mocks.DecisionUnlink)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Unlink", "resolvedPath", "0001", "", "0002", "", "precedes", "").Return(nil)
+	mockInput.On("Unlink", "resolvedPath", "0001", "", "0002", "", "relates", "").Return(nil)
+
+	cmd := NewUnlinkCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--from", "0001", "--to", "0002", "--tag", "precedes"})
+	assert.NoError(t, cmd.Execute())
+
+	cmd = NewUnlinkCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--from", "0001", "--to", "0002", "--tag", "relates"})
+	assert.NoError(t, cmd.Execute())
+
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewUnlinkCommand_ReverseTagWithoutTag(t *testing.T) {
+	mockInput := new(in_mocks.DecisionUnlink)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewUnlinkCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--from", "0001", "--to", "0002", "--reverse-tag", "supersedes"})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "--reverse-tag can only be used together with --tag")
+}
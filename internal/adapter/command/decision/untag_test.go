@@ -0,0 +1,40 @@
+package decision
+
+import (
+	"testing"
+
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUntagCommand_WithPositionalArgs(t *testing.T) {
+	mockInput := new(in_mocks.DecisionUntag)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+	mockInput.On("Untag", "resolvedPath", "0001", "", []string{"architecture", "urgent"}).Return(nil)
+
+	cmd := NewUntagCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001", "architecture", "urgent"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewUntagCommand_ErrorWhenNoTagsProvided(t *testing.T) {
+	mockInput := new(in_mocks.DecisionUntag)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockConfig.On("IsLoaded").Return(true)
+	mockConfig.On("GetDefaultModelPath").Return("resolvedPath")
+
+	cmd := NewUntagCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--id", "0001"})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "at least one tag must be specified")
+}
@@ -11,7 +11,7 @@ import (
 
 func NewCopyCommand(input inputport.ModelCopy, config domain.ConfigService) *cobra.Command {
 	var modelPath, targetPath, idFilter, titlePattern string
-	var tagFilters, statusFilters []string
+	var tagFilters, statusFilters, whereFilters []string
 
 	cmd := &cobra.Command{
 		Use:   "copy",
@@ -39,6 +39,9 @@ func NewCopyCommand(input inputport.ModelCopy, config domain.ConfigService) *cob
 			if idFilter != "" {
 				filters["id"] = []string{idFilter}
 			}
+			if len(whereFilters) > 0 {
+				filters["where"] = whereFilters
+			}
 
 			return input.Copy(modelPath, targetPath, filters)
 		},
@@ -50,6 +53,7 @@ func NewCopyCommand(input inputport.ModelCopy, config domain.ConfigService) *cob
 	cmd.Flags().StringArrayVar(&statusFilters, "status", []string{}, "Filter by status (repeatable)")
 	cmd.Flags().StringVar(&titlePattern, "title", "", "Regex pattern to match titles")
 	cmd.Flags().StringVar(&idFilter, "id", "", "Match specific IDs or ranges (e.g. 0001,0003-0005)")
+	cmd.Flags().StringArrayVar(&whereFilters, "where", []string{}, "Filter by a query, e.g. \"status = open and tag = security\" (repeatable)")
 
 	return cmd
 }
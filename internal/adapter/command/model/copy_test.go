@@ -52,6 +52,29 @@ func TestNewCopyCommand_UsesDefaultModelFromConfig(t *testing.T) {
 	mockCfg.AssertExpectations(t)
 }
 
+func TestNewCopyCommand_Where(t *testing.T) {
+	mockInput := new(in_mocks.ModelCopy)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	mockInput.On("Copy", "source/model", "target/path", map[string][]string{
+		"where": {"status = open", `links."superseded by"`},
+	}).Return(nil)
+
+	cmd := NewCopyCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{
+		"--model", "source/model",
+		"--target", "target/path",
+		"--where", "status = open",
+		"--where", `links."superseded by"`,
+	})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	mockInput.AssertExpectations(t)
+	mockCfg.AssertNotCalled(t, "GetDefaultModelPath")
+}
+
 func TestNewCopyCommand_InputReturnsError(t *testing.T) {
 	mockInput := new(in_mocks.ModelCopy)
 	mockCfg := new(svc_mocks.ConfigService)
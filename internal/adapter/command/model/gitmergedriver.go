@@ -0,0 +1,64 @@
+package model
+
+import (
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func NewGitMergeDriverCommand(input inputport.ModelGitMergeDriver, config domain.ConfigService) *cobra.Command {
+	var modelPath string
+	var install bool
+
+	cmd := &cobra.Command{
+		Use:   "git-merge-driver <base> <ours> <theirs> <path>",
+		Short: "Merges the index and decision files of a model in git merges",
+		Long: `Merges the index and decision files of a model when git merges branches. Git calls the
+merge driver with the common base, our and their version of a file and its path. The result is
+written to our version.
+
+The decisions of index.yaml are merged by ID. If both branches added a decision with the same ID,
+the index keeps ours and 'adg resolve-collisions' gives the newer decision a new ID after the
+merge. Other conflicts in the index keep our version, 'adg rebuild' recreates it from the
+decision files. The frontmatter of decision files is merged by key, links, tags and other lists
+by item, and the text line by line. Conflicts are marked in the decision file as usual.
+
+--install registers the driver in the git configuration of the repository and assigns the index
+and decision files of the model to it in the .gitattributes file of the repository. The
+.gitattributes file should be committed, the git configuration has to be installed in every clone.
+
+Examples:
+  adg git-merge-driver --install
+  adg git-merge-driver --install --model models/platform`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if install {
+				return cobra.NoArgs(cmd, args)
+			}
+			if len(args) != 4 {
+				return fmt.Errorf("expected the base, our and their version and the path of the file, got %d arguments (use --install to set up the driver)", len(args))
+			}
+			return nil
+		},
+		// git shows the output of merge drivers, the usage would only add noise
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !install {
+				return input.Merge(args[0], args[1], args[2], args[3])
+			}
+
+			resolvedPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+			return input.Install(resolvedPath)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model directory (optional if configured)")
+	cmd.Flags().BoolVar(&install, "install", false, "Register the merge driver for the model in its git repository")
+
+	return cmd
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGitMergeDriverCommand_Merge(t *testing.T) {
+	mockInput := new(in_mocks.ModelGitMergeDriver)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	mockInput.On("Merge", ".merge_file_a", ".merge_file_b", ".merge_file_c", "m/index.yaml").Return(nil)
+
+	cmd := NewGitMergeDriverCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{".merge_file_a", ".merge_file_b", ".merge_file_c", "m/index.yaml"})
+
+	err := cmd.Execute()
+
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewGitMergeDriverCommand_MergeConflict(t *testing.T) {
+	mockInput := new(in_mocks.ModelGitMergeDriver)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	mockInput.On("Merge", "a", "b", "c", "m/AD0001-a.md").Return(errors.New("m/AD0001-a.md has conflicts that have to be resolved by hand"))
+
+	cmd := NewGitMergeDriverCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{"a", "b", "c", "m/AD0001-a.md"})
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "m/AD0001-a.md has conflicts that have to be resolved by hand")
+}
+
+func TestNewGitMergeDriverCommand_MissingArguments(t *testing.T) {
+	mockInput := new(in_mocks.ModelGitMergeDriver)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	cmd := NewGitMergeDriverCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{"a", "b"})
+
+	err := cmd.Execute()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "got 2 arguments")
+	mockInput.AssertNotCalled(t, "Merge")
+}
+
+func TestNewGitMergeDriverCommand_Install(t *testing.T) {
+	mockInput := new(in_mocks.ModelGitMergeDriver)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	mockCfg.On("IsLoaded").Return(true)
+	mockCfg.On("GetDefaultModelPath").Return("default/model")
+	mockInput.On("Install", "default/model").Return(nil)
+
+	cmd := NewGitMergeDriverCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{"--install"})
+
+	err := cmd.Execute()
+
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewGitMergeDriverCommand_InstallRejectsArguments(t *testing.T) {
+	mockInput := new(in_mocks.ModelGitMergeDriver)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	cmd := NewGitMergeDriverCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{"--install", "a"})
+
+	err := cmd.Execute()
+
+	assert.Error(t, err)
+	mockInput.AssertNotCalled(t, "Install")
+}
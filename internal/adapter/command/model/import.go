@@ -11,7 +11,7 @@ import (
 
 func NewImportCommand(input inputport.ModelImport, config domain.ConfigService) *cobra.Command {
 	var modelPath, sourcePath, idFilter, titlePattern string
-	var tagFilters, statusFilters []string
+	var tagFilters, statusFilters, whereFilters []string
 
 	cmd := &cobra.Command{
 		Use:   "import",
@@ -42,6 +42,9 @@ IDs will be renumbered automatically to avoid conflicts.`,
 			if idFilter != "" {
 				filters["id"] = []string{idFilter}
 			}
+			if len(whereFilters) > 0 {
+				filters["where"] = whereFilters
+			}
 
 			return input.Import(sourcePath, modelPath, filters)
 		},
@@ -53,6 +56,7 @@ IDs will be renumbered automatically to avoid conflicts.`,
 	cmd.Flags().StringArrayVar(&statusFilters, "status", []string{}, "Filter by status (repeatable)")
 	cmd.Flags().StringVar(&titlePattern, "title", "", "Regex pattern to match titles")
 	cmd.Flags().StringVar(&idFilter, "id", "", "Match specific IDs or ranges (e.g. 0001,0003-0005)")
+	cmd.Flags().StringArrayVar(&whereFilters, "where", []string{}, "Filter by a query, e.g. \"status = open and tag = security\" (repeatable)")
 
 	return cmd
 }
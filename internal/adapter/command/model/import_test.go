@@ -52,6 +52,30 @@ func TestNewImportCommand_SuccessfulImport(t *testing.T) {
 	mockCfg.AssertExpectations(t)
 }
 
+func TestNewImportCommand_Where(t *testing.T) {
+	mockInput := new(in_mocks.ModelImport)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	mockInput.On("Import", "source/model", "target/model", map[string][]string{
+		"tag":   {"critical"},
+		"where": {"status = open and not links.'revised by'"},
+	}).Return(nil)
+
+	cmd := NewImportCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{
+		"--source", "source/model",
+		"--model", "target/model",
+		"--tag", "critical",
+		"--where", "status = open and not links.'revised by'",
+	})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	mockInput.AssertExpectations(t)
+	mockCfg.AssertNotCalled(t, "GetDefaultModelPath")
+}
+
 func TestNewImportCommand_InputReturnsError(t *testing.T) {
 	mockInput := new(in_mocks.ModelImport)
 	mockCfg := new(svc_mocks.ConfigService)
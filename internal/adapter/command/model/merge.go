@@ -9,7 +9,7 @@ import (
 
 func NewMergeModelsCommand(input inputport.ModelMerge) *cobra.Command {
 	var modelAPath, modelBPath, targetPath, idFilter, titlePattern string
-	var tagFilters, statusFilters []string
+	var tagFilters, statusFilters, whereFilters []string
 
 	cmd := &cobra.Command{
 		Use:   "merge",
@@ -36,6 +36,9 @@ IDs will be renumbered if needed to ensure uniqueness.`,
 			if idFilter != "" {
 				filters["id"] = []string{idFilter}
 			}
+			if len(whereFilters) > 0 {
+				filters["where"] = whereFilters
+			}
 
 			return input.Merge(modelAPath, modelBPath, targetPath, filters)
 		},
@@ -48,6 +51,7 @@ IDs will be renumbered if needed to ensure uniqueness.`,
 	cmd.Flags().StringArrayVar(&statusFilters, "status", []string{}, "Filter by status (repeatable)")
 	cmd.Flags().StringVar(&titlePattern, "title", "", "Regex pattern to match titles")
 	cmd.Flags().StringVar(&idFilter, "id", "", "Match specific IDs or ranges (e.g. 0001,0003-0005)")
+	cmd.Flags().StringArrayVar(&whereFilters, "where", []string{}, "Filter by a query, e.g. \"status = open and tag = security\" (repeatable)")
 
 	return cmd
 }
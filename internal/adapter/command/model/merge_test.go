@@ -64,3 +64,22 @@ func TestNewMergeModelsCommand_InputReturnsError(t *testing.T) {
 	err := cmd.Execute()
 	assert.EqualError(t, err, "merge failed")
 }
+
+func TestNewMergeModelsCommand_Where(t *testing.T) {
+	mockInput := new(in_mocks.ModelMerge)
+	mockInput.
+		On("Merge", "modelA", "modelB", "target", map[string][]string{"where": {"title = 'Kafka, Redis'"}}).
+		Return(nil)
+
+	cmd := NewMergeModelsCommand(mockInput)
+	cmd.SetArgs([]string{
+		"--model1", "modelA",
+		"--model2", "modelB",
+		"--target", "target",
+		"--where", "title = 'Kafka, Redis'",
+	})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
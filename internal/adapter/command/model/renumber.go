@@ -0,0 +1,54 @@
+package model
+
+import (
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+	decision "github.com/adr/ad-guidance-tool/internal/domain/decision"
+
+	"github.com/spf13/cobra"
+)
+
+func NewRenumberCommand(input inputport.ModelRenumber, config domain.ConfigService) *cobra.Command {
+	var modelPath, order string
+	var mappings []string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "renumber",
+		Short: "Renumbers the decisions of a model to close gaps in their IDs",
+		Long: `Assigns new IDs to the decisions of a model. By default, the decisions are numbered
+contiguously in the order of their current IDs (or of their creation dates with --order created),
+following the ID settings of the model. IDs of archived decisions are skipped. With --map, only
+the given decisions get the given IDs.
+
+The decision files and their rule files are renamed, and the links of all decisions, links to the
+renamed files in the text of the decisions, the adr headers of rule files and the index are
+updated. Links from other models are not updated.
+
+Examples:
+  adg renumber --dry-run
+  adg renumber --model models/platform --order created
+  adg renumber --map 0007=0003,0009=0004`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resolvedPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			mapping, err := decision.ParseIDMapping(mappings)
+			if err != nil {
+				return err
+			}
+
+			return input.Renumber(resolvedPath, order, mapping, dryRun)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model directory (optional if configured)")
+	cmd.Flags().StringVar(&order, "order", decision.RenumberByID, "Order of the new IDs: id or created")
+	cmd.Flags().StringArrayVar(&mappings, "map", nil, "New IDs of single decisions as <old>=<new> (e.g. 0007=0003,0009=0004) (repeatable)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only print the new IDs without changing the model")
+
+	return cmd
+}
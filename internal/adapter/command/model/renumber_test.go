@@ -0,0 +1,69 @@
+package model
+
+import (
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRenumberCommand_Defaults(t *testing.T) {
+	mockInput := new(in_mocks.ModelRenumber)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	mockCfg.On("IsLoaded").Return(true)
+	mockCfg.On("GetDefaultModelPath").Return("default/model")
+	mockInput.On("Renumber", "default/model", "id", map[string]string{}, false).Return(nil)
+
+	cmd := NewRenumberCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewRenumberCommand_MappingAndDryRun(t *testing.T) {
+	mockInput := new(in_mocks.ModelRenumber)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	mockInput.On("Renumber", "my/model", "created", map[string]string{"0007": "0003", "0009": "0004"}, true).Return(nil)
+
+	cmd := NewRenumberCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{"--model", "my/model", "--order", "created", "--map", "0007=0003,0009=0004", "--dry-run"})
+
+	err := cmd.Execute()
+
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewRenumberCommand_InvalidMapping(t *testing.T) {
+	mockInput := new(in_mocks.ModelRenumber)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	cmd := NewRenumberCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{"--model", "my/model", "--map", "0007"})
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, `invalid ID mapping "0007", use <old>=<new>`)
+	mockInput.AssertNotCalled(t, "Renumber")
+}
+
+func TestNewRenumberCommand_InputReturnsError(t *testing.T) {
+	mockInput := new(in_mocks.ModelRenumber)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	mockInput.On("Renumber", "my/model", "id", map[string]string{}, false).Return(errors.New("renumbering failed"))
+
+	cmd := NewRenumberCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{"--model", "my/model"})
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "renumbering failed")
+}
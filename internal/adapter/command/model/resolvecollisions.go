@@ -0,0 +1,45 @@
+package model
+
+import (
+	util "github.com/adr/ad-guidance-tool/internal/adapter/command"
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewResolveCollisionsCommand(input inputport.ModelResolveCollisions, config domain.ConfigService) *cobra.Command {
+	var modelPath string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "resolve-collisions",
+		Short: "Gives decisions that share an ID with another decision a new ID",
+		Long: `Resolves the duplicate IDs of decisions that were added on different branches, which is detected
+by 'adg rebuild' and 'adg validate' after a merge. For every ID used by several decisions, the
+oldest decision keeps the ID and the others get the next free IDs. Decisions created on the same
+day are ordered by their file path.
+
+The files of the renumbered decisions and their rule files are renamed, and links to the renamed
+files in the text of the decisions are updated. A link to a shared ID is kept for the decision
+that links back to the linking decision and redirected to the new ID of the other one, links of
+both decisions point to both. Finally, the index is rebuilt.
+
+Examples:
+  adg resolve-collisions --dry-run
+  adg resolve-collisions --model models/platform`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resolvedPath, err := util.ResolveModelPathOrDefault(modelPath, config)
+			if err != nil {
+				return err
+			}
+
+			return input.ResolveCollisions(resolvedPath, dryRun)
+		},
+	}
+
+	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model directory (optional if configured)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only print the new IDs without changing the model")
+
+	return cmd
+}
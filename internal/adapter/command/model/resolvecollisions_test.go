@@ -0,0 +1,42 @@
+package model
+
+import (
+	in_mocks "github.com/adr/ad-guidance-tool/mocks/inputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewResolveCollisionsCommand_DefaultModel(t *testing.T) {
+	mockInput := new(in_mocks.ModelResolveCollisions)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	mockCfg.On("IsLoaded").Return(true)
+	mockCfg.On("GetDefaultModelPath").Return("default/model")
+	mockInput.On("ResolveCollisions", "default/model", false).Return(nil)
+
+	cmd := NewResolveCollisionsCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
+}
+
+func TestNewResolveCollisionsCommand_DryRun(t *testing.T) {
+	mockInput := new(in_mocks.ModelResolveCollisions)
+	mockCfg := new(svc_mocks.ConfigService)
+
+	mockInput.On("ResolveCollisions", "my/model", true).Return(errors.New("resolving collisions failed"))
+
+	cmd := NewResolveCollisionsCommand(mockInput, mockCfg)
+	cmd.SetArgs([]string{"--model", "my/model", "--dry-run"})
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "resolving collisions failed")
+	mockInput.AssertExpectations(t)
+}
@@ -10,21 +10,35 @@ import (
 
 func NewValidateCommand(input inputport.ModelValidate, config domain.ConfigService) *cobra.Command {
 	var modelPath string
+	var fix bool
 
 	cmd := &cobra.Command{
 		Use:   "validate",
 		Short: "Validate the models decisions by checking if the files match the index file",
+		Long: `Validates the decisions of a model:
+  - the metadata of the decision files matches the index file,
+  - the decision files contain the required section anchors and valid metadata,
+  - the links are consistent: every link points to an existing decision other than the decision
+    itself, has its reverse entry on the target, respects the cardinality of its link type and
+    precedes links as well as links of acyclic link types do not form cycles.
+
+With --fix, missing reverse entries of links are added. The other problems have to be fixed by hand.
+
+Examples:
+  adg validate
+  adg validate --model models/platform --fix`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			resolvedPath, err := util.ResolveModelPathOrDefault(modelPath, config)
 			if err != nil {
 				return err
 			}
 
-			return input.Validate(resolvedPath)
+			return input.Validate(resolvedPath, fix)
 		},
 	}
 
 	cmd.Flags().StringVar(&modelPath, "model", "", "Path to the decision model directory (optional if configured)")
+	cmd.Flags().BoolVar(&fix, "fix", false, "Add missing reverse entries of links")
 
 	return cmd
 }
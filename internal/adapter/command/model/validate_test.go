@@ -15,7 +15,7 @@ func TestNewValidateCommand_Success(t *testing.T) {
 
 	mockConfig.On("IsLoaded").Return(true)
 	mockConfig.On("GetDefaultModelPath").Return("resolvedModelPath")
-	mockInput.On("Validate", "resolvedModelPath").Return(nil)
+	mockInput.On("Validate", "resolvedModelPath", false).Return(nil)
 
 	cmd := NewValidateCommand(mockInput, mockConfig)
 	cmd.SetArgs([]string{}) // simulate no --model flag
@@ -23,7 +23,21 @@ func TestNewValidateCommand_Success(t *testing.T) {
 	err := cmd.Execute()
 	assert.NoError(t, err)
 
-	mockInput.AssertCalled(t, "Validate", "resolvedModelPath")
+	mockInput.AssertCalled(t, "Validate", "resolvedModelPath", false)
+}
+
+func TestNewValidateCommand_Fix(t *testing.T) {
+	mockInput := new(in_mocks.ModelValidate)
+	mockConfig := new(svc_mocks.ConfigService)
+
+	mockInput.On("Validate", "models", true).Return(nil)
+
+	cmd := NewValidateCommand(mockInput, mockConfig)
+	cmd.SetArgs([]string{"--model", "models", "--fix"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	mockInput.AssertExpectations(t)
 }
 
 func TestNewValidateCommand_ConfigError(t *testing.T) {
@@ -46,7 +60,7 @@ func TestNewValidateCommand_InputReturnsError(t *testing.T) {
 
 	mockConfig.On("IsLoaded").Return(true)
 	mockConfig.On("GetDefaultModelPath").Return("resolvedModelPath")
-	mockInput.On("Validate", "resolvedModelPath").Return(errors.New("validation failed"))
+	mockInput.On("Validate", "resolvedModelPath", false).Return(errors.New("validation failed"))
 
 	cmd := NewValidateCommand(mockInput, mockConfig)
 	cmd.SetArgs([]string{})
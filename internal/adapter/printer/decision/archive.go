@@ -0,0 +1,14 @@
+package decision
+
+import "fmt"
+
+type ArchiveDecisionPresenter struct{}
+
+func NewArchivePresenter() *ArchiveDecisionPresenter {
+	return &ArchiveDecisionPresenter{}
+}
+
+func (p *ArchiveDecisionPresenter) Archived(decisionID string, unlinked []string) {
+	fmt.Printf("Decision %s has been archived.\n", decisionID)
+	printUnlinked(decisionID, unlinked)
+}
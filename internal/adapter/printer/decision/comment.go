@@ -11,3 +11,11 @@ func NewCommentPresenter() *CommentDecisionPresenter {
 func (p *CommentDecisionPresenter) Commented(decisionID, author, comment string) {
 	fmt.Printf("Comment added by %s to decision %s: \"%s\"\n", author, decisionID, comment)
 }
+
+func (p *CommentDecisionPresenter) CommentEdited(decisionID string, commentID int) {
+	fmt.Printf("Comment %d of decision %s updated.\n", commentID, decisionID)
+}
+
+func (p *CommentDecisionPresenter) CommentDeleted(decisionID string, commentID int) {
+	fmt.Printf("Comment %d deleted from decision %s.\n", commentID, decisionID)
+}
@@ -18,3 +18,18 @@ func TestCommented(t *testing.T) {
 		t.Errorf("Expected output to contain: %q\nGot: %q", expected, output)
 	}
 }
+
+func TestCommentEditedAndDeleted(t *testing.T) {
+	presenter := NewCommentPresenter()
+
+	output := captureOutput(func() {
+		presenter.CommentEdited("0001", 3)
+		presenter.CommentDeleted("0001", 4)
+	})
+
+	for _, expected := range []string{"Comment 3 of decision 0001 updated.", "Comment 4 deleted from decision 0001."} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q\nGot: %q", expected, output)
+		}
+	}
+}
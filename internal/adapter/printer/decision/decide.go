@@ -1,6 +1,9 @@
 package decision
 
-import "fmt"
+import (
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
 
 type DecidePresenter struct{}
 
@@ -11,3 +14,14 @@ func NewDecidePresenter() *DecidePresenter {
 func (p *DecidePresenter) Decided(decisionID string) {
 	fmt.Printf("Decision %s has been marked as decided.\n", decisionID)
 }
+
+func (p *DecidePresenter) Recommended(decisionID string, ranking []domain.OptionScore) {
+	if len(ranking) == 0 {
+		return
+	}
+	best := ranking[0].Option
+	fmt.Printf("The decision matrix of decision %s recommends option %d (%s).\n", decisionID, best.Number, best.Title)
+	for _, r := range ranking {
+		fmt.Printf("  %d. %s: %d\n", r.Option.Number, r.Option.Title, r.Total)
+	}
+}
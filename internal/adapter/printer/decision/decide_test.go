@@ -2,6 +2,7 @@ package decision
 
 import (
 	"fmt"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
 	"strings"
 	"testing"
 
@@ -20,3 +21,20 @@ func TestDecided(t *testing.T) {
 	assert.Equal(t, expected, output)
 	assert.True(t, strings.Contains(output, decisionID))
 }
+
+func TestRecommended(t *testing.T) {
+	presenter := NewDecidePresenter()
+
+	output := captureOutput(func() {
+		presenter.Recommended("0002", []domain.OptionScore{
+			{Option: domain.Option{Number: 2, Title: "MySQL"}, Total: 18},
+			{Option: domain.Option{Number: 1, Title: "PostgreSQL"}, Total: 12},
+		})
+	})
+
+	for _, expected := range []string{"recommends option 2 (MySQL)", "2. MySQL: 18", "1. PostgreSQL: 12"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package decision
+
+import (
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type DueDecisionsPresenter struct{}
+
+func NewDuePresenter() *DueDecisionsPresenter {
+	return &DueDecisionsPresenter{}
+}
+
+func (p *DueDecisionsPresenter) Due(decisions []domain.DueDecision, within int) {
+	if len(decisions) == 0 {
+		if within > 0 {
+			fmt.Printf("No decisions are due for review in the next %d days.\n", within)
+		} else {
+			fmt.Println("No decisions are due for review.")
+		}
+		return
+	}
+
+	for _, d := range decisions {
+		fmt.Printf("%s [review by %s, %s] - %s\n", d.Decision.ID, d.Decision.ReviewBy, describeDue(d.DaysLeft), d.Decision.Title)
+	}
+}
+
+func describeDue(daysLeft int) string {
+	switch {
+	case daysLeft < 0:
+		return fmt.Sprintf("overdue by %d days", -daysLeft)
+	case daysLeft == 0:
+		return "due today"
+	default:
+		return fmt.Sprintf("due in %d days", daysLeft)
+	}
+}
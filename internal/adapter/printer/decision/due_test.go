@@ -0,0 +1,42 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"strings"
+	"testing"
+)
+
+func TestDue(t *testing.T) {
+	presenter := NewDuePresenter()
+
+	output := captureOutput(func() {
+		presenter.Due([]decision.DueDecision{
+			{Decision: decision.Decision{ID: "0002", Title: "Caching", ReviewBy: "2026-01-01"}, DaysLeft: -3},
+			{Decision: decision.Decision{ID: "0005", Title: "Hosting", ReviewBy: "2026-01-04"}, DaysLeft: 0},
+			{Decision: decision.Decision{ID: "0001", Title: "Database", ReviewBy: "2026-01-09"}, DaysLeft: 5},
+		}, 10)
+	})
+
+	for _, expected := range []string{
+		"0002 [review by 2026-01-01, overdue by 3 days] - Caching",
+		"0005 [review by 2026-01-04, due today] - Hosting",
+		"0001 [review by 2026-01-09, due in 5 days] - Database",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+}
+
+func TestDue_Empty(t *testing.T) {
+	presenter := NewDuePresenter()
+
+	output := captureOutput(func() {
+		presenter.Due(nil, 30)
+	})
+
+	expected := "No decisions are due for review in the next 30 days."
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
@@ -0,0 +1,165 @@
+package decision
+
+import (
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var nodeNamePattern = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// nodeStyle is the look of a decision in the graph, derived from the role of its status in the lifecycle.
+type nodeStyle struct {
+	class  string
+	fill   string
+	stroke string
+	dashed bool
+}
+
+var (
+	styleInitial    = nodeStyle{class: "initial", fill: "#fff3bf", stroke: "#e67700"}
+	styleDecided    = nodeStyle{class: "decided", fill: "#d3f9d8", stroke: "#2b8a3e"}
+	styleSuperseded = nodeStyle{class: "superseded", fill: "#f1f3f5", stroke: "#868e96", dashed: true}
+	styleFinal      = nodeStyle{class: "final", fill: "#ffe3e3", stroke: "#c92a2a"}
+	styleOther      = nodeStyle{class: "other", fill: "#e7f5ff", stroke: "#1971c2"}
+	styleExternal   = nodeStyle{class: "external", fill: "#f3f0ff", stroke: "#6741d9", dashed: true}
+)
+
+var nodeStyles = []nodeStyle{styleInitial, styleDecided, styleSuperseded, styleFinal, styleOther, styleExternal}
+
+type GraphPresenter struct{}
+
+func NewGraphPresenter() *GraphPresenter {
+	return &GraphPresenter{}
+}
+
+func (p *GraphPresenter) Rendered(graph domain.Graph, format string) {
+	switch strings.ToLower(format) {
+	case "mermaid":
+		fmt.Print(renderMermaid(graph))
+	case "plantuml", "puml":
+		fmt.Print(renderPlantUML(graph))
+	default:
+		fmt.Print(renderDOT(graph))
+	}
+}
+
+// styleOf returns the style of a decision. Decisions of other models follow a different lifecycle
+// and share one style.
+func styleOf(lifecycle domain.Lifecycle, d domain.Decision) nodeStyle {
+	status := d.Status
+	switch {
+	case domain.IsReference(d.ID):
+		return styleExternal
+	case status == "" || status == lifecycle.Initial:
+		return styleInitial
+	case status == lifecycle.Decided:
+		return styleDecided
+	case status == lifecycle.Superseded:
+		return styleSuperseded
+	case len(lifecycle.AllowedTransitions(status)) == 0:
+		return styleFinal
+	default:
+		return styleOther
+	}
+}
+
+// nodeName turns a decision ID into an identifier that is valid in every format.
+func nodeName(id string) string {
+	return "d" + nodeNamePattern.ReplaceAllString(id, "_")
+}
+
+func renderDOT(graph domain.Graph) string {
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+	}
+
+	var sb strings.Builder
+	sb.WriteString("digraph decisions {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	sb.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+
+	for _, d := range graph.Nodes {
+		style := styleOf(graph.Lifecycle, d)
+		attrs := fmt.Sprintf("label=%s, fillcolor=%s, color=%s", quote(d.ID+"\n"+d.Title+"\n("+d.Status+")"), quote(style.fill), quote(style.stroke))
+		if style.dashed {
+			attrs += `, style="rounded,filled,dashed"`
+		}
+		if d.ID == graph.Focus {
+			attrs += ", penwidth=3"
+		}
+		sb.WriteString(fmt.Sprintf("  %s [%s];\n", nodeName(d.ID), attrs))
+	}
+	for _, e := range graph.Edges {
+		sb.WriteString(fmt.Sprintf("  %s -> %s [label=%s];\n", nodeName(e.From), nodeName(e.To), quote(e.Type)))
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func renderMermaid(graph domain.Graph) string {
+	escape := func(s string) string {
+		return strings.NewReplacer(`"`, "#quot;", "|", "#124;", "<", "#lt;", ">", "#gt;").Replace(s)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+
+	used := make(map[string][]string)
+	for _, d := range graph.Nodes {
+		sb.WriteString(fmt.Sprintf("  %s[\"%s: %s<br/>(%s)\"]\n", nodeName(d.ID), escape(d.ID), escape(d.Title), escape(d.Status)))
+		style := styleOf(graph.Lifecycle, d)
+		used[style.class] = append(used[style.class], nodeName(d.ID))
+	}
+	for _, e := range graph.Edges {
+		sb.WriteString(fmt.Sprintf("  %s -->|%s| %s\n", nodeName(e.From), escape(e.Type), nodeName(e.To)))
+	}
+
+	for _, style := range nodeStyles {
+		if len(used[style.class]) == 0 {
+			continue
+		}
+		def := fmt.Sprintf("fill:%s,stroke:%s", style.fill, style.stroke)
+		if style.dashed {
+			def += ",stroke-dasharray:5 5"
+		}
+		sb.WriteString(fmt.Sprintf("  classDef %s %s\n", style.class, def))
+		sb.WriteString(fmt.Sprintf("  class %s %s\n", strings.Join(used[style.class], ","), style.class))
+	}
+	if graph.Focus != "" {
+		sb.WriteString(fmt.Sprintf("  style %s stroke-width:3px\n", nodeName(graph.Focus)))
+	}
+	return sb.String()
+}
+
+func renderPlantUML(graph domain.Graph) string {
+	escape := func(s string) string {
+		return strings.ReplaceAll(s, `"`, `'`)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("@startuml\n")
+	sb.WriteString("left to right direction\n")
+	sb.WriteString("skinparam roundCorner 15\n")
+
+	for _, d := range graph.Nodes {
+		style := styleOf(graph.Lifecycle, d)
+		look := fmt.Sprintf("%s;line:%s", style.fill, strings.TrimPrefix(style.stroke, "#"))
+		if style.dashed {
+			look += ";line.dashed"
+		}
+		if d.ID == graph.Focus {
+			look += ";line.bold"
+		}
+		sb.WriteString(fmt.Sprintf("rectangle \"%s\\n%s\\n(%s)\" as %s %s\n", escape(d.ID), escape(d.Title), escape(d.Status), nodeName(d.ID), look))
+	}
+	for _, e := range graph.Edges {
+		sb.WriteString(fmt.Sprintf("%s --> %s : %s\n", nodeName(e.From), nodeName(e.To), escape(e.Type)))
+	}
+
+	sb.WriteString("@enduml\n")
+	return sb.String()
+}
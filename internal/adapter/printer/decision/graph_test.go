@@ -0,0 +1,97 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"strings"
+	"testing"
+)
+
+func graphTestGraph() decision.Graph {
+	return decision.Graph{
+		Nodes: []decision.Decision{
+			{ID: "0001", Title: `Use "Kafka"`, Status: "superseded"},
+			{ID: "0002", Title: "Use Pulsar", Status: "decided"},
+			{ID: "0003", Title: "Drop SOAP", Status: "rejected"},
+		},
+		Edges: []decision.GraphEdge{
+			{From: "0001", To: "0002", Type: "superseded by"},
+			{From: "0002", To: "0003", Type: "precedes"},
+		},
+		Lifecycle: decision.DefaultLifecycle(),
+		Focus:     "0002",
+	}
+}
+
+func assertOutputContains(t *testing.T, output string, expected []string) {
+	t.Helper()
+	for _, e := range expected {
+		if !strings.Contains(output, e) {
+			t.Errorf("Expected output to contain: %q\nGot: %q", e, output)
+		}
+	}
+}
+
+func TestRendered_DOT(t *testing.T) {
+	output := captureOutput(func() {
+		NewGraphPresenter().Rendered(graphTestGraph(), "dot")
+	})
+
+	assertOutputContains(t, output, []string{
+		"digraph decisions {",
+		`d0001 [label="0001\nUse \"Kafka\"\n(superseded)", fillcolor="#f1f3f5", color="#868e96", style="rounded,filled,dashed"];`,
+		`d0002 [label="0002\nUse Pulsar\n(decided)", fillcolor="#d3f9d8", color="#2b8a3e", penwidth=3];`,
+		`d0003 [label="0003\nDrop SOAP\n(rejected)", fillcolor="#ffe3e3"`,
+		`d0001 -> d0002 [label="superseded by"];`,
+	})
+}
+
+func TestRendered_Mermaid(t *testing.T) {
+	output := captureOutput(func() {
+		NewGraphPresenter().Rendered(graphTestGraph(), "mermaid")
+	})
+
+	assertOutputContains(t, output, []string{
+		"flowchart LR",
+		`d0001["0001: Use #quot;Kafka#quot;<br/>(superseded)"]`,
+		"d0001 -->|superseded by| d0002",
+		"classDef superseded fill:#f1f3f5,stroke:#868e96,stroke-dasharray:5 5",
+		"class d0002 decided",
+		"style d0002 stroke-width:3px",
+	})
+}
+
+func TestRendered_PlantUML(t *testing.T) {
+	output := captureOutput(func() {
+		NewGraphPresenter().Rendered(graphTestGraph(), "plantuml")
+	})
+
+	assertOutputContains(t, output, []string{
+		"@startuml",
+		`rectangle "0001\nUse 'Kafka'\n(superseded)" as d0001 #f1f3f5;line:868e96;line.dashed`,
+		`rectangle "0002\nUse Pulsar\n(decided)" as d0002 #d3f9d8;line:2b8a3e;line.bold`,
+		"d0002 --> d0003 : precedes",
+		"@enduml",
+	})
+}
+
+func TestRendered_ExternalDecision(t *testing.T) {
+	graph := decision.Graph{
+		Nodes: []decision.Decision{
+			{ID: "0001", Title: "Use events", Status: "open"},
+			{ID: "platform:0012", Title: "Use Kafka", Status: "decided"},
+		},
+		Edges:     []decision.GraphEdge{{From: "0001", To: "platform:0012", Type: "depends on"}},
+		Lifecycle: decision.DefaultLifecycle(),
+	}
+
+	output := captureOutput(func() {
+		NewGraphPresenter().Rendered(graph, "mermaid")
+	})
+
+	assertOutputContains(t, output, []string{
+		`dplatform_0012["platform:0012: Use Kafka<br/>(decided)"]`,
+		"d0001 -->|depends on| dplatform_0012",
+		"classDef external fill:#f3f0ff,stroke:#6741d9,stroke-dasharray:5 5",
+		"class dplatform_0012 external",
+	})
+}
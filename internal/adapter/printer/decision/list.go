@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"os"
 	"sort"
-	"strconv"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -14,11 +13,48 @@ import (
 
 type ListDecisionsPresenter struct{}
 
+// listedDecision is a decision together with its structured options as printed by the json and
+// yaml formats. Its keys are those of the frontmatter of decision files, custom fields are listed
+// under "fields" in json.
+type listedDecision struct {
+	ID            string                    `json:"adr_id" yaml:"adr_id"`
+	Title         string                    `json:"title" yaml:"title"`
+	Status        string                    `json:"status" yaml:"status"`
+	Tags          []string                  `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Links         listedLinks               `json:"links" yaml:"links,omitempty"`
+	Comments      []listedComment           `json:"comments,omitempty" yaml:"comments,omitempty"`
+	Created       string                    `json:"created,omitempty" yaml:"created,omitempty"`
+	DecidedAt     string                    `json:"decided_at,omitempty" yaml:"decided_at,omitempty"`
+	ReviewBy      string                    `json:"review_by,omitempty" yaml:"review_by,omitempty"`
+	Deciders      []string                  `json:"deciders,omitempty" yaml:"deciders,omitempty"`
+	Consulted     []string                  `json:"consulted,omitempty" yaml:"consulted,omitempty"`
+	Informed      []string                  `json:"informed,omitempty" yaml:"informed,omitempty"`
+	ChosenOptions []int                     `json:"chosen_options,omitempty" yaml:"chosen_options,omitempty"`
+	Scores        map[string]map[string]int `json:"scores,omitempty" yaml:"scores,omitempty"`
+	Fields        map[string]any            `json:"fields,omitempty" yaml:",inline"`
+	Options       []domain.Option           `json:"options,omitempty" yaml:"options,omitempty"`
+}
+
+type listedLinks struct {
+	Precedes []string            `json:"precedes" yaml:"precedes"`
+	Succeeds []string            `json:"succeeds" yaml:"succeeds"`
+	Custom   map[string][]string `json:"custom,omitempty" yaml:"custom,omitempty"`
+}
+
+type listedComment struct {
+	ID      int    `json:"id,omitempty" yaml:"id,omitempty"`
+	Author  string `json:"author" yaml:"author"`
+	Date    string `json:"date" yaml:"date"`
+	Comment string `json:"comment" yaml:"comment"`
+	ReplyTo int    `json:"reply_to,omitempty" yaml:"reply_to,omitempty"`
+	Edited  string `json:"edited,omitempty" yaml:"edited,omitempty"`
+}
+
 func NewListPresenter() *ListDecisionsPresenter {
 	return &ListDecisionsPresenter{}
 }
 
-func (p *ListDecisionsPresenter) Listed(decisions []domain.Decision, format string) {
+func (p *ListDecisionsPresenter) Listed(decisions []domain.Decision, options map[string][]domain.Option, format string) {
 	if len(decisions) == 0 {
 		fmt.Println("Model is empty, no decisions to list.")
 		return
@@ -31,9 +67,9 @@ func (p *ListDecisionsPresenter) Listed(decisions []domain.Decision, format stri
 
 	switch strings.ToLower(format) {
 	case "json":
-		output, err = p.renderJSON(decisions)
+		output, err = p.renderJSON(withOptions(decisions, options))
 	case "yaml", "yml":
-		output, err = p.renderYAML(decisions)
+		output, err = p.renderYAML(withOptions(decisions, options))
 	case "md", "markdown":
 		output = p.renderMarkdown(decisions)
 	default:
@@ -50,17 +86,40 @@ func (p *ListDecisionsPresenter) Listed(decisions []domain.Decision, format stri
 
 func sortDecisionsByID(decisions []domain.Decision) {
 	sort.SliceStable(decisions, func(i, j int) bool {
-		id1, err1 := strconv.Atoi(decisions[i].ID)
-		id2, err2 := strconv.Atoi(decisions[j].ID)
+		return domain.CompareIDs(decisions[i].ID, decisions[j].ID) < 0
+	})
+}
 
-		if err1 != nil || err2 != nil {
-			return decisions[i].ID < decisions[j].ID
+func withOptions(decisions []domain.Decision, options map[string][]domain.Option) []listedDecision {
+	listed := make([]listedDecision, len(decisions))
+	for i, d := range decisions {
+		comments := make([]listedComment, len(d.Comments))
+		for j, c := range d.Comments {
+			comments[j] = listedComment(c)
 		}
-		return id1 < id2
-	})
+		listed[i] = listedDecision{
+			ID:            d.ID,
+			Title:         d.Title,
+			Status:        d.Status,
+			Tags:          d.Tags,
+			Links:         listedLinks(d.Links),
+			Comments:      comments,
+			Created:       d.Created,
+			DecidedAt:     d.DecidedAt,
+			ReviewBy:      d.ReviewBy,
+			Deciders:      d.Deciders,
+			Consulted:     d.Consulted,
+			Informed:      d.Informed,
+			ChosenOptions: d.ChosenOptions,
+			Scores:        d.Scores,
+			Fields:        d.Fields,
+			Options:       options[d.ID],
+		}
+	}
+	return listed
 }
 
-func (p *ListDecisionsPresenter) renderJSON(decisions []domain.Decision) (string, error) {
+func (p *ListDecisionsPresenter) renderJSON(decisions []listedDecision) (string, error) {
 	data, err := json.MarshalIndent(decisions, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal decisions to JSON: %w", err)
@@ -68,7 +127,7 @@ func (p *ListDecisionsPresenter) renderJSON(decisions []domain.Decision) (string
 	return string(data), nil
 }
 
-func (p *ListDecisionsPresenter) renderYAML(decisions []domain.Decision) (string, error) {
+func (p *ListDecisionsPresenter) renderYAML(decisions []listedDecision) (string, error) {
 	data, err := yaml.Marshal(decisions)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal decisions to YAML: %w", err)
@@ -81,6 +140,24 @@ func (p *ListDecisionsPresenter) renderMarkdown(decisions []domain.Decision) str
 	for _, d := range decisions {
 		sb.WriteString(fmt.Sprintf("### %s - %s\n", d.ID, d.Title))
 		sb.WriteString(fmt.Sprintf("- **Status:** %s\n", d.Status))
+		if d.Created != "" {
+			sb.WriteString(fmt.Sprintf("- **Created:** %s\n", d.Created))
+		}
+		if d.DecidedAt != "" {
+			sb.WriteString(fmt.Sprintf("- **Decided:** %s\n", d.DecidedAt))
+		}
+		if d.ReviewBy != "" {
+			sb.WriteString(fmt.Sprintf("- **Review by:** %s\n", d.ReviewBy))
+		}
+		if len(d.Deciders) > 0 {
+			sb.WriteString(fmt.Sprintf("- **Deciders:** %s\n", strings.Join(d.Deciders, ", ")))
+		}
+		if len(d.Consulted) > 0 {
+			sb.WriteString(fmt.Sprintf("- **Consulted:** %s\n", strings.Join(d.Consulted, ", ")))
+		}
+		if len(d.Informed) > 0 {
+			sb.WriteString(fmt.Sprintf("- **Informed:** %s\n", strings.Join(d.Informed, ", ")))
+		}
 		if len(d.Tags) > 0 {
 			sb.WriteString(fmt.Sprintf("- **Tags:** %s\n", strings.Join(d.Tags, ", ")))
 			sb.WriteString("\n")
@@ -14,14 +14,77 @@ func TestListed_JSON(t *testing.T) {
 	}
 
 	output := captureOutput(func() {
-		presenter.Listed(decisions, "json")
+		presenter.Listed(decisions, nil, "json")
 	})
 
-	if !strings.Contains(output, `"Title": "Decision A"`) || !strings.Contains(output, `"Title": "Decision B"`) {
+	if !strings.Contains(output, `"title": "Decision A"`) || !strings.Contains(output, `"title": "Decision B"`) {
 		t.Errorf("JSON output missing expected content:\n%s", output)
 	}
 }
 
+func TestListed_JSONWithOptions(t *testing.T) {
+	presenter := NewListPresenter()
+	decisions := []decision.Decision{{ID: "0001", Title: "Decision A", Status: "open"}}
+	options := map[string][]decision.Option{
+		"0001": {{Number: 1, Title: "Redis", Cons: []string{"memory bound"}}},
+	}
+
+	output := captureOutput(func() {
+		presenter.Listed(decisions, options, "json")
+	})
+
+	for _, expected := range []string{`"title": "Decision A"`, `"options": [`, `"title": "Redis"`, `"memory bound"`} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("JSON output missing %q:\n%s", expected, output)
+		}
+	}
+}
+
+func TestListed_JSONUsesLowercaseKeys(t *testing.T) {
+	presenter := NewListPresenter()
+	decisions := []decision.Decision{{
+		ID:       "0001",
+		Title:    "Decision A",
+		Status:   "decided",
+		Links:    decision.Links{Precedes: []string{"0002"}, Custom: map[string][]string{"relates": {"0003"}}},
+		Comments: []decision.Comment{{ID: 1, Author: "jane", Date: "2026-10-18", Comment: "agreed"}},
+		Fields:   map[string]any{"cost": 3},
+	}}
+
+	output := captureOutput(func() {
+		presenter.Listed(decisions, nil, "json")
+	})
+
+	for _, expected := range []string{`"adr_id": "0001"`, `"status": "decided"`, `"precedes": [`, `"custom": {`, `"author": "jane"`, `"fields": {`} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("JSON output missing %q:\n%s", expected, output)
+		}
+	}
+	for _, key := range []string{`"ID"`, `"Title"`, `"Links"`, `"Precedes"`, `"Comments"`, `"Author"`, `"Fields"`} {
+		if strings.Contains(output, key) {
+			t.Errorf("JSON output contains Go field name %s:\n%s", key, output)
+		}
+	}
+}
+
+func TestListed_YAMLWithOptions(t *testing.T) {
+	presenter := NewListPresenter()
+	decisions := []decision.Decision{{ID: "0001", Title: "Decision A", Status: "open"}}
+	options := map[string][]decision.Option{
+		"0001": {{Number: 1, Title: "Redis", Pros: []string{"fast"}}},
+	}
+
+	output := captureOutput(func() {
+		presenter.Listed(decisions, options, "yaml")
+	})
+
+	for _, expected := range []string{"title: Decision A", "options:", "title: Redis", "- fast"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("YAML output missing %q:\n%s", expected, output)
+		}
+	}
+}
+
 func TestListed_YAML(t *testing.T) {
 	presenter := NewListPresenter()
 	decisions := []decision.Decision{
@@ -29,7 +92,7 @@ func TestListed_YAML(t *testing.T) {
 	}
 
 	output := captureOutput(func() {
-		presenter.Listed(decisions, "yaml")
+		presenter.Listed(decisions, nil, "yaml")
 	})
 
 	if !strings.Contains(output, "title: Decision B") {
@@ -50,7 +113,7 @@ func TestListed_Markdown(t *testing.T) {
 	}
 
 	output := captureOutput(func() {
-		presenter.Listed(decisions, "md")
+		presenter.Listed(decisions, nil, "md")
 	})
 
 	if !strings.Contains(output, "### 0001 - Decision A") || !strings.Contains(output, "- **Precedes:** 0002") || !strings.Contains(output, "**Related:** 0004") {
@@ -65,7 +128,7 @@ func TestListed_Simple(t *testing.T) {
 	}
 
 	output := captureOutput(func() {
-		presenter.Listed(decisions, "simple")
+		presenter.Listed(decisions, nil, "simple")
 	})
 
 	if !strings.Contains(output, "0001 [open] - Simple Decision : [alpha beta]") {
@@ -73,10 +136,26 @@ func TestListed_Simple(t *testing.T) {
 	}
 }
 
+func TestListed_SortsIDsNumerically(t *testing.T) {
+	presenter := NewListPresenter()
+	decisions := []decision.Decision{
+		{ID: "10000", Title: "Later", Status: "open"},
+		{ID: "9999", Title: "Earlier", Status: "open"},
+	}
+
+	output := captureOutput(func() {
+		presenter.Listed(decisions, nil, "simple")
+	})
+
+	if strings.Index(output, "9999") > strings.Index(output, "10000") {
+		t.Errorf("Expected 9999 before 10000:\n%s", output)
+	}
+}
+
 func TestListed_EmptyModel(t *testing.T) {
 	presenter := NewListPresenter()
 	output := captureOutput(func() {
-		presenter.Listed([]decision.Decision{}, "json")
+		presenter.Listed([]decision.Decision{}, nil, "json")
 	})
 
 	if !strings.Contains(output, "Model is empty") {
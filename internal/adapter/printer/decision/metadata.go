@@ -0,0 +1,34 @@
+package decision
+
+import (
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+	"strings"
+)
+
+type MetadataDecisionPresenter struct{}
+
+func NewMetadataPresenter() *MetadataDecisionPresenter {
+	return &MetadataDecisionPresenter{}
+}
+
+func (p *MetadataDecisionPresenter) Metadata(decision *domain.Decision, updated bool) {
+	if updated {
+		fmt.Printf("Metadata of decision %s updated.\n", decision.ID)
+	} else {
+		fmt.Printf("Metadata of decision %s:\n", decision.ID)
+	}
+	fmt.Printf("  created:    %s\n", orNone(decision.Created))
+	fmt.Printf("  decided_at: %s\n", orNone(decision.DecidedAt))
+	fmt.Printf("  review_by:  %s\n", orNone(decision.ReviewBy))
+	fmt.Printf("  deciders:   %s\n", orNone(strings.Join(decision.Deciders, ", ")))
+	fmt.Printf("  consulted:  %s\n", orNone(strings.Join(decision.Consulted, ", ")))
+	fmt.Printf("  informed:   %s\n", orNone(strings.Join(decision.Informed, ", ")))
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,22 @@
+package decision
+
+import (
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"strings"
+	"testing"
+)
+
+func TestMetadata(t *testing.T) {
+	presenter := NewMetadataPresenter()
+	d := &domain.Decision{ID: "0003", Created: "2025-01-01", Deciders: []string{"alice", "bob"}}
+
+	output := captureOutput(func() {
+		presenter.Metadata(d, true)
+	})
+
+	for _, expected := range []string{"Metadata of decision 0003 updated.", "created:    2025-01-01", "decided_at: -", "deciders:   alice, bob"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package decision
+
+import (
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+	"strings"
+)
+
+type NextPresenter struct{}
+
+func NewNextPresenter() *NextPresenter {
+	return &NextPresenter{}
+}
+
+func (p *NextPresenter) Next(readiness domain.Readiness) {
+	if len(readiness.Ready) == 0 && len(readiness.Blocked) == 0 {
+		fmt.Println("No decisions are waiting to be decided.")
+		return
+	}
+
+	if len(readiness.Ready) > 0 {
+		fmt.Println("Ready to decide:")
+		for _, d := range readiness.Ready {
+			fmt.Printf("  %s [%s] - %s\n", d.ID, d.Status, d.Title)
+		}
+	} else {
+		fmt.Println("No decision is ready to be decided.")
+	}
+
+	if len(readiness.Blocked) > 0 {
+		fmt.Println()
+		fmt.Println("Blocked:")
+		for _, b := range readiness.Blocked {
+			fmt.Printf("  %s [%s] - %s (waiting for %s)\n", b.Decision.ID, b.Decision.Status, b.Decision.Title, strings.Join(b.BlockedBy, ", "))
+		}
+	}
+}
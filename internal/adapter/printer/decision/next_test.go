@@ -0,0 +1,37 @@
+package decision
+
+import (
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"strings"
+	"testing"
+)
+
+func TestNext(t *testing.T) {
+	presenter := NewNextPresenter()
+
+	output := captureOutput(func() {
+		presenter.Next(domain.Readiness{
+			Ready:   []domain.Decision{{ID: "0002", Title: "Database", Status: "open"}},
+			Blocked: []domain.BlockedDecision{{Decision: domain.Decision{ID: "0004", Title: "Cache", Status: "open"}, BlockedBy: []string{"0002", "0003"}}},
+		})
+	})
+
+	for _, expected := range []string{"Ready to decide:", "0002 [open] - Database", "Blocked:", "0004 [open] - Cache (waiting for 0002, 0003)"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+}
+
+func TestNext_NothingPending(t *testing.T) {
+	presenter := NewNextPresenter()
+
+	output := captureOutput(func() {
+		presenter.Next(domain.Readiness{})
+	})
+
+	expected := "No decisions are waiting to be decided."
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
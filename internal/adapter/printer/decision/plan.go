@@ -0,0 +1,39 @@
+package decision
+
+import (
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type PlanPresenter struct{}
+
+func NewPlanPresenter() *PlanPresenter {
+	return &PlanPresenter{}
+}
+
+func (p *PlanPresenter) Planned(plan domain.Plan) {
+	if len(plan.Waves) == 0 && len(plan.Cyclic) == 0 {
+		fmt.Println("No decisions are waiting to be decided.")
+		return
+	}
+
+	for i, wave := range plan.Waves {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("Wave %d:\n", i+1)
+		for _, d := range wave {
+			fmt.Printf("  %s [%s] - %s\n", d.ID, d.Status, d.Title)
+		}
+	}
+
+	if len(plan.Cyclic) > 0 {
+		if len(plan.Waves) > 0 {
+			fmt.Println()
+		}
+		fmt.Println("Cannot be planned, these decisions are part of or depend on a cycle of precedes links:")
+		for _, d := range plan.Cyclic {
+			fmt.Printf("  %s [%s] - %s\n", d.ID, d.Status, d.Title)
+		}
+	}
+}
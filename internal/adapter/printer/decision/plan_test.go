@@ -0,0 +1,40 @@
+package decision
+
+import (
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"strings"
+	"testing"
+)
+
+func TestPlanned(t *testing.T) {
+	presenter := NewPlanPresenter()
+
+	output := captureOutput(func() {
+		presenter.Planned(domain.Plan{
+			Waves: [][]domain.Decision{
+				{{ID: "0001", Title: "Database", Status: "open"}},
+				{{ID: "0002", Title: "Cache", Status: "open"}},
+			},
+			Cyclic: []domain.Decision{{ID: "0003", Title: "Queue", Status: "open"}},
+		})
+	})
+
+	for _, expected := range []string{"Wave 1:\n  0001 [open] - Database", "Wave 2:\n  0002 [open] - Cache", "cycle of precedes links:\n  0003 [open] - Queue"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+}
+
+func TestPlanned_NothingPending(t *testing.T) {
+	presenter := NewPlanPresenter()
+
+	output := captureOutput(func() {
+		presenter.Planned(domain.Plan{})
+	})
+
+	expected := "No decisions are waiting to be decided."
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
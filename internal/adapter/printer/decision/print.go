@@ -4,45 +4,163 @@ import (
 	"github.com/adr/ad-guidance-tool/internal/domain/config"
 	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
 
+	"encoding/json"
 	"fmt"
+	"os"
+	"slices"
 	"sort"
+
+	"gopkg.in/yaml.v3"
 )
 
 type PrintDecisionsPresenter struct {
 	config config.ConfigService
 }
 
+// decisionView is the structured representation of a decision's content used by the json and yaml formats.
+type decisionView struct {
+	ID       string          `json:"id" yaml:"id"`
+	Question string          `json:"question,omitempty" yaml:"question,omitempty"`
+	Options  []domain.Option `json:"options,omitempty" yaml:"options,omitempty"`
+	Criteria string          `json:"criteria,omitempty" yaml:"criteria,omitempty"`
+	Outcome  string          `json:"outcome,omitempty" yaml:"outcome,omitempty"`
+	Comments string          `json:"comments,omitempty" yaml:"comments,omitempty"`
+	Sections []sectionView   `json:"sections,omitempty" yaml:"sections,omitempty"`
+	Links    []linkView      `json:"links,omitempty" yaml:"links,omitempty"`
+}
+
+// sectionView is any other section of a decision, e.g. the decision matrix or custom sections.
+type sectionView struct {
+	Anchor  string `json:"anchor" yaml:"anchor"`
+	Title   string `json:"title" yaml:"title"`
+	Content string `json:"content" yaml:"content"`
+}
+
+// linkView is a linked decision. Model is the alias of the model of decisions of other models.
+type linkView struct {
+	Type    string `json:"type" yaml:"type"`
+	Target  string `json:"target" yaml:"target"`
+	Model   string `json:"model,omitempty" yaml:"model,omitempty"`
+	Title   string `json:"title,omitempty" yaml:"title,omitempty"`
+	Status  string `json:"status,omitempty" yaml:"status,omitempty"`
+	Missing bool   `json:"missing,omitempty" yaml:"missing,omitempty"`
+}
+
+// viewFields are the sections with a dedicated field in decisionView
+var viewFields = []string{"question", "options", "criteria", "outcome", "comments"}
+
 func NewPrintPresenter(config config.ConfigService) *PrintDecisionsPresenter {
 	return &PrintDecisionsPresenter{config: config}
 }
 
-func (p *PrintDecisionsPresenter) Printed(contents []domain.DecisionContent, sections map[string]bool) {
+func (p *PrintDecisionsPresenter) Printed(contents []domain.DecisionContent, links map[string][]domain.ResolvedLink, sections map[string]bool, format string) {
 	sort.Slice(contents, func(i, j int) bool {
 		return contents[i].ID < contents[j].ID
 	})
 
+	switch format {
+	case "json", "yaml":
+		p.printStructured(contents, links, sections, format)
+	default:
+		p.printText(contents, links, sections)
+	}
+}
+
+func (p *PrintDecisionsPresenter) printText(contents []domain.DecisionContent, links map[string][]domain.ResolvedLink, sections map[string]bool) {
 	for _, d := range contents {
 		fmt.Printf("===== Decision %s =====\n\n", d.ID)
 
-		if sections["question"] && d.Question != "" {
-			fmt.Println(p.config.GetQuestionHeader())
-			fmt.Println(d.Question + "\n")
+		for _, sec := range d.OrderedSections() {
+			if !isSelected(sections, sec.Anchor) || sec.Body == "" {
+				continue
+			}
+			fmt.Println(p.header(sec))
+			fmt.Println(sec.Body + "\n")
+		}
+
+		if isSelected(sections, "links") && len(links[d.ID]) > 0 {
+			fmt.Println("Links")
+			for _, link := range links[d.ID] {
+				if link.Missing {
+					fmt.Printf("- %s: %s (not found)\n", link.Type, link.Target)
+				} else {
+					fmt.Printf("- %s: %s %s [%s]\n", link.Type, link.Target, link.Title, link.Status)
+				}
+			}
+			fmt.Println()
+		}
+	}
+}
+
+// header returns the configured header of the standard sections and the file's header of all others.
+func (p *PrintDecisionsPresenter) header(sec domain.Section) string {
+	switch sec.Anchor {
+	case "question":
+		return p.config.GetQuestionHeader()
+	case "options":
+		return p.config.GetOptionsHeader()
+	case "criteria":
+		return p.config.GetCriteriaHeader()
+	case "outcome":
+		return p.config.GetOutcomeHeader()
+	case "comments":
+		return p.config.GetCommentsHeader()
+	}
+	if sec.Title != "" {
+		return sec.Title
+	}
+	return sec.Anchor
+}
+
+// an empty selection selects all sections
+func isSelected(sections map[string]bool, anchor string) bool {
+	return len(sections) == 0 || sections[anchor]
+}
+
+func (p *PrintDecisionsPresenter) printStructured(contents []domain.DecisionContent, links map[string][]domain.ResolvedLink, sections map[string]bool, format string) {
+	views := make([]decisionView, 0, len(contents))
+	for _, d := range contents {
+		view := decisionView{ID: d.ID}
+		if isSelected(sections, "question") {
+			view.Question = d.Question
+		}
+		if isSelected(sections, "options") {
+			view.Options = domain.ParseOptions(d.Options)
 		}
-		if sections["options"] && d.Options != "" {
-			fmt.Println(p.config.GetOptionsHeader())
-			fmt.Println(d.Options + "\n")
+		if isSelected(sections, "criteria") {
+			view.Criteria = d.Criteria
 		}
-		if sections["criteria"] && d.Criteria != "" {
-			fmt.Println(p.config.GetCriteriaHeader())
-			fmt.Println(d.Criteria + "\n")
+		if isSelected(sections, "outcome") {
+			view.Outcome = d.Outcome
 		}
-		if sections["outcome"] && d.Outcome != "" {
-			fmt.Println(p.config.GetOutcomeHeader())
-			fmt.Println(d.Outcome + "\n")
+		if isSelected(sections, "comments") {
+			view.Comments = d.Comments
 		}
-		if sections["comments"] && d.Comments != "" {
-			fmt.Println(p.config.GetCommentsHeader())
-			fmt.Println(d.Comments + "\n")
+		for _, sec := range d.OrderedSections() {
+			if slices.Contains(viewFields, sec.Anchor) || !isSelected(sections, sec.Anchor) || sec.Body == "" {
+				continue
+			}
+			view.Sections = append(view.Sections, sectionView{Anchor: sec.Anchor, Title: sec.Title, Content: sec.Body})
 		}
+		if isSelected(sections, "links") {
+			for _, link := range links[d.ID] {
+				view.Links = append(view.Links, linkView(link))
+			}
+		}
+		views = append(views, view)
 	}
+
+	var data []byte
+	var err error
+	if format == "json" {
+		data, err = json.MarshalIndent(views, "", "  ")
+	} else {
+		data, err = yaml.Marshal(views)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error rendering decisions: %v\n", err)
+		return
+	}
+
+	fmt.Println(string(data))
 }
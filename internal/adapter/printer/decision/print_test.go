@@ -45,7 +45,7 @@ func TestPrinted_AllSections(t *testing.T) {
 	}
 
 	output := captureOutput(func() {
-		presenter.Printed(contents, sections)
+		presenter.Printed(contents, nil, sections, "text")
 	})
 
 	for _, expected := range []string{
@@ -92,7 +92,7 @@ func TestPrinted_PartialSections(t *testing.T) {
 	}
 
 	output := captureOutput(func() {
-		presenter.Printed(contents, sections)
+		presenter.Printed(contents, nil, sections, "text")
 	})
 
 	if !strings.Contains(output, "===== Decision 0002 =====") {
@@ -108,3 +108,116 @@ func TestPrinted_PartialSections(t *testing.T) {
 		t.Error("Expected outcome section to be printed")
 	}
 }
+
+func TestPrinted_JSONWithStructuredOptions(t *testing.T) {
+	presenter := NewPrintPresenter(new(svc_mocks.ConfigService))
+
+	contents := []decision.DecisionContent{
+		{
+			ID:       "0001",
+			Question: "Which database?",
+			Options:  "1. <a name=\"option-1\"></a> PostgreSQL\n\n   - Pro: cheap\n   - Con: operating effort",
+		},
+	}
+
+	output := captureOutput(func() {
+		presenter.Printed(contents, nil, map[string]bool{"options": true}, "json")
+	})
+
+	for _, expected := range []string{`"id": "0001"`, `"title": "PostgreSQL"`, `"pros": [`, `"cheap"`, `"operating effort"`} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+	if strings.Contains(output, "Which database?") {
+		t.Errorf("Did not expect unselected question in output: %q", output)
+	}
+}
+
+func TestPrinted_YAML(t *testing.T) {
+	presenter := NewPrintPresenter(new(svc_mocks.ConfigService))
+
+	contents := []decision.DecisionContent{{ID: "0001", Outcome: "Chose Option A"}}
+
+	output := captureOutput(func() {
+		presenter.Printed(contents, nil, map[string]bool{"outcome": true}, "yaml")
+	})
+
+	if !strings.Contains(output, "outcome: Chose Option A") {
+		t.Errorf("YAML output missing expected content:\n%s", output)
+	}
+}
+
+func TestPrinted_CustomSections(t *testing.T) {
+	mockConfig := new(svc_mocks.ConfigService)
+	presenter := NewPrintPresenter(mockConfig)
+
+	mockConfig.On("GetQuestionHeader").Return("Question")
+	mockConfig.On("GetOptionsHeader").Return("Options")
+	mockConfig.On("GetCriteriaHeader").Return("Criteria")
+
+	contents := []decision.DecisionContent{
+		{
+			ID:       "0001",
+			Question: "Which database?",
+			Sections: []decision.Section{
+				{Anchor: "question", Title: "Question"},
+				{Anchor: "consequences", Title: "Consequences", Body: "Backups needed"},
+			},
+		},
+	}
+
+	output := captureOutput(func() {
+		presenter.Printed(contents, nil, nil, "text")
+	})
+
+	if !strings.Contains(output, "Consequences\nBackups needed") {
+		t.Errorf("Expected custom section in output, got: %q", output)
+	}
+	if strings.Index(output, "Consequences") < strings.Index(output, "Which database?") {
+		t.Errorf("Expected custom section after the question, got: %q", output)
+	}
+
+	output = captureOutput(func() {
+		presenter.Printed(contents, nil, map[string]bool{"consequences": true}, "json")
+	})
+
+	for _, expected := range []string{`"anchor": "consequences"`, `"title": "Consequences"`, `"content": "Backups needed"`} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+	if strings.Contains(output, "Which database?") {
+		t.Errorf("Did not expect unselected question in output: %q", output)
+	}
+}
+
+func TestPrinted_Links(t *testing.T) {
+	presenter := NewPrintPresenter(new(svc_mocks.ConfigService))
+
+	contents := []decision.DecisionContent{{ID: "0001"}}
+	links := map[string][]decision.ResolvedLink{"0001": {
+		{Type: "precedes", Target: "0002", Title: "Use REST", Status: "open"},
+		{Type: "depends on", Target: "platform:0012", Model: "platform", Missing: true},
+	}}
+
+	output := captureOutput(func() {
+		presenter.Printed(contents, links, map[string]bool{"links": true}, "text")
+	})
+
+	for _, expected := range []string{"Links", "- precedes: 0002 Use REST [open]", "- depends on: platform:0012 (not found)"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+
+	output = captureOutput(func() {
+		presenter.Printed(contents, links, map[string]bool{"links": true}, "json")
+	})
+
+	for _, expected := range []string{`"target": "platform:0012"`, `"model": "platform"`, `"missing": true`} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+}
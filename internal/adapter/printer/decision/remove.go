@@ -0,0 +1,23 @@
+package decision
+
+import (
+	"fmt"
+	"strings"
+)
+
+type RemoveDecisionPresenter struct{}
+
+func NewRemovePresenter() *RemoveDecisionPresenter {
+	return &RemoveDecisionPresenter{}
+}
+
+func (p *RemoveDecisionPresenter) Removed(decisionID string, unlinked []string) {
+	fmt.Printf("Decision %s has been removed.\n", decisionID)
+	printUnlinked(decisionID, unlinked)
+}
+
+func printUnlinked(decisionID string, unlinked []string) {
+	if len(unlinked) > 0 {
+		fmt.Printf("Removed links to %s from decisions: %s\n", decisionID, strings.Join(unlinked, ", "))
+	}
+}
@@ -0,0 +1,32 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRemoved(t *testing.T) {
+	presenter := NewRemovePresenter()
+
+	output := captureOutput(func() {
+		presenter.Removed("0003", []string{"0001", "0004"})
+	})
+
+	for _, expected := range []string{"Decision 0003 has been removed.", "Removed links to 0003 from decisions: 0001, 0004"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+}
+
+func TestRemoved_NoLinks(t *testing.T) {
+	presenter := NewRemovePresenter()
+
+	output := captureOutput(func() {
+		presenter.Removed("0003", nil)
+	})
+
+	if strings.Contains(output, "Removed links") {
+		t.Errorf("Did not expect link cleanup message, but got: %q", output)
+	}
+}
@@ -0,0 +1,13 @@
+package decision
+
+import "fmt"
+
+type RenameDecisionPresenter struct{}
+
+func NewRenamePresenter() *RenameDecisionPresenter {
+	return &RenameDecisionPresenter{}
+}
+
+func (p *RenameDecisionPresenter) Renamed(decisionID, oldTitle, newTitle string) {
+	fmt.Printf("Decision %s renamed from %q to %q.\n", decisionID, oldTitle, newTitle)
+}
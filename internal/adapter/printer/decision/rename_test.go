@@ -0,0 +1,19 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenamed(t *testing.T) {
+	presenter := NewRenamePresenter()
+
+	output := captureOutput(func() {
+		presenter.Renamed("0004", "old title", "New title")
+	})
+
+	expected := `Decision 0004 renamed from "old title" to "New title".`
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
@@ -0,0 +1,13 @@
+package decision
+
+import "fmt"
+
+type ReopenDecisionPresenter struct{}
+
+func NewReopenPresenter() *ReopenDecisionPresenter {
+	return &ReopenDecisionPresenter{}
+}
+
+func (p *ReopenDecisionPresenter) Reopened(decisionID, status string) {
+	fmt.Printf("Decision %s has been reopened and is %s again.\n", decisionID, status)
+}
@@ -0,0 +1,19 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReopened(t *testing.T) {
+	presenter := NewReopenPresenter()
+
+	output := captureOutput(func() {
+		presenter.Reopened("0005", "open")
+	})
+
+	expected := "Decision 0005 has been reopened and is open again."
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
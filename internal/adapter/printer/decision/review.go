@@ -0,0 +1,19 @@
+package decision
+
+import (
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type ReviewDecisionPresenter struct{}
+
+func NewReviewPresenter() *ReviewDecisionPresenter {
+	return &ReviewDecisionPresenter{}
+}
+
+func (p *ReviewDecisionPresenter) Reviewed(decisionID, result, reviewBy string) {
+	fmt.Printf("Decision %s reviewed (%s), next review by %s.\n", decisionID, result, reviewBy)
+	if result == domain.ReviewNeedsRevision {
+		fmt.Printf("Use 'adg revise --id %s' or 'adg reopen --id %s' to revisit it.\n", decisionID, decisionID)
+	}
+}
@@ -0,0 +1,35 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReviewed(t *testing.T) {
+	presenter := NewReviewPresenter()
+
+	output := captureOutput(func() {
+		presenter.Reviewed("0004", "still-valid", "2027-04-01")
+	})
+
+	expected := "Decision 0004 reviewed (still-valid), next review by 2027-04-01."
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+	if strings.Contains(output, "adg revise") {
+		t.Errorf("Did not expect a revision hint, got: %q", output)
+	}
+}
+
+func TestReviewed_NeedsRevision(t *testing.T) {
+	presenter := NewReviewPresenter()
+
+	output := captureOutput(func() {
+		presenter.Reviewed("0004", "needs-revision", "2027-04-01")
+	})
+
+	expected := "Use 'adg revise --id 0004' or 'adg reopen --id 0004' to revisit it."
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
@@ -0,0 +1,13 @@
+package decision
+
+import "fmt"
+
+type ScoreDecisionPresenter struct{}
+
+func NewScorePresenter() *ScoreDecisionPresenter {
+	return &ScoreDecisionPresenter{}
+}
+
+func (p *ScoreDecisionPresenter) Scored(decisionID, option, criterion string, value int) {
+	fmt.Printf("Option %s of decision %s scored %d on criterion %s.\n", option, decisionID, value, criterion)
+}
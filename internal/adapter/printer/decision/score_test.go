@@ -0,0 +1,19 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScored(t *testing.T) {
+	presenter := NewScorePresenter()
+
+	output := captureOutput(func() {
+		presenter.Scored("0002", "1", "Performance", 4)
+	})
+
+	expected := "Option 1 of decision 0002 scored 4 on criterion Performance."
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
@@ -0,0 +1,47 @@
+package decision
+
+import (
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+	"strings"
+)
+
+type SearchPresenter struct{}
+
+func NewSearchPresenter() *SearchPresenter {
+	return &SearchPresenter{}
+}
+
+func (p *SearchPresenter) Searched(results []domain.SearchResult, total int) {
+	if total == 0 {
+		fmt.Println("No decisions found.")
+		return
+	}
+
+	for i, r := range results {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s [%s] - %s\n", r.ID, r.Status, r.Title)
+		for _, hit := range r.Hits {
+			fmt.Printf("  %s: %s\n", hit.Section, highlight(hit))
+		}
+	}
+
+	if len(results) < total {
+		fmt.Printf("\nShowing %d of %d matching decisions, use --limit to see more.\n", len(results), total)
+	}
+}
+
+// highlight marks the matched terms of a snippet in bold markdown.
+func highlight(hit domain.SearchHit) string {
+	var b strings.Builder
+	last := 0
+	for _, h := range hit.Highlights {
+		b.WriteString(hit.Snippet[last:h[0]])
+		b.WriteString("**" + hit.Snippet[h[0]:h[1]] + "**")
+		last = h[1]
+	}
+	b.WriteString(hit.Snippet[last:])
+	return b.String()
+}
@@ -0,0 +1,43 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"strings"
+	"testing"
+)
+
+func TestSearched(t *testing.T) {
+	presenter := NewSearchPresenter()
+
+	output := captureOutput(func() {
+		presenter.Searched([]decision.SearchResult{
+			{ID: "0001", Title: "Message broker", Status: "decided", Hits: []decision.SearchHit{
+				{Anchor: "question", Section: "Question", Snippet: "Should we use Kafka or Kafka Streams?", Highlights: [][2]int{{14, 19}, {23, 28}}},
+			}},
+			{ID: "0004", Title: "Kafka topics", Status: "open"},
+		}, 5)
+	})
+
+	for _, expected := range []string{
+		"0001 [decided] - Message broker",
+		"  Question: Should we use **Kafka** or **Kafka** Streams?",
+		"0004 [open] - Kafka topics",
+		"Showing 2 of 5 matching decisions",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+}
+
+func TestSearched_NoResults(t *testing.T) {
+	presenter := NewSearchPresenter()
+
+	output := captureOutput(func() {
+		presenter.Searched(nil, 0)
+	})
+
+	if !strings.Contains(output, "No decisions found.") {
+		t.Errorf("Expected no results message, but got: %q", output)
+	}
+}
@@ -0,0 +1,29 @@
+package decision
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type SetFieldDecisionPresenter struct{}
+
+func NewSetFieldPresenter() *SetFieldDecisionPresenter {
+	return &SetFieldDecisionPresenter{}
+}
+
+func (p *SetFieldDecisionPresenter) FieldsSet(decisionID string, values map[string]string) {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if strings.TrimSpace(values[name]) == "" {
+			fmt.Printf("Field %s removed from decision %s.\n", name, decisionID)
+		} else {
+			fmt.Printf("Field %s of decision %s set to %s.\n", name, decisionID, values[name])
+		}
+	}
+}
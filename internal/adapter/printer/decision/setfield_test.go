@@ -0,0 +1,19 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFieldsSet(t *testing.T) {
+	presenter := NewSetFieldPresenter()
+
+	output := captureOutput(func() {
+		presenter.FieldsSet("0003", map[string]string{"risk": "high", "component": ""})
+	})
+
+	expected := "Field component removed from decision 0003.\nField risk of decision 0003 set to high.\n"
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
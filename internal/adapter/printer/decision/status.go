@@ -0,0 +1,13 @@
+package decision
+
+import "fmt"
+
+type StatusDecisionPresenter struct{}
+
+func NewStatusPresenter() *StatusDecisionPresenter {
+	return &StatusDecisionPresenter{}
+}
+
+func (p *StatusDecisionPresenter) StatusChanged(decisionID, from, to string) {
+	fmt.Printf("Decision %s status changed from %s to %s.\n", decisionID, from, to)
+}
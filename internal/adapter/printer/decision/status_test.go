@@ -0,0 +1,19 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStatusChanged(t *testing.T) {
+	presenter := NewStatusPresenter()
+
+	output := captureOutput(func() {
+		presenter.StatusChanged("0007", "decided", "deprecated")
+	})
+
+	expected := "Decision 0007 status changed from decided to deprecated."
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
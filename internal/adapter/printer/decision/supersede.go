@@ -0,0 +1,16 @@
+package decision
+
+import "fmt"
+
+type SupersedeDecisionPresenter struct{}
+
+func NewSupersedePresenter() *SupersedeDecisionPresenter {
+	return &SupersedeDecisionPresenter{}
+}
+
+func (p *SupersedeDecisionPresenter) Superseded(originalID, replacementID string, created bool) {
+	if created {
+		fmt.Printf("Created decision %s.\n", replacementID)
+	}
+	fmt.Printf("Decision %s is now superseded by decision %s.\n", originalID, replacementID)
+}
@@ -0,0 +1,36 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSuperseded(t *testing.T) {
+	presenter := NewSupersedePresenter()
+
+	output := captureOutput(func() {
+		presenter.Superseded("0003", "0011", false)
+	})
+
+	expected := "Decision 0003 is now superseded by decision 0011."
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+	if strings.Contains(output, "Created") {
+		t.Errorf("Did not expect creation message, but got: %q", output)
+	}
+}
+
+func TestSuperseded_Created(t *testing.T) {
+	presenter := NewSupersedePresenter()
+
+	output := captureOutput(func() {
+		presenter.Superseded("0003", "0011", true)
+	})
+
+	for _, expected := range []string{"Created decision 0011.", "Decision 0003 is now superseded by decision 0011."} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package decision
+
+import (
+	"fmt"
+	"strings"
+)
+
+type TagMergePresenter struct{}
+
+func NewTagMergePresenter() *TagMergePresenter {
+	return &TagMergePresenter{}
+}
+
+func (p *TagMergePresenter) TagsMerged(sourceTags []string, targetTag string, updated []string) {
+	fmt.Printf("Tags [%s] merged into %q in decisions: %s\n", strings.Join(sourceTags, ", "), targetTag, strings.Join(updated, ", "))
+}
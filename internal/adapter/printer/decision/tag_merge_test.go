@@ -0,0 +1,19 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTagsMerged(t *testing.T) {
+	presenter := NewTagMergePresenter()
+
+	output := captureOutput(func() {
+		presenter.TagsMerged([]string{"sec", "secu"}, "security", []string{"0002", "0003"})
+	})
+
+	expected := `Tags [sec, secu] merged into "security" in decisions: 0002, 0003`
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
@@ -0,0 +1,16 @@
+package decision
+
+import (
+	"fmt"
+	"strings"
+)
+
+type TagRenamePresenter struct{}
+
+func NewTagRenamePresenter() *TagRenamePresenter {
+	return &TagRenamePresenter{}
+}
+
+func (p *TagRenamePresenter) TagRenamed(oldTag, newTag string, updated []string) {
+	fmt.Printf("Tag %q renamed to %q in decisions: %s\n", oldTag, newTag, strings.Join(updated, ", "))
+}
@@ -0,0 +1,19 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTagRenamed(t *testing.T) {
+	presenter := NewTagRenamePresenter()
+
+	output := captureOutput(func() {
+		presenter.TagRenamed("perf", "performance", []string{"0001", "0004"})
+	})
+
+	expected := `Tag "perf" renamed to "performance" in decisions: 0001, 0004`
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
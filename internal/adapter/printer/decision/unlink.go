@@ -0,0 +1,20 @@
+package decision
+
+import "fmt"
+
+type UnlinkPresenter struct{}
+
+func NewUnlinkPresenter() *UnlinkPresenter {
+	return &UnlinkPresenter{}
+}
+
+func (p *UnlinkPresenter) Unlinked(sourceID, targetID, tag, reverseTag string) {
+	if tag == "" && reverseTag == "" {
+		fmt.Printf("All links between %s and %s removed\n", sourceID, targetID)
+		return
+	}
+	fmt.Printf("Link removed: %s →[%s]→ %s\n", sourceID, tag, targetID)
+	if reverseTag != "" {
+		fmt.Printf("Reverse link removed: %s →[%s]→ %s\n", targetID, reverseTag, sourceID)
+	}
+}
@@ -0,0 +1,33 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnlinked_WithTags(t *testing.T) {
+	presenter := NewUnlinkPresenter()
+
+	output := captureOutput(func() {
+		presenter.Unlinked("0001", "0002", "precedes", "succeeds")
+	})
+
+	for _, expected := range []string{"Link removed: 0001 →[precedes]→ 0002", "Reverse link removed: 0002 →[succeeds]→ 0001"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+		}
+	}
+}
+
+func TestUnlinked_AllLinks(t *testing.T) {
+	presenter := NewUnlinkPresenter()
+
+	output := captureOutput(func() {
+		presenter.Unlinked("0001", "0002", "", "")
+	})
+
+	expected := "All links between 0001 and 0002 removed"
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
@@ -0,0 +1,16 @@
+package decision
+
+import (
+	"fmt"
+	"strings"
+)
+
+type UntagDecisionPresenter struct{}
+
+func NewUntagPresenter() *UntagDecisionPresenter {
+	return &UntagDecisionPresenter{}
+}
+
+func (p *UntagDecisionPresenter) Untagged(decisionID string, tags []string) {
+	fmt.Printf("Tags [%s] removed from decision %s\n", strings.Join(tags, ", "), decisionID)
+}
@@ -0,0 +1,19 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUntagged(t *testing.T) {
+	presenter := NewUntagPresenter()
+
+	output := captureOutput(func() {
+		presenter.Untagged("0001", []string{"critical", "UI"})
+	})
+
+	expected := "Tags [critical, UI] removed from decision 0001"
+	if !strings.Contains(output, expected) {
+		t.Errorf("Expected output to contain: %q, but got: %q", expected, output)
+	}
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	modeldomain "github.com/adr/ad-guidance-tool/internal/domain/model"
+	"fmt"
+)
+
+type GitMergeDriverPresenter struct{}
+
+func NewGitMergeDriverPresenter() *GitMergeDriverPresenter {
+	return &GitMergeDriverPresenter{}
+}
+
+func (p *GitMergeDriverPresenter) Merged(filePath string, result *modeldomain.MergeResult) {
+	for _, id := range result.Collisions {
+		fmt.Printf("%s: both branches added a decision with the ID %s, run 'adg resolve-collisions' after the merge\n", filePath, id)
+	}
+	for _, key := range result.Conflicts {
+		if result.Index {
+			fmt.Printf("%s: kept our version of %s, run 'adg rebuild' after the merge\n", filePath, key)
+		} else {
+			fmt.Printf("%s: conflicting changes to %s\n", filePath, key)
+		}
+	}
+}
+
+func (p *GitMergeDriverPresenter) Installed(modelPath, attributesPath string) {
+	fmt.Printf("Installed the merge driver for model %s, the files of the model are assigned to it in %s.\n", modelPath, attributesPath)
+}
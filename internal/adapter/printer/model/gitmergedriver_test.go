@@ -0,0 +1,64 @@
+package model
+
+import (
+	modeldomain "github.com/adr/ad-guidance-tool/internal/domain/model"
+	"bytes"
+	"io"
+	"os"
+	"testing"
+)
+
+func captureStdout(print func()) string {
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	print()
+
+	w.Close()
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	os.Stdout = oldStdout
+	return buf.String()
+}
+
+func TestGitMergeDriverPresenter_MergedIndex(t *testing.T) {
+	result := &modeldomain.MergeResult{Index: true, Collisions: []string{"0002"}, Conflicts: []string{"0001.title"}}
+
+	got := captureStdout(func() { NewGitMergeDriverPresenter().Merged("m/index.yaml", result) })
+
+	expectedOutput := "m/index.yaml: both branches added a decision with the ID 0002, run 'adg resolve-collisions' after the merge\n" +
+		"m/index.yaml: kept our version of 0001.title, run 'adg rebuild' after the merge\n"
+	if got != expectedOutput {
+		t.Errorf("unexpected output:\nexpected: %q\ngot: %q", expectedOutput, got)
+	}
+}
+
+func TestGitMergeDriverPresenter_MergedDecision(t *testing.T) {
+	result := &modeldomain.MergeResult{Conflicts: []string{"status", "text"}}
+
+	got := captureStdout(func() { NewGitMergeDriverPresenter().Merged("m/AD0001-a.md", result) })
+
+	expectedOutput := "m/AD0001-a.md: conflicting changes to status\n" +
+		"m/AD0001-a.md: conflicting changes to text\n"
+	if got != expectedOutput {
+		t.Errorf("unexpected output:\nexpected: %q\ngot: %q", expectedOutput, got)
+	}
+}
+
+func TestGitMergeDriverPresenter_MergedClean(t *testing.T) {
+	got := captureStdout(func() { NewGitMergeDriverPresenter().Merged("m/index.yaml", &modeldomain.MergeResult{Index: true}) })
+
+	if got != "" {
+		t.Errorf("expected no output, got: %q", got)
+	}
+}
+
+func TestGitMergeDriverPresenter_Installed(t *testing.T) {
+	got := captureStdout(func() { NewGitMergeDriverPresenter().Installed("m", "/repo/.gitattributes") })
+
+	expectedOutput := "Installed the merge driver for model m, the files of the model are assigned to it in /repo/.gitattributes.\n"
+	if got != expectedOutput {
+		t.Errorf("unexpected output:\nexpected: %q\ngot: %q", expectedOutput, got)
+	}
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	decision "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type RenumberModelPresenter struct{}
+
+func NewRenumberPresenter() *RenumberModelPresenter {
+	return &RenumberModelPresenter{}
+}
+
+func (p *RenumberModelPresenter) Renumbered(modelPath string, changes []decision.IDChange, dryRun bool) {
+	if len(changes) == 0 {
+		fmt.Printf("The IDs of model %s are already in order, nothing to renumber.\n", modelPath)
+		return
+	}
+
+	// align the columns of the mapping table
+	fromWidth, toWidth := 0, 0
+	for _, change := range changes {
+		fromWidth = max(fromWidth, len(change.From))
+		toWidth = max(toWidth, len(change.To))
+	}
+	for _, change := range changes {
+		fmt.Printf("%-*s -> %-*s  %s\n", fromWidth, change.From, toWidth, change.To, change.Title)
+	}
+
+	if dryRun {
+		fmt.Printf("Dry run: %d decisions of model %s would be renumbered.\n", len(changes), modelPath)
+	} else {
+		fmt.Printf("Renumbered %d decisions of model %s.\n", len(changes), modelPath)
+	}
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"testing"
+)
+
+func TestRenumberModelPresenter_Renumbered(t *testing.T) {
+	changes := []decision.IDChange{
+		{From: "0009", To: "0003", Title: "Use Kafka"},
+		{From: "10012", To: "0004", Title: "Use Postgres"},
+	}
+
+	expectedOutput := "0009  -> 0003  Use Kafka\n" +
+		"10012 -> 0004  Use Postgres\n" +
+		"Renumbered 2 decisions of model model.\n"
+	if got := captureStdout(func() { NewRenumberPresenter().Renumbered("model", changes, false) }); got != expectedOutput {
+		t.Errorf("unexpected output:\nexpected: %q\ngot: %q", expectedOutput, got)
+	}
+}
+
+func TestRenumberModelPresenter_DryRun(t *testing.T) {
+	changes := []decision.IDChange{{From: "0009", To: "0003", Title: "Use Kafka"}}
+
+	expectedOutput := "0009 -> 0003  Use Kafka\n" +
+		"Dry run: 1 decisions of model model would be renumbered.\n"
+	if got := captureStdout(func() { NewRenumberPresenter().Renumbered("model", changes, true) }); got != expectedOutput {
+		t.Errorf("unexpected output:\nexpected: %q\ngot: %q", expectedOutput, got)
+	}
+}
+
+func TestRenumberModelPresenter_NothingToRenumber(t *testing.T) {
+	expectedOutput := "The IDs of model model are already in order, nothing to renumber.\n"
+	if got := captureStdout(func() { NewRenumberPresenter().Renumbered("model", nil, false) }); got != expectedOutput {
+		t.Errorf("unexpected output:\nexpected: %q\ngot: %q", expectedOutput, got)
+	}
+}
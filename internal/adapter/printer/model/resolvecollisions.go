@@ -0,0 +1,50 @@
+package model
+
+import (
+	decision "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+	"strings"
+)
+
+type ResolveCollisionsPresenter struct{}
+
+func NewResolveCollisionsPresenter() *ResolveCollisionsPresenter {
+	return &ResolveCollisionsPresenter{}
+}
+
+func (p *ResolveCollisionsPresenter) CollisionsResolved(modelPath string, changes []decision.IDChange, ambiguous []decision.AmbiguousLink, dryRun bool) {
+	if len(changes) == 0 {
+		fmt.Printf("No decisions of model %s share an ID.\n", modelPath)
+		return
+	}
+
+	// align the columns of the mapping table
+	fromWidth, toWidth := 0, 0
+	for _, change := range changes {
+		fromWidth = max(fromWidth, len(change.From))
+		toWidth = max(toWidth, len(change.To))
+	}
+	for _, change := range changes {
+		fmt.Printf("%-*s -> %-*s  %s\n", fromWidth, change.From, toWidth, change.To, change.Title)
+	}
+
+	if dryRun {
+		fmt.Printf("Dry run: %s of model %s would get a new ID.\n", countDecisions(len(changes)), modelPath)
+	} else {
+		fmt.Printf("Gave %s of model %s a new ID.\n", countDecisions(len(changes)), modelPath)
+	}
+
+	if len(ambiguous) > 0 {
+		fmt.Println("None or several of the decisions with a shared ID have the reverse entry of these links, check where they should point:")
+		for _, link := range ambiguous {
+			fmt.Printf("  %s %s %s -> %s\n", link.From, link.Tag, link.ID, strings.Join(link.Targets, ", "))
+		}
+	}
+}
+
+func countDecisions(n int) string {
+	if n == 1 {
+		return "1 decision"
+	}
+	return fmt.Sprintf("%d decisions", n)
+}
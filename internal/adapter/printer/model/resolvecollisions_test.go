@@ -0,0 +1,74 @@
+package model
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"testing"
+)
+
+func TestResolveCollisionsPresenter_CollisionsResolved(t *testing.T) {
+	changes := []decision.IDChange{{From: "0002", To: "0004", Title: "Use Kafka"}}
+
+	got := captureStdout(func() { NewResolveCollisionsPresenter().CollisionsResolved("m", changes, nil, false) })
+
+	expectedOutput := "0002 -> 0004  Use Kafka\n" +
+		"Gave 1 decision of model m a new ID.\n"
+	if got != expectedOutput {
+		t.Errorf("unexpected output:\nexpected: %q\ngot: %q", expectedOutput, got)
+	}
+}
+
+func TestResolveCollisionsPresenter_DryRun(t *testing.T) {
+	changes := []decision.IDChange{{From: "0002", To: "0004", Title: "Use Kafka"}}
+
+	got := captureStdout(func() { NewResolveCollisionsPresenter().CollisionsResolved("m", changes, nil, true) })
+
+	expectedOutput := "0002 -> 0004  Use Kafka\n" +
+		"Dry run: 1 decision of model m would get a new ID.\n"
+	if got != expectedOutput {
+		t.Errorf("unexpected output:\nexpected: %q\ngot: %q", expectedOutput, got)
+	}
+}
+
+func TestResolveCollisionsPresenter_SeveralDecisions(t *testing.T) {
+	changes := []decision.IDChange{
+		{From: "0002", To: "0004", Title: "Use Kafka"},
+		{From: "0003", To: "0005", Title: "Use gRPC"},
+	}
+
+	got := captureStdout(func() { NewResolveCollisionsPresenter().CollisionsResolved("m", changes, nil, true) })
+
+	expectedOutput := "0002 -> 0004  Use Kafka\n" +
+		"0003 -> 0005  Use gRPC\n" +
+		"Dry run: 2 decisions of model m would get a new ID.\n"
+	if got != expectedOutput {
+		t.Errorf("unexpected output:\nexpected: %q\ngot: %q", expectedOutput, got)
+	}
+}
+
+func TestResolveCollisionsPresenter_NoCollisions(t *testing.T) {
+	got := captureStdout(func() { NewResolveCollisionsPresenter().CollisionsResolved("m", nil, nil, false) })
+
+	expectedOutput := "No decisions of model m share an ID.\n"
+	if got != expectedOutput {
+		t.Errorf("unexpected output:\nexpected: %q\ngot: %q", expectedOutput, got)
+	}
+}
+
+func TestResolveCollisionsPresenter_AmbiguousLinks(t *testing.T) {
+	changes := []decision.IDChange{{From: "0002", To: "0004", Title: "Use Kafka"}}
+	ambiguous := []decision.AmbiguousLink{
+		{From: "0001", Tag: "precedes", ID: "0002", Targets: []string{"0002"}},
+		{From: "0003", Tag: "relates", ID: "0002", Targets: []string{"0002", "0004"}},
+	}
+
+	got := captureStdout(func() { NewResolveCollisionsPresenter().CollisionsResolved("m", changes, ambiguous, false) })
+
+	expectedOutput := "0002 -> 0004  Use Kafka\n" +
+		"Gave 1 decision of model m a new ID.\n" +
+		"None or several of the decisions with a shared ID have the reverse entry of these links, check where they should point:\n" +
+		"  0001 precedes 0002 -> 0002\n" +
+		"  0003 relates 0002 -> 0002, 0004\n"
+	if got != expectedOutput {
+		t.Errorf("unexpected output:\nexpected: %q\ngot: %q", expectedOutput, got)
+	}
+}
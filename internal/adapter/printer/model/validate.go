@@ -8,7 +8,7 @@ func NewModelValidatePresenter() *ModelValidatePresenter {
 	return &ModelValidatePresenter{}
 }
 
-func (p *ModelValidatePresenter) ModelValidated(modelName string, indexErr, dataErr error) {
+func (p *ModelValidatePresenter) ModelValidated(modelName string, indexErr, dataErr, linkErr error) {
 	if indexErr == nil {
 		fmt.Printf("%s model metadata is valid and index is up to date\n", modelName)
 
@@ -21,5 +21,11 @@ func (p *ModelValidatePresenter) ModelValidated(modelName string, indexErr, data
 		fmt.Printf("%s model metadata is invalid: %s\n", modelName, indexErr)
 	}
 
+	if linkErr == nil {
+		fmt.Printf("%s model links are consistent\n", modelName)
+	} else {
+		fmt.Printf("%s model links are invalid: %s\n", modelName, linkErr)
+	}
+
 	// todo: print total number of valid/invalid decisions if available
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -27,11 +28,12 @@ func TestModelValidatePresenter_ModelValidated_AllValid(t *testing.T) {
 	presenter := NewModelValidatePresenter()
 
 	output := captureOutput(func() {
-		presenter.ModelValidated("test-model", nil, nil)
+		presenter.ModelValidated("test-model", nil, nil, nil)
 	})
 
 	expected := "test-model model metadata is valid and index is up to date\n" +
-		"test-model model file content is valid with correct anchors\n"
+		"test-model model file content is valid with correct anchors\n" +
+		"test-model model links are consistent\n"
 
 	if output != expected {
 		t.Errorf("unexpected output:\nexpected:\n%s\ngot:\n%s", expected, output)
@@ -42,10 +44,11 @@ func TestModelValidatePresenter_ModelValidated_InvalidIndex(t *testing.T) {
 	presenter := NewModelValidatePresenter()
 
 	output := captureOutput(func() {
-		presenter.ModelValidated("test-model", errors.New("index missing keys"), nil)
+		presenter.ModelValidated("test-model", errors.New("index missing keys"), nil, nil)
 	})
 
-	expected := "test-model model metadata is invalid: index missing keys\n"
+	expected := "test-model model metadata is invalid: index missing keys\n" +
+		"test-model model links are consistent\n"
 
 	if output != expected {
 		t.Errorf("unexpected output:\nexpected:\n%s\ngot:\n%s", expected, output)
@@ -56,13 +59,28 @@ func TestModelValidatePresenter_ModelValidated_InvalidData(t *testing.T) {
 	presenter := NewModelValidatePresenter()
 
 	output := captureOutput(func() {
-		presenter.ModelValidated("test-model", nil, errors.New("missing anchor in decision content"))
+		presenter.ModelValidated("test-model", nil, errors.New("missing anchor in decision content"), nil)
 	})
 
 	expected := "test-model model metadata is valid and index is up to date\n" +
-		"test-model model file content is invalid: missing anchor in decision content\n"
+		"test-model model file content is invalid: missing anchor in decision content\n" +
+		"test-model model links are consistent\n"
 
 	if output != expected {
 		t.Errorf("unexpected output:\nexpected:\n%s\ngot:\n%s", expected, output)
 	}
 }
+
+func TestModelValidatePresenter_ModelValidated_InvalidLinks(t *testing.T) {
+	presenter := NewModelValidatePresenter()
+
+	output := captureOutput(func() {
+		presenter.ModelValidated("test-model", nil, nil, errors.New("validation of links completed with errors"))
+	})
+
+	expected := "test-model model links are invalid: validation of links completed with errors\n"
+
+	if !strings.Contains(output, expected) {
+		t.Errorf("unexpected output:\nexpected to contain:\n%s\ngot:\n%s", expected, output)
+	}
+}
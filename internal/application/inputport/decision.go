@@ -1,19 +1,39 @@
 package inputport
 
+import "github.com/adr/ad-guidance-tool/internal/domain/decision"
+
 type DecisionAdd interface {
 	Add(modelPath string, titles []string) error
 }
 
+type DecisionArchive interface {
+	Archive(modelPath, id, title string, force bool) error
+}
+
 type DecisionComment interface {
-	Comment(modelPath, id, title, author, comment string) error
+	Comment(modelPath, id, title, author, comment string, replyTo int) error
+	EditComment(modelPath, id, title string, commentID int, comment string) error
+	DeleteComment(modelPath, id, title string, commentID int) error
 }
 
 type DecisionDecide interface {
-	Decide(modelPath, id, title, option, reason, author string, enforceOption bool) error
+	Decide(modelPath, id, title string, options []string, reason, author, reviewBy string, enforceOption, recommend bool) error
+}
+
+type DecisionDue interface {
+	Due(modelPath string, within int) error
+}
+
+type DecisionScore interface {
+	Score(modelPath, id, title, option, criterion string, value int) error
 }
 
 type DecisionEdit interface {
-	Edit(modelPath string, id string, title string, question *string, options *[]string, criteria *string) error
+	Edit(modelPath string, id string, title string, edit decision.ContentEdit) error
+}
+
+type DecisionGraph interface {
+	Graph(modelPath string, filters map[string][]string, focusID, focusTitle string, depth int, format string) error
 }
 
 type DecisionLink interface {
@@ -21,21 +41,81 @@ type DecisionLink interface {
 }
 
 type DecisionList interface {
-	ListDecisions(modelPath string, filters map[string][]string, format string) error
+	ListDecisions(modelPath string, filters map[string][]string, format string, includeSuperseded bool) error
+}
+
+type DecisionNext interface {
+	Next(modelPath string) error
+}
+
+type DecisionPlan interface {
+	Plan(modelPath string) error
 }
 
 type DecisionPrint interface {
-	Print(modelPath string, ids []string, titles []string, sections map[string]bool) error
+	Print(modelPath string, ids []string, titles []string, sections map[string]bool, format string) error
+}
+
+type DecisionReopen interface {
+	Reopen(modelPath, id, title, reason, author string) error
+}
+
+type DecisionRemove interface {
+	Remove(modelPath, id, title string, force bool) error
+}
+
+type DecisionMetadata interface {
+	Metadata(modelPath, id, title string, edit decision.MetadataEdit) error
+}
+
+type DecisionSearch interface {
+	Search(modelPath string, query decision.SearchQuery, limit int) error
+}
+
+type DecisionSetField interface {
+	SetFields(modelPath, id, title string, values map[string]string) error
+}
+
+type DecisionRename interface {
+	Rename(modelPath, id, title, newTitle string) error
+}
+
+type DecisionReview interface {
+	Review(modelPath, id, title, result, note, next, author string) error
 }
 
 type DecisionRevise interface {
 	ReviseDecision(modelPath, id, title string) error
 }
 
+type DecisionStatus interface {
+	ChangeStatus(modelPath, id, title, status, reason, author string) error
+}
+
+type DecisionSupersede interface {
+	Supersede(modelPath, id, title, byID, byTitle, newTitle, reason, author string) error
+}
+
 type DecisionTag interface {
 	Tag(modelPath, id, title string, tags []string) error
 }
 
+type DecisionUntag interface {
+	Untag(modelPath, id, title string, tags []string) error
+}
+
+type DecisionTagRename interface {
+	RenameTag(modelPath, oldTag, newTag string) error
+}
+
+type DecisionTagMerge interface {
+	MergeTags(modelPath string, sourceTags []string, targetTag string) error
+}
+
+type DecisionUnlink interface {
+	Unlink(modelPath, sourceID, sourceTitle, targetID, targetTitle, tag, reverseTag string) error
+}
+
 type DecisionRule interface {
 	Rule(modelPath, id, title, outputPath string) error
 }
@@ -4,6 +4,11 @@ type ModelCopy interface {
 	Copy(modelPath, targetPath string, filters map[string][]string) error
 }
 
+type ModelGitMergeDriver interface {
+	Merge(basePath, oursPath, theirsPath, filePath string) error
+	Install(modelPath string) error
+}
+
 type ModelImport interface {
 	Import(sourcePath, targetPath string, filters map[string][]string) error
 }
@@ -20,6 +25,14 @@ type ModelRebuildIndex interface {
 	RebuildIndex(modelPath string) error
 }
 
+type ModelRenumber interface {
+	Renumber(modelPath, order string, mapping map[string]string, dryRun bool) error
+}
+
+type ModelResolveCollisions interface {
+	ResolveCollisions(modelPath string, dryRun bool) error
+}
+
 type ModelValidate interface {
-	Validate(modelPath string) error
+	Validate(modelPath string, fix bool) error
 }
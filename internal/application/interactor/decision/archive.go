@@ -0,0 +1,36 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type ArchiveDecisionInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionArchive
+}
+
+func NewArchiveDecisionInteractor(service domain.DecisionService, output outputport.DecisionArchive) inputport.DecisionArchive {
+	return &ArchiveDecisionInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *ArchiveDecisionInteractor) Archive(modelPath, id, title string, force bool) error {
+	decision, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return err
+	}
+
+	unlinked, err := i.service.Archive(modelPath, decision, force)
+	if err != nil {
+		return fmt.Errorf("failed to archive decision %s: %w", decision.ID, err)
+	}
+
+	i.output.Archived(decision.ID, unlinked)
+	return nil
+}
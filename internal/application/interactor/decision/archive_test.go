@@ -0,0 +1,58 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchive_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionArchive)
+
+	d := &decision.Decision{ID: "0003", Title: "old decision"}
+
+	mockService.On("GetDecisionByTitle", "model", "old decision").Return(d, nil)
+	mockService.On("Archive", "model", d, false).Return([]string{"0001", "0004"}, nil)
+	mockOutput.On("Archived", "0003", []string{"0001", "0004"}).Return()
+
+	interactor := NewArchiveDecisionInteractor(mockService, mockOutput)
+	err := interactor.Archive("model", "", "old decision", false)
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestArchive_ResolveError(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionArchive)
+
+	mockService.On("GetDecisionByID", "model", "0003").Return(nil, errors.New("not found"))
+
+	interactor := NewArchiveDecisionInteractor(mockService, mockOutput)
+	err := interactor.Archive("model", "0003", "", false)
+
+	assert.ErrorContains(t, err, "not found")
+	mockService.AssertNotCalled(t, "Archive")
+}
+
+func TestArchive_ServiceFails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionArchive)
+
+	d := &decision.Decision{ID: "0003"}
+
+	mockService.On("GetDecisionByID", "model", "0003").Return(d, nil)
+	mockService.On("Archive", "model", d, false).Return(nil, errors.New("disk error"))
+
+	interactor := NewArchiveDecisionInteractor(mockService, mockOutput)
+	err := interactor.Archive("model", "0003", "", false)
+
+	assert.ErrorContains(t, err, "failed to archive decision 0003")
+	mockOutput.AssertNotCalled(t, "Archived")
+}
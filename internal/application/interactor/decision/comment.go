@@ -20,7 +20,7 @@ func NewCommentDecisionInteractor(service domain.DecisionService, output outputp
 	}
 }
 
-func (i *CommentDecisionInteractor) Comment(modelPath, id, title, author, comment string) error {
+func (i *CommentDecisionInteractor) Comment(modelPath, id, title, author, comment string, replyTo int) error {
 	var (
 		decision *domain.Decision
 		err      error
@@ -31,10 +31,43 @@ func (i *CommentDecisionInteractor) Comment(modelPath, id, title, author, commen
 		return err
 	}
 
-	if err := i.service.Comment(modelPath, decision, author, comment); err != nil {
+	if replyTo != 0 {
+		err = i.service.Reply(modelPath, decision, replyTo, author, comment)
+	} else {
+		err = i.service.Comment(modelPath, decision, author, comment)
+	}
+	if err != nil {
 		return fmt.Errorf("failed to add comment: %w", err)
 	}
 
 	i.output.Commented(decision.ID, author, comment)
 	return nil
 }
+
+func (i *CommentDecisionInteractor) EditComment(modelPath, id, title string, commentID int, comment string) error {
+	decision, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return err
+	}
+
+	if err := i.service.EditComment(modelPath, decision, commentID, comment); err != nil {
+		return fmt.Errorf("failed to edit comment: %w", err)
+	}
+
+	i.output.CommentEdited(decision.ID, commentID)
+	return nil
+}
+
+func (i *CommentDecisionInteractor) DeleteComment(modelPath, id, title string, commentID int) error {
+	decision, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return err
+	}
+
+	if err := i.service.DeleteComment(modelPath, decision, commentID); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	i.output.CommentDeleted(decision.ID, commentID)
+	return nil
+}
@@ -21,7 +21,7 @@ func TestComment_ByID_Success(t *testing.T) {
 	mockOutput.On("Commented", "0012", "John", "Nice!").Return(nil)
 
 	interactor := NewCommentDecisionInteractor(mockService, mockOutput)
-	err := interactor.Comment("model", "0012", "", "John", "Nice!")
+	err := interactor.Comment("model", "0012", "", "John", "Nice!", 0)
 
 	assert.NoError(t, err)
 	mockService.AssertExpectations(t)
@@ -39,7 +39,7 @@ func TestComment_ByTitle_Success(t *testing.T) {
 	mockOutput.On("Commented", "0042", "Alice", "I agree.").Return(nil)
 
 	interactor := NewCommentDecisionInteractor(mockService, mockOutput)
-	err := interactor.Comment("model", "", "My Decision", "Alice", "I agree.")
+	err := interactor.Comment("model", "", "My Decision", "Alice", "I agree.", 0)
 
 	assert.NoError(t, err)
 	mockService.AssertExpectations(t)
@@ -53,7 +53,7 @@ func TestComment_GetDecisionFails(t *testing.T) {
 	mockService.On("GetDecisionByID", "model", "9999").Return(nil, errors.New("not found"))
 
 	interactor := NewCommentDecisionInteractor(mockService, mockOutput)
-	err := interactor.Comment("model", "9999", "", "Bob", "Feedback")
+	err := interactor.Comment("model", "9999", "", "Bob", "Feedback", 0)
 
 	assert.ErrorContains(t, err, "not found")
 	mockService.AssertExpectations(t)
@@ -69,8 +69,59 @@ func TestComment_CommentFails(t *testing.T) {
 	mockService.On("Comment", "model", d, "Jane", "Oops").Return(errors.New("repo error"))
 
 	interactor := NewCommentDecisionInteractor(mockService, mockOutput)
-	err := interactor.Comment("model", "1001", "", "Jane", "Oops")
+	err := interactor.Comment("model", "1001", "", "Jane", "Oops", 0)
 
 	assert.ErrorContains(t, err, "failed to add comment")
 	mockService.AssertExpectations(t)
 }
+
+func TestComment_Reply(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionComment)
+
+	d := &decision.Decision{ID: "0012"}
+
+	mockService.On("GetDecisionByID", "model", "0012").Return(d, nil)
+	mockService.On("Reply", "model", d, 2, "John", "Agreed").Return(nil)
+	mockOutput.On("Commented", "0012", "John", "Agreed").Return()
+
+	interactor := NewCommentDecisionInteractor(mockService, mockOutput)
+	err := interactor.Comment("model", "0012", "", "John", "Agreed", 2)
+
+	assert.NoError(t, err)
+	mockService.AssertNotCalled(t, "Comment")
+	mockOutput.AssertExpectations(t)
+}
+
+func TestEditComment_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionComment)
+
+	d := &decision.Decision{ID: "0012"}
+
+	mockService.On("GetDecisionByID", "model", "0012").Return(d, nil)
+	mockService.On("EditComment", "model", d, 3, "Fixed typo").Return(nil)
+	mockOutput.On("CommentEdited", "0012", 3).Return()
+
+	interactor := NewCommentDecisionInteractor(mockService, mockOutput)
+	err := interactor.EditComment("model", "0012", "", 3, "Fixed typo")
+
+	assert.NoError(t, err)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestDeleteComment_Fails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionComment)
+
+	d := &decision.Decision{ID: "0012"}
+
+	mockService.On("GetDecisionByID", "model", "0012").Return(d, nil)
+	mockService.On("DeleteComment", "model", d, 7).Return(errors.New("comment 7 does not exist"))
+
+	interactor := NewCommentDecisionInteractor(mockService, mockOutput)
+	err := interactor.DeleteComment("model", "0012", "", 7)
+
+	assert.EqualError(t, err, "failed to delete comment: comment 7 does not exist")
+	mockOutput.AssertNotCalled(t, "CommentDeleted")
+}
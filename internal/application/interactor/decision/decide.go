@@ -20,7 +20,7 @@ func NewDecideInteractor(service domain.DecisionService, output outputport.Decis
 	}
 }
 
-func (i *DecideDecisionInteractor) Decide(modelPath, id, title, option, reason, author string, enforceOption bool) error {
+func (i *DecideDecisionInteractor) Decide(modelPath, id, title string, options []string, reason, author, reviewBy string, enforceOption, recommend bool) error {
 	var (
 		decision *domain.Decision
 		err      error
@@ -32,13 +32,33 @@ func (i *DecideDecisionInteractor) Decide(modelPath, id, title, option, reason,
 	}
 
 	if decision.Status == "decided" {
-		return fmt.Errorf("decision has already been decided, revise the decision to create a copy that is still open")
+		return fmt.Errorf("decision has already been decided, reopen it or revise the decision to create a copy that is still open")
 	}
 
-	if err := i.service.Decide(modelPath, decision, option, reason, enforceOption); err != nil {
+	var ranking []domain.OptionScore
+	if recommend {
+		ranking, err = i.service.RankOptions(modelPath, decision)
+		if err != nil {
+			return err
+		}
+		i.output.Recommended(decision.ID, ranking)
+
+		// without an option the recommendation is only proposed
+		if len(options) == 0 {
+			return nil
+		}
+	}
+
+	if err := i.service.Decide(modelPath, decision, options, reason, author, reviewBy, enforceOption); err != nil {
 		return err
 	}
 
+	if recommend {
+		if err := i.service.RecordRecommendation(modelPath, decision, ranking[0]); err != nil {
+			return err
+		}
+	}
+
 	if err := i.service.Comment(modelPath, decision, author, "marked decision as decided"); err != nil {
 		return err
 	}
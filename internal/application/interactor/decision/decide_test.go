@@ -17,12 +17,12 @@ func TestDecide_ByID_Success(t *testing.T) {
 	d := &decision.Decision{ID: "0005", Status: "open"}
 
 	mockService.On("GetDecisionByID", "model", "0005").Return(d, nil)
-	mockService.On("Decide", "model", d, "Option A", "Clear reason", true).Return(nil)
+	mockService.On("Decide", "model", d, []string{"Option A"}, "Clear reason", "Alice", "", true).Return(nil)
 	mockService.On("Comment", "model", d, "Alice", "marked decision as decided").Return(nil)
 	mockOutput.On("Decided", "0005").Return(nil)
 
 	interactor := NewDecideInteractor(mockService, mockOutput)
-	err := interactor.Decide("model", "0005", "", "Option A", "Clear reason", "Alice", true)
+	err := interactor.Decide("model", "0005", "", []string{"Option A"}, "Clear reason", "Alice", "", true, false)
 
 	assert.NoError(t, err)
 	mockService.AssertExpectations(t)
@@ -36,12 +36,12 @@ func TestDecide_ByTitle_Success(t *testing.T) {
 	d := &decision.Decision{ID: "0020", Status: "open"}
 
 	mockService.On("GetDecisionByTitle", "model", "Important").Return(d, nil)
-	mockService.On("Decide", "model", d, "1", "", false).Return(nil)
+	mockService.On("Decide", "model", d, []string{"1"}, "", "Bob", "", false).Return(nil)
 	mockService.On("Comment", "model", d, "Bob", "marked decision as decided").Return(nil)
 	mockOutput.On("Decided", "0020").Return(nil)
 
 	interactor := NewDecideInteractor(mockService, mockOutput)
-	err := interactor.Decide("model", "", "Important", "1", "", "Bob", false)
+	err := interactor.Decide("model", "", "Important", []string{"1"}, "", "Bob", "", false, false)
 
 	assert.NoError(t, err)
 	mockService.AssertExpectations(t)
@@ -57,7 +57,7 @@ func TestDecide_AlreadyDecided(t *testing.T) {
 	mockService.On("GetDecisionByID", "model", "0042").Return(d, nil)
 
 	interactor := NewDecideInteractor(mockService, mockOutput)
-	err := interactor.Decide("model", "0042", "", "Any", "", "Someone", true)
+	err := interactor.Decide("model", "0042", "", []string{"Any"}, "", "Someone", "", true, false)
 
 	assert.ErrorContains(t, err, "already been decided")
 	mockService.AssertExpectations(t)
@@ -70,7 +70,7 @@ func TestDecide_GetDecisionFails(t *testing.T) {
 	mockService.On("GetDecisionByID", "model", "1234").Return(nil, errors.New("not found"))
 
 	interactor := NewDecideInteractor(mockService, mockOutput)
-	err := interactor.Decide("model", "1234", "", "X", "", "Y", true)
+	err := interactor.Decide("model", "1234", "", []string{"X"}, "", "Y", "", true, false)
 
 	assert.ErrorContains(t, err, "not found")
 	mockService.AssertExpectations(t)
@@ -83,10 +83,10 @@ func TestDecide_DecideFails(t *testing.T) {
 	d := &decision.Decision{ID: "0100", Status: "open"}
 
 	mockService.On("GetDecisionByID", "model", "0100").Return(d, nil)
-	mockService.On("Decide", "model", d, "X", "", false).Return(errors.New("fail"))
+	mockService.On("Decide", "model", d, []string{"X"}, "", "author", "", false).Return(errors.New("fail"))
 
 	interactor := NewDecideInteractor(mockService, mockOutput)
-	err := interactor.Decide("model", "0100", "", "X", "", "author", false)
+	err := interactor.Decide("model", "0100", "", []string{"X"}, "", "author", "", false, false)
 
 	assert.ErrorContains(t, err, "fail")
 	mockService.AssertExpectations(t)
@@ -99,12 +99,74 @@ func TestDecide_CommentFails(t *testing.T) {
 	d := &decision.Decision{ID: "0777", Status: "open"}
 
 	mockService.On("GetDecisionByID", "model", "0777").Return(d, nil)
-	mockService.On("Decide", "model", d, "Y", "", false).Return(nil)
+	mockService.On("Decide", "model", d, []string{"Y"}, "", "Zed", "", false).Return(nil)
 	mockService.On("Comment", "model", d, "Zed", "marked decision as decided").Return(errors.New("write failed"))
 
 	interactor := NewDecideInteractor(mockService, mockOutput)
-	err := interactor.Decide("model", "0777", "", "Y", "", "Zed", false)
+	err := interactor.Decide("model", "0777", "", []string{"Y"}, "", "Zed", "", false, false)
 
 	assert.ErrorContains(t, err, "write failed")
 	mockService.AssertExpectations(t)
 }
+
+func TestDecide_RecommendOnly(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionDecide)
+
+	d := &decision.Decision{ID: "0002", Status: "open"}
+	ranking := []decision.OptionScore{
+		{Option: decision.Option{Number: 2, Title: "MySQL"}, Total: 18},
+		{Option: decision.Option{Number: 1, Title: "PostgreSQL"}, Total: 12},
+	}
+
+	mockService.On("GetDecisionByID", "model", "0002").Return(d, nil)
+	mockService.On("RankOptions", "model", d).Return(ranking, nil)
+	mockOutput.On("Recommended", "0002", ranking).Return()
+
+	interactor := NewDecideInteractor(mockService, mockOutput)
+	err := interactor.Decide("model", "0002", "", nil, "", "alice", "", false, true)
+
+	assert.NoError(t, err)
+	mockService.AssertNotCalled(t, "Decide")
+	mockOutput.AssertNotCalled(t, "Decided")
+	mockOutput.AssertExpectations(t)
+}
+
+func TestDecide_RecommendAndDecide(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionDecide)
+
+	d := &decision.Decision{ID: "0002", Status: "open"}
+	ranking := []decision.OptionScore{{Option: decision.Option{Number: 2, Title: "MySQL"}, Total: 18}}
+
+	mockService.On("GetDecisionByID", "model", "0002").Return(d, nil)
+	mockService.On("RankOptions", "model", d).Return(ranking, nil)
+	mockService.On("Decide", "model", d, []string{"1"}, "team preference", "alice", "", false).Return(nil)
+	mockService.On("RecordRecommendation", "model", d, ranking[0]).Return(nil)
+	mockService.On("Comment", "model", d, "alice", "marked decision as decided").Return(nil)
+	mockOutput.On("Recommended", "0002", ranking).Return()
+	mockOutput.On("Decided", "0002").Return()
+
+	interactor := NewDecideInteractor(mockService, mockOutput)
+	err := interactor.Decide("model", "0002", "", []string{"1"}, "team preference", "alice", "", false, true)
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestDecide_RecommendWithoutScores(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionDecide)
+
+	d := &decision.Decision{ID: "0002", Status: "open"}
+
+	mockService.On("GetDecisionByID", "model", "0002").Return(d, nil)
+	mockService.On("RankOptions", "model", d).Return(nil, errors.New("decision 0002 has no scores"))
+
+	interactor := NewDecideInteractor(mockService, mockOutput)
+	err := interactor.Decide("model", "0002", "", []string{"1"}, "", "alice", "", false, true)
+
+	assert.ErrorContains(t, err, "has no scores")
+	mockService.AssertNotCalled(t, "Decide")
+}
@@ -0,0 +1,29 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+)
+
+type DueDecisionsInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionDue
+}
+
+func NewDueDecisionsInteractor(service domain.DecisionService, output outputport.DecisionDue) inputport.DecisionDue {
+	return &DueDecisionsInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *DueDecisionsInteractor) Due(modelPath string, within int) error {
+	due, err := i.service.DueForReview(modelPath, within)
+	if err != nil {
+		return err
+	}
+
+	i.output.Due(due, within)
+	return nil
+}
@@ -0,0 +1,40 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDue_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionDue)
+
+	due := []decision.DueDecision{{Decision: decision.Decision{ID: "0002"}, DaysLeft: -3}}
+
+	mockService.On("DueForReview", "model", 30).Return(due, nil)
+	mockOutput.On("Due", due, 30).Return()
+
+	interactor := NewDueDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Due("model", 30)
+
+	assert.NoError(t, err)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestDue_ServiceFails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionDue)
+
+	mockService.On("DueForReview", "model", 0).Return(nil, errors.New("index missing"))
+
+	interactor := NewDueDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Due("model", 0)
+
+	assert.EqualError(t, err, "index missing")
+	mockOutput.AssertNotCalled(t, "Due")
+}
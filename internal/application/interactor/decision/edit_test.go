@@ -20,11 +20,13 @@ func TestEdit_ByID_Success(t *testing.T) {
 	crit := "Speed, Cost"
 
 	mockSvc.On("GetDecisionByID", "model", "0010").Return(d, nil)
-	mockSvc.On("Edit", "model", d, &q, &opts, &crit).Return(nil)
+	edit := decision.ContentEdit{Question: &q, AddOptions: opts, Criteria: &crit}
+
+	mockSvc.On("Edit", "model", d, edit).Return(nil)
 	mockOut.On("Edited", "0010").Return(nil)
 
 	interactor := NewEditDecisionInteractor(mockSvc, mockOut)
-	err := interactor.Edit("model", "0010", "", &q, &opts, &crit)
+	err := interactor.Edit("model", "0010", "", edit)
 
 	assert.NoError(t, err)
 	mockSvc.AssertExpectations(t)
@@ -40,11 +42,13 @@ func TestEdit_ByTitle_Success(t *testing.T) {
 	opts := []string{"Yes", "No"}
 
 	mockSvc.On("GetDecisionByTitle", "model", "Decide Feature").Return(d, nil)
-	mockSvc.On("Edit", "model", d, &q, &opts, (*string)(nil)).Return(nil)
+	edit := decision.ContentEdit{Question: &q, AddOptions: opts}
+
+	mockSvc.On("Edit", "model", d, edit).Return(nil)
 	mockOut.On("Edited", "0020").Return(nil)
 
 	interactor := NewEditDecisionInteractor(mockSvc, mockOut)
-	err := interactor.Edit("model", "", "Decide Feature", &q, &opts, nil)
+	err := interactor.Edit("model", "", "Decide Feature", edit)
 
 	assert.NoError(t, err)
 	mockSvc.AssertExpectations(t)
@@ -58,7 +62,7 @@ func TestEdit_GetDecisionFails(t *testing.T) {
 	mockSvc.On("GetDecisionByID", "model", "9999").Return(nil, errors.New("not found"))
 
 	interactor := NewEditDecisionInteractor(mockSvc, mockOut)
-	err := interactor.Edit("model", "9999", "", nil, nil, nil)
+	err := interactor.Edit("model", "9999", "", decision.ContentEdit{})
 
 	assert.ErrorContains(t, err, "not found")
 	mockSvc.AssertExpectations(t)
@@ -72,10 +76,12 @@ func TestEdit_EditFails(t *testing.T) {
 	q := "Broken update"
 
 	mockSvc.On("GetDecisionByID", "model", "0055").Return(d, nil)
-	mockSvc.On("Edit", "model", d, &q, (*[]string)(nil), (*string)(nil)).Return(errors.New("write error"))
+	edit := decision.ContentEdit{Question: &q, ReplaceQuestion: true}
+
+	mockSvc.On("Edit", "model", d, edit).Return(errors.New("write error"))
 
 	interactor := NewEditDecisionInteractor(mockSvc, mockOut)
-	err := interactor.Edit("model", "0055", "", &q, nil, nil)
+	err := interactor.Edit("model", "0055", "", edit)
 
 	assert.ErrorContains(t, err, "write error")
 	mockSvc.AssertExpectations(t)
@@ -0,0 +1,94 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"slices"
+)
+
+type GraphDecisionsInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionGraph
+}
+
+func NewGraphDecisionsInteractor(service domain.DecisionService, output outputport.DecisionGraph) inputport.DecisionGraph {
+	return &GraphDecisionsInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+// Graph renders the links between the decisions matching the filters. With a focus decision only
+// the decisions within depth links of it are shown; the focus decision is kept even if it does
+// not match the filters.
+func (i *GraphDecisionsInteractor) Graph(modelPath string, filters map[string][]string, focusID, focusTitle string, depth int, format string) error {
+	decisions, err := i.service.GetAllDecisions(modelPath)
+	if err != nil {
+		return err
+	}
+
+	var focus *domain.Decision
+	if focusID != "" || focusTitle != "" {
+		focus, err = util.ResolveDecisionByIdOrTitle(modelPath, focusID, focusTitle, i.service)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(filters) > 0 {
+		decisions, err = i.service.FilterDecisions(modelPath, decisions, filters)
+		if err != nil {
+			return err
+		}
+		if focus != nil && !slices.ContainsFunc(decisions, func(d domain.Decision) bool { return d.ID == focus.ID }) {
+			decisions = append(decisions, *focus)
+		}
+	}
+
+	decisions = append(decisions, i.externalDecisions(modelPath, decisions)...)
+
+	settings, err := i.service.GetSettings(modelPath)
+	if err != nil {
+		return err
+	}
+
+	graph := domain.BuildGraph(decisions, settings.Lifecycle)
+	if focus != nil {
+		graph, err = graph.Neighbourhood(focus.ID, depth)
+		if err != nil {
+			return err
+		}
+	}
+
+	i.output.Rendered(graph, format)
+	return nil
+}
+
+// externalDecisions resolves the decisions of other models the given decisions link to. They are
+// identified by their qualified ID and their own links are left out. Targets that cannot be
+// resolved are skipped, validate reports them.
+func (i *GraphDecisionsInteractor) externalDecisions(modelPath string, decisions []domain.Decision) []domain.Decision {
+	var references []string
+	for _, d := range decisions {
+		for _, targets := range d.Links.Custom {
+			for _, target := range targets {
+				if domain.IsReference(target) && !slices.Contains(references, target) {
+					references = append(references, target)
+				}
+			}
+		}
+	}
+	slices.Sort(references)
+
+	var external []domain.Decision
+	for _, reference := range references {
+		d, err := i.service.ResolveReference(modelPath, reference)
+		if err != nil {
+			continue
+		}
+		external = append(external, domain.Decision{ID: reference, Title: d.Title, Status: d.Status})
+	}
+	return external
+}
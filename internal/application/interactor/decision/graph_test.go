@@ -0,0 +1,98 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGraph_AllDecisions(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionGraph)
+
+	decisions := []decision.Decision{
+		{ID: "0001", Links: decision.Links{Precedes: []string{"0002"}}},
+		{ID: "0002", Links: decision.Links{Succeeds: []string{"0001"}}},
+	}
+
+	mockService.On("GetAllDecisions", "model").Return(decisions, nil)
+	mockService.On("GetSettings", "model").Return(decision.DefaultModelSettings(), nil)
+	mockOutput.On("Rendered", mock.MatchedBy(func(g decision.Graph) bool {
+		return len(g.Nodes) == 2 && len(g.Edges) == 1 && g.Focus == ""
+	}), "dot").Return()
+
+	interactor := NewGraphDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Graph("model", map[string][]string{}, "", "", 1, "dot")
+
+	assert.NoError(t, err)
+	mockService.AssertNotCalled(t, "FilterDecisions", mock.Anything, mock.Anything, mock.Anything)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestGraph_FocusKeepsFocusDecision(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionGraph)
+
+	focus := decision.Decision{ID: "0001", Status: "open", Links: decision.Links{Precedes: []string{"0002"}}}
+	decided := decision.Decision{ID: "0002", Status: "decided", Links: decision.Links{Succeeds: []string{"0001"}, Precedes: []string{"0003"}}}
+	far := decision.Decision{ID: "0003", Status: "decided", Links: decision.Links{Succeeds: []string{"0002"}}}
+	all := []decision.Decision{focus, decided, far}
+	filters := map[string][]string{"status": {"decided"}}
+
+	mockService.On("GetAllDecisions", "model").Return(all, nil)
+	mockService.On("GetDecisionByID", "model", "0001").Return(&focus, nil)
+	mockService.On("FilterDecisions", mock.Anything, all, filters).Return([]decision.Decision{decided, far}, nil)
+	mockService.On("GetSettings", "model").Return(decision.DefaultModelSettings(), nil)
+	mockOutput.On("Rendered", mock.MatchedBy(func(g decision.Graph) bool {
+		return g.Focus == "0001" && len(g.Nodes) == 2 && g.Nodes[0].ID == "0001" && g.Nodes[1].ID == "0002"
+	}), "mermaid").Return()
+
+	interactor := NewGraphDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Graph("model", filters, "0001", "", 1, "mermaid")
+
+	assert.NoError(t, err)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestGraph_FilterFails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionGraph)
+
+	mockService.On("GetAllDecisions", "model").Return([]decision.Decision{}, nil)
+	mockService.On("FilterDecisions", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("invalid title regex"))
+
+	interactor := NewGraphDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Graph("model", map[string][]string{"title": {"*["}}, "", "", 1, "dot")
+
+	assert.EqualError(t, err, "invalid title regex")
+	mockOutput.AssertNotCalled(t, "Rendered")
+}
+
+func TestGraph_ResolvesExternalDecisions(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionGraph)
+
+	decisions := []decision.Decision{
+		{ID: "0001", Links: decision.Links{Custom: map[string][]string{"depends on": {"platform:0012", "platform:0099"}}}},
+	}
+
+	mockService.On("GetAllDecisions", "model").Return(decisions, nil)
+	mockService.On("ResolveReference", "model", "platform:0012").Return(&decision.Decision{ID: "0012", Title: "Use Kafka", Status: "decided"}, nil)
+	mockService.On("ResolveReference", "model", "platform:0099").Return(nil, errors.New("not found"))
+	mockService.On("GetSettings", "model").Return(decision.DefaultModelSettings(), nil)
+	mockOutput.On("Rendered", mock.MatchedBy(func(g decision.Graph) bool {
+		return len(g.Nodes) == 2 && g.Nodes[1].ID == "platform:0012" && g.Nodes[1].Title == "Use Kafka" &&
+			len(g.Edges) == 1 && g.Edges[0].To == "platform:0012"
+	}), "dot").Return()
+
+	interactor := NewGraphDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Graph("model", map[string][]string{}, "", "", 1, "dot")
+
+	assert.NoError(t, err)
+	mockOutput.AssertExpectations(t)
+}
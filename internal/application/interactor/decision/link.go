@@ -37,6 +37,10 @@ func (i *LinkDecisionsInteractor) Link(
 		return fmt.Errorf("could not find source decision: %w", err)
 	}
 
+	if domain.IsReference(targetID) {
+		return i.linkExternal(modelPath, source, targetID, tag, reverseTag)
+	}
+
 	target, err = util.ResolveDecisionByIdOrTitle(modelPath, targetID, targetTitle, i.service)
 	if err != nil {
 		return fmt.Errorf("could not find target decision: %w", err)
@@ -46,6 +50,18 @@ func (i *LinkDecisionsInteractor) Link(
 		return fmt.Errorf("source and target decision are the same, cannot create a tag from a decision to itself")
 	}
 
+	if tag == "" || reverseTag == "" {
+		settings, err := i.service.GetSettings(modelPath)
+		if err != nil {
+			return err
+		}
+		if tag == "" {
+			tag = settings.InverseLinkTag(reverseTag)
+		} else if reverseTag == "" {
+			reverseTag = settings.InverseLinkTag(tag)
+		}
+	}
+
 	if err := i.service.Link(modelPath, source, target, tag, reverseTag); err != nil {
 		return fmt.Errorf("linking failed: %w", err)
 	}
@@ -53,3 +69,19 @@ func (i *LinkDecisionsInteractor) Link(
 	i.output.Linked(source.ID, target.ID, tag, reverseTag)
 	return nil
 }
+
+func (i *LinkDecisionsInteractor) linkExternal(modelPath string, source *domain.Decision, reference, tag, reverseTag string) error {
+	switch {
+	case tag == "" || tag == "precedes" || tag == "succeeds":
+		return fmt.Errorf("links to decisions of other models need a custom --tag")
+	case reverseTag != "":
+		return fmt.Errorf("links to decisions of other models cannot have a reverse tag")
+	}
+
+	if err := i.service.LinkExternal(modelPath, source, reference, tag); err != nil {
+		return fmt.Errorf("linking failed: %w", err)
+	}
+
+	i.output.Linked(source.ID, reference, tag, "")
+	return nil
+}
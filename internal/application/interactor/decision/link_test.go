@@ -79,10 +79,64 @@ func TestLink_ServiceFails(t *testing.T) {
 
 	mockSvc.On("GetDecisionByID", "model", "004").Return(src, nil)
 	mockSvc.On("GetDecisionByID", "model", "005").Return(dst, nil)
-	mockSvc.On("Link", "model", src, dst, "relates", "").Return(errors.New("write error"))
+	mockSvc.On("GetSettings", "model").Return(decision.DefaultModelSettings(), nil)
+	mockSvc.On("Link", "model", src, dst, "relates", "relates").Return(errors.New("write error"))
 
 	i := NewLinkDecisionsInteractor(mockSvc, mockOut)
 	err := i.Link("model", "004", "", "005", "", "relates", "")
 
 	assert.ErrorContains(t, err, "linking failed")
 }
+
+func TestLink_DerivesReverseTagFromLinkType(t *testing.T) {
+	mockSvc := new(svc_mocks.DecisionService)
+	mockOut := new(out_mocks.DecisionLink)
+
+	src := &decision.Decision{ID: "004"}
+	dst := &decision.Decision{ID: "005"}
+	settings := decision.DefaultModelSettings()
+	settings.LinkTypes = map[string]decision.LinkType{"depends on": {Inverse: "required by"}}
+
+	mockSvc.On("GetDecisionByID", "model", "004").Return(src, nil)
+	mockSvc.On("GetDecisionByID", "model", "005").Return(dst, nil)
+	mockSvc.On("GetSettings", "model").Return(settings, nil)
+	mockSvc.On("Link", "model", src, dst, "depends on", "required by").Return(nil)
+	mockOut.On("Linked", "004", "005", "depends on", "required by").Return()
+
+	i := NewLinkDecisionsInteractor(mockSvc, mockOut)
+	err := i.Link("model", "004", "", "005", "", "depends on", "")
+
+	assert.NoError(t, err)
+	mockOut.AssertExpectations(t)
+}
+
+func TestLink_ExternalReference(t *testing.T) {
+	mockSvc := new(svc_mocks.DecisionService)
+	mockOut := new(out_mocks.DecisionLink)
+
+	src := &decision.Decision{ID: "001"}
+
+	mockSvc.On("GetDecisionByID", "model", "001").Return(src, nil)
+	mockSvc.On("LinkExternal", "model", src, "platform:0012", "depends on").Return(nil)
+	mockOut.On("Linked", "001", "platform:0012", "depends on", "").Return(nil)
+
+	i := NewLinkDecisionsInteractor(mockSvc, mockOut)
+	err := i.Link("model", "001", "", "platform:0012", "", "depends on", "")
+
+	assert.NoError(t, err)
+	mockSvc.AssertExpectations(t)
+	mockOut.AssertExpectations(t)
+}
+
+func TestLink_ExternalReferenceNeedsCustomTag(t *testing.T) {
+	mockSvc := new(svc_mocks.DecisionService)
+	mockOut := new(out_mocks.DecisionLink)
+
+	mockSvc.On("GetDecisionByID", "model", "001").Return(&decision.Decision{ID: "001"}, nil)
+
+	i := NewLinkDecisionsInteractor(mockSvc, mockOut)
+	err := i.Link("model", "001", "", "platform:0012", "", "precedes", "succeeds")
+
+	assert.ErrorContains(t, err, "need a custom --tag")
+	mockOut.AssertNotCalled(t, "Linked")
+}
@@ -4,6 +4,9 @@ import (
 	"github.com/adr/ad-guidance-tool/internal/application/inputport"
 	"github.com/adr/ad-guidance-tool/internal/application/outputport"
 	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+	"slices"
+	"strings"
 )
 
 type ListDecisionsInteractor struct {
@@ -18,19 +21,117 @@ func NewListDecisionsInteractor(service domain.DecisionService, output outputpor
 	}
 }
 
-func (i *ListDecisionsInteractor) ListDecisions(modelPath string, filters map[string][]string, format string) error {
+func (i *ListDecisionsInteractor) ListDecisions(modelPath string, filters map[string][]string, format string, includeSuperseded bool) error {
 	decisions, err := i.service.GetAllDecisions(modelPath)
 	if err != nil {
 		return err
 	}
 
+	statuses, err := statusFilters(filters)
+	if err != nil {
+		return err
+	}
+
+	if err := i.validateStatusFilters(modelPath, statuses); err != nil {
+		return err
+	}
+
 	if len(filters) > 0 {
-		decisions, err = i.service.FilterDecisions(decisions, filters)
+		decisions, err = i.service.FilterDecisions(modelPath, decisions, filters)
 		if err != nil {
 			return err
 		}
 	}
 
-	i.output.Listed(decisions, format)
+	if !includeSuperseded {
+		decisions, err = i.hideSuperseded(modelPath, decisions, statuses)
+		if err != nil {
+			return err
+		}
+	}
+
+	var options map[string][]domain.Option
+	if isStructuredFormat(format) {
+		options, err = i.loadOptions(modelPath, decisions)
+		if err != nil {
+			return err
+		}
+	}
+
+	i.output.Listed(decisions, options, format)
+	return nil
+}
+
+// loadOptions reads the structured options of each decision for formats that can represent them.
+func (i *ListDecisionsInteractor) loadOptions(modelPath string, decisions []domain.Decision) (map[string][]domain.Option, error) {
+	options := make(map[string][]domain.Option, len(decisions))
+	for _, d := range decisions {
+		content, err := i.service.GetDecisionContent(modelPath, d.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load content for ID %q: %w", d.ID, err)
+		}
+		options[d.ID] = domain.ParseOptions(content.Options)
+	}
+	return options, nil
+}
+
+func isStructuredFormat(format string) bool {
+	switch strings.ToLower(format) {
+	case "json", "yaml", "yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// statusFilters returns the statuses given with --status and the ones compared against in --where expressions.
+func statusFilters(filters map[string][]string) ([]string, error) {
+	statuses := slices.Clone(filters["status"])
+	for _, expr := range filters["where"] {
+		query, err := domain.ParseQuery(expr)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, query.Statuses()...)
+	}
+	return statuses, nil
+}
+
+func (i *ListDecisionsInteractor) validateStatusFilters(modelPath string, statuses []string) error {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	settings, err := i.service.GetSettings(modelPath)
+	if err != nil {
+		return err
+	}
+
+	for _, status := range statuses {
+		if !settings.Lifecycle.HasState(status) {
+			return fmt.Errorf("unknown status %q, allowed statuses are: %s", status, strings.Join(settings.Lifecycle.States, ", "))
+		}
+	}
 	return nil
 }
+
+// hideSuperseded removes superseded decisions unless they were explicitly requested via a status filter.
+func (i *ListDecisionsInteractor) hideSuperseded(modelPath string, decisions []domain.Decision, statuses []string) ([]domain.Decision, error) {
+	settings, err := i.service.GetSettings(modelPath)
+	if err != nil {
+		return nil, err
+	}
+
+	superseded := settings.Lifecycle.Superseded
+	if slices.Contains(statuses, superseded) {
+		return decisions, nil
+	}
+
+	visible := make([]domain.Decision, 0, len(decisions))
+	for _, d := range decisions {
+		if d.Status != superseded {
+			visible = append(visible, d)
+		}
+	}
+	return visible, nil
+}
@@ -21,10 +21,10 @@ func TestListDecisions_SuccessWithoutFilters(t *testing.T) {
 	}
 
 	mockSvc.On("GetAllDecisions", "model").Return(decisions, nil)
-	mockOut.On("Listed", decisions, "table").Return(nil)
+	mockOut.On("Listed", decisions, map[string][]decision.Option(nil), "table").Return(nil)
 
 	interactor := NewListDecisionsInteractor(mockSvc, mockOut)
-	err := interactor.ListDecisions("model", map[string][]string{}, "table")
+	err := interactor.ListDecisions("model", map[string][]string{}, "table", true)
 
 	assert.NoError(t, err)
 	mockSvc.AssertExpectations(t)
@@ -44,11 +44,17 @@ func TestListDecisions_SuccessWithFilters(t *testing.T) {
 	}
 
 	mockSvc.On("GetAllDecisions", "model").Return(allDecisions, nil)
-	mockSvc.On("FilterDecisions", allDecisions, mock.Anything).Return(filtered, nil)
-	mockOut.On("Listed", filtered, "json").Return(nil)
+	mockSvc.On("FilterDecisions", mock.Anything, allDecisions, mock.Anything).Return(filtered, nil)
+	mockSvc.On("GetDecisionContent", "model", "002").Return(&decision.DecisionContent{
+		ID:      "002",
+		Options: "1. <a name=\"option-1\"></a> Redis\n\n   - Pro: fast",
+	}, nil)
+	mockOut.On("Listed", filtered, map[string][]decision.Option{
+		"002": {{Number: 1, Title: "Redis", Pros: []string{"fast"}}},
+	}, "json").Return(nil)
 
 	interactor := NewListDecisionsInteractor(mockSvc, mockOut)
-	err := interactor.ListDecisions("model", map[string][]string{"id": {"002"}}, "json")
+	err := interactor.ListDecisions("model", map[string][]string{"id": {"002"}}, "json", true)
 
 	assert.NoError(t, err)
 	mockSvc.AssertExpectations(t)
@@ -62,7 +68,7 @@ func TestListDecisions_GetAllFails(t *testing.T) {
 	mockSvc.On("GetAllDecisions", "model").Return(nil, errors.New("failed to load"))
 
 	interactor := NewListDecisionsInteractor(mockSvc, mockOut)
-	err := interactor.ListDecisions("model", nil, "any")
+	err := interactor.ListDecisions("model", nil, "any", true)
 
 	assert.ErrorContains(t, err, "failed to load")
 	mockSvc.AssertExpectations(t)
@@ -77,10 +83,115 @@ func TestListDecisions_FilterFails(t *testing.T) {
 	}
 
 	mockSvc.On("GetAllDecisions", "model").Return(raw, nil)
-	mockSvc.On("FilterDecisions", raw, mock.Anything).Return(nil, errors.New("bad filter"))
+	mockSvc.On("FilterDecisions", mock.Anything, raw, mock.Anything).Return(nil, errors.New("bad filter"))
 
 	interactor := NewListDecisionsInteractor(mockSvc, mockOut)
-	err := interactor.ListDecisions("model", map[string][]string{"tag": {"urgent"}}, "yaml")
+	err := interactor.ListDecisions("model", map[string][]string{"tag": {"urgent"}}, "yaml", true)
 
 	assert.ErrorContains(t, err, "bad filter")
 }
+
+func TestListDecisions_StatusFilterValidated(t *testing.T) {
+	mockSvc := new(svc_mocks.DecisionService)
+	mockOut := new(out_mocks.DecisionList)
+
+	raw := []decision.Decision{
+		{ID: "001", Title: "X", Status: "decided"},
+	}
+	filters := map[string][]string{"status": {"decided"}}
+
+	mockSvc.On("GetAllDecisions", "model").Return(raw, nil)
+	mockSvc.On("GetSettings", "model").Return(decision.DefaultModelSettings(), nil)
+	mockSvc.On("FilterDecisions", mock.Anything, raw, filters).Return(raw, nil)
+	mockOut.On("Listed", raw, map[string][]decision.Option(nil), "simple").Return()
+
+	interactor := NewListDecisionsInteractor(mockSvc, mockOut)
+	err := interactor.ListDecisions("model", filters, "simple", true)
+
+	assert.NoError(t, err)
+	mockSvc.AssertExpectations(t)
+	mockOut.AssertExpectations(t)
+}
+
+func TestListDecisions_UnknownStatusFilter(t *testing.T) {
+	mockSvc := new(svc_mocks.DecisionService)
+	mockOut := new(out_mocks.DecisionList)
+
+	mockSvc.On("GetAllDecisions", "model").Return([]decision.Decision{}, nil)
+	mockSvc.On("GetSettings", "model").Return(decision.DefaultModelSettings(), nil)
+
+	interactor := NewListDecisionsInteractor(mockSvc, mockOut)
+	err := interactor.ListDecisions("model", map[string][]string{"status": {"accepted"}}, "simple", true)
+
+	assert.ErrorContains(t, err, `unknown status "accepted"`)
+	mockSvc.AssertNotCalled(t, "FilterDecisions")
+	mockOut.AssertNotCalled(t, "Listed")
+}
+
+func TestListDecisions_HidesSuperseded(t *testing.T) {
+	mockSvc := new(svc_mocks.DecisionService)
+	mockOut := new(out_mocks.DecisionList)
+
+	raw := []decision.Decision{
+		{ID: "0001", Title: "Old", Status: "superseded"},
+		{ID: "0002", Title: "New", Status: "decided"},
+	}
+
+	mockSvc.On("GetAllDecisions", "model").Return(raw, nil)
+	mockSvc.On("GetSettings", "model").Return(decision.DefaultModelSettings(), nil)
+	mockOut.On("Listed", []decision.Decision{raw[1]}, map[string][]decision.Option(nil), "simple").Return()
+
+	interactor := NewListDecisionsInteractor(mockSvc, mockOut)
+	err := interactor.ListDecisions("model", map[string][]string{}, "simple", false)
+
+	assert.NoError(t, err)
+	mockOut.AssertExpectations(t)
+}
+
+func TestListDecisions_ShowsSupersededWhenFilteredByStatus(t *testing.T) {
+	mockSvc := new(svc_mocks.DecisionService)
+	mockOut := new(out_mocks.DecisionList)
+
+	raw := []decision.Decision{
+		{ID: "0001", Title: "Old", Status: "superseded"},
+	}
+	filters := map[string][]string{"status": {"superseded"}}
+
+	mockSvc.On("GetAllDecisions", "model").Return(raw, nil)
+	mockSvc.On("GetSettings", "model").Return(decision.DefaultModelSettings(), nil)
+	mockSvc.On("FilterDecisions", mock.Anything, raw, filters).Return(raw, nil)
+	mockOut.On("Listed", raw, map[string][]decision.Option(nil), "simple").Return()
+
+	interactor := NewListDecisionsInteractor(mockSvc, mockOut)
+	err := interactor.ListDecisions("model", filters, "simple", false)
+
+	assert.NoError(t, err)
+	mockOut.AssertExpectations(t)
+}
+
+func TestListDecisions_WhereQueryStatuses(t *testing.T) {
+	mockSvc := new(svc_mocks.DecisionService)
+	mockOut := new(out_mocks.DecisionList)
+
+	raw := []decision.Decision{
+		{ID: "0001", Title: "Old", Status: "superseded", Tags: []string{"security"}},
+	}
+	filters := map[string][]string{"where": {"status = superseded and tag = security"}}
+
+	mockSvc.On("GetAllDecisions", "model").Return(raw, nil)
+	mockSvc.On("GetSettings", "model").Return(decision.DefaultModelSettings(), nil)
+	mockSvc.On("FilterDecisions", mock.Anything, raw, filters).Return(raw, nil)
+	mockOut.On("Listed", raw, map[string][]decision.Option(nil), "simple").Return()
+
+	interactor := NewListDecisionsInteractor(mockSvc, mockOut)
+	err := interactor.ListDecisions("model", filters, "simple", false)
+
+	assert.NoError(t, err)
+	mockOut.AssertExpectations(t)
+
+	err = interactor.ListDecisions("model", map[string][]string{"where": {"status = accepted"}}, "simple", false)
+	assert.ErrorContains(t, err, `unknown status "accepted"`)
+
+	err = interactor.ListDecisions("model", map[string][]string{"where": {"status = (open"}}, "simple", false)
+	assert.ErrorContains(t, err, "invalid query")
+}
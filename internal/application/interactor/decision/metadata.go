@@ -0,0 +1,40 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+)
+
+type MetadataDecisionInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionMetadata
+}
+
+func NewMetadataDecisionInteractor(service domain.DecisionService, output outputport.DecisionMetadata) inputport.DecisionMetadata {
+	return &MetadataDecisionInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *MetadataDecisionInteractor) Metadata(modelPath, id, title string, edit domain.MetadataEdit) error {
+	decision, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return err
+	}
+
+	// without changes the current metadata is only shown
+	if edit.IsEmpty() {
+		i.output.Metadata(decision, false)
+		return nil
+	}
+
+	if err := i.service.EditMetadata(modelPath, decision, edit); err != nil {
+		return err
+	}
+
+	i.output.Metadata(decision, true)
+	return nil
+}
@@ -0,0 +1,66 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadata_ShowOnly(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionMetadata)
+
+	d := &decision.Decision{ID: "0003", Created: "2025-01-01"}
+
+	mockService.On("GetDecisionByID", "model", "0003").Return(d, nil)
+	mockOutput.On("Metadata", d, false).Return()
+
+	interactor := NewMetadataDecisionInteractor(mockService, mockOutput)
+	err := interactor.Metadata("model", "0003", "", decision.MetadataEdit{})
+
+	assert.NoError(t, err)
+	mockService.AssertNotCalled(t, "EditMetadata")
+	mockOutput.AssertExpectations(t)
+}
+
+func TestMetadata_Edit(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionMetadata)
+
+	d := &decision.Decision{ID: "0003"}
+	deciders := []string{"alice"}
+	edit := decision.MetadataEdit{Deciders: &deciders}
+
+	mockService.On("GetDecisionByID", "model", "0003").Return(d, nil)
+	mockService.On("EditMetadata", "model", d, edit).Return(nil)
+	mockOutput.On("Metadata", d, true).Return()
+
+	interactor := NewMetadataDecisionInteractor(mockService, mockOutput)
+	err := interactor.Metadata("model", "0003", "", edit)
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestMetadata_EditFails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionMetadata)
+
+	d := &decision.Decision{ID: "0003"}
+	created := "never"
+	edit := decision.MetadataEdit{Created: &created}
+
+	mockService.On("GetDecisionByID", "model", "0003").Return(d, nil)
+	mockService.On("EditMetadata", "model", d, edit).Return(errors.New("invalid created date"))
+
+	interactor := NewMetadataDecisionInteractor(mockService, mockOutput)
+	err := interactor.Metadata("model", "0003", "", edit)
+
+	assert.EqualError(t, err, "invalid created date")
+	mockOutput.AssertNotCalled(t, "Metadata")
+}
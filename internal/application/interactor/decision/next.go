@@ -0,0 +1,29 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+)
+
+type NextDecisionsInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionNext
+}
+
+func NewNextDecisionsInteractor(service domain.DecisionService, output outputport.DecisionNext) inputport.DecisionNext {
+	return &NextDecisionsInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *NextDecisionsInteractor) Next(modelPath string) error {
+	readiness, err := i.service.Next(modelPath)
+	if err != nil {
+		return err
+	}
+
+	i.output.Next(readiness)
+	return nil
+}
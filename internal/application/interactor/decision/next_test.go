@@ -0,0 +1,39 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNext_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionNext)
+
+	readiness := decision.Readiness{Ready: []decision.Decision{{ID: "0001"}}}
+	mockService.On("Next", "model").Return(readiness, nil)
+	mockOutput.On("Next", readiness).Return()
+
+	interactor := NewNextDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Next("model")
+
+	assert.NoError(t, err)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestNext_Fails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionNext)
+
+	mockService.On("Next", "model").Return(decision.Readiness{}, errors.New("load failed"))
+
+	interactor := NewNextDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Next("model")
+
+	assert.EqualError(t, err, "load failed")
+	mockOutput.AssertNotCalled(t, "Next")
+}
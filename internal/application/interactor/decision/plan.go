@@ -0,0 +1,29 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+)
+
+type PlanDecisionsInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionPlan
+}
+
+func NewPlanDecisionsInteractor(service domain.DecisionService, output outputport.DecisionPlan) inputport.DecisionPlan {
+	return &PlanDecisionsInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *PlanDecisionsInteractor) Plan(modelPath string) error {
+	plan, err := i.service.Plan(modelPath)
+	if err != nil {
+		return err
+	}
+
+	i.output.Planned(plan)
+	return nil
+}
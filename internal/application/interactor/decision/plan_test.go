@@ -0,0 +1,39 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlan_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionPlan)
+
+	plan := decision.Plan{Waves: [][]decision.Decision{{{ID: "0001"}}, {{ID: "0002"}}}}
+	mockService.On("Plan", "model").Return(plan, nil)
+	mockOutput.On("Planned", plan).Return()
+
+	interactor := NewPlanDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Plan("model")
+
+	assert.NoError(t, err)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestPlan_Fails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionPlan)
+
+	mockService.On("Plan", "model").Return(decision.Plan{}, errors.New("load failed"))
+
+	interactor := NewPlanDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Plan("model")
+
+	assert.EqualError(t, err, "load failed")
+	mockOutput.AssertNotCalled(t, "Planned")
+}
@@ -1,6 +1,7 @@
 package decision
 
 import (
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
 	"github.com/adr/ad-guidance-tool/internal/application/inputport"
 	"github.com/adr/ad-guidance-tool/internal/application/outputport"
 	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
@@ -19,15 +20,31 @@ func NewPrintDecisionsInteractor(service domain.DecisionService, output outputpo
 	}
 }
 
-func (i *PrintDecisionsInteractor) Print(modelPath string, ids []string, titles []string, sections map[string]bool) error {
+func (i *PrintDecisionsInteractor) Print(modelPath string, ids []string, titles []string, sections map[string]bool, format string) error {
 	var contents []domain.DecisionContent
+	links := make(map[string][]domain.ResolvedLink)
+	printLinks := len(sections) == 0 || sections["links"]
 
 	for _, id := range ids {
 		content, err := i.service.GetDecisionContent(modelPath, id)
+		if err != nil {
+			if _, ok := util.ResolveIdAsTitle(modelPath, id, i.service); ok {
+				titles = append(titles, id)
+				continue
+			}
+		}
 		if err != nil {
 			return fmt.Errorf("failed to load content for ID %q: %w", id, err)
 		}
 		contents = append(contents, *content)
+
+		if printLinks {
+			decision, err := i.service.GetDecisionByID(modelPath, id)
+			if err != nil {
+				return fmt.Errorf("failed to load links for ID %q: %w", id, err)
+			}
+			links[id] = i.service.ResolveLinks(modelPath, decision)
+		}
 	}
 
 	for _, title := range titles {
@@ -40,8 +57,12 @@ func (i *PrintDecisionsInteractor) Print(modelPath string, ids []string, titles
 			return fmt.Errorf("failed to load content for title %q: %w", title, err)
 		}
 		contents = append(contents, *content)
+
+		if printLinks {
+			links[decision.ID] = i.service.ResolveLinks(modelPath, decision)
+		}
 	}
 
-	i.output.Printed(contents, sections)
+	i.output.Printed(contents, links, sections, format)
 	return nil
 }
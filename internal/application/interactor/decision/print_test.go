@@ -22,16 +22,57 @@ func TestPrint_SuccessWithIDsAndTitles(t *testing.T) {
 	mockSvc.On("GetDecisionByTitle", "model", "Decision 2").
 		Return(&decision.Decision{ID: "002"}, nil)
 	mockSvc.On("GetDecisionContent", "model", "002").Return(content2, nil)
-	mockOut.On("Printed", []decision.DecisionContent{*content1, *content2}, sections).Return(nil)
+	mockOut.On("Printed", []decision.DecisionContent{*content1, *content2}, map[string][]decision.ResolvedLink{}, sections, "text").Return(nil)
 
 	interactor := NewPrintDecisionsInteractor(mockSvc, mockOut)
-	err := interactor.Print("model", []string{"001"}, []string{"Decision 2"}, sections)
+	err := interactor.Print("model", []string{"001"}, []string{"Decision 2"}, sections, "text")
 
 	assert.NoError(t, err)
 	mockSvc.AssertExpectations(t)
 	mockOut.AssertExpectations(t)
 }
 
+func TestPrint_ResolvesLinks(t *testing.T) {
+	mockSvc := new(svc_mocks.DecisionService)
+	mockOut := new(out_mocks.DecisionPrint)
+
+	content := &decision.DecisionContent{ID: "001"}
+	d := &decision.Decision{ID: "001", Links: decision.Links{Custom: map[string][]string{"relates to": {"platform:0012"}}}}
+	resolved := []decision.ResolvedLink{{Type: "relates to", Target: "platform:0012", Model: "platform", Title: "Use Kafka", Status: "decided"}}
+	sections := map[string]bool{"links": true}
+
+	mockSvc.On("GetDecisionContent", "model", "001").Return(content, nil)
+	mockSvc.On("GetDecisionByID", "model", "001").Return(d, nil)
+	mockSvc.On("ResolveLinks", "model", d).Return(resolved)
+	mockOut.On("Printed", []decision.DecisionContent{*content}, map[string][]decision.ResolvedLink{"001": resolved}, sections, "text").Return(nil)
+
+	interactor := NewPrintDecisionsInteractor(mockSvc, mockOut)
+	err := interactor.Print("model", []string{"001"}, nil, sections, "text")
+
+	assert.NoError(t, err)
+	mockSvc.AssertExpectations(t)
+	mockOut.AssertExpectations(t)
+}
+
+func TestPrint_FallsBackToTitleForIDLikeTitles(t *testing.T) {
+	mockSvc := new(svc_mocks.DecisionService)
+	mockOut := new(out_mocks.DecisionPrint)
+
+	content := &decision.DecisionContent{ID: "0003"}
+	sections := map[string]bool{"question": true}
+
+	mockSvc.On("GetDecisionContent", "model", "Kafka2").Return(nil, errors.New("not found"))
+	mockSvc.On("GetDecisionByTitle", "model", "Kafka2").Return(&decision.Decision{ID: "0003"}, nil)
+	mockSvc.On("GetDecisionContent", "model", "0003").Return(content, nil)
+	mockOut.On("Printed", []decision.DecisionContent{*content}, map[string][]decision.ResolvedLink{}, sections, "text").Return(nil)
+
+	interactor := NewPrintDecisionsInteractor(mockSvc, mockOut)
+	err := interactor.Print("model", []string{"Kafka2"}, nil, sections, "text")
+
+	assert.NoError(t, err)
+	mockOut.AssertExpectations(t)
+}
+
 func TestPrint_FailsOnInvalidID(t *testing.T) {
 	mockSvc := new(svc_mocks.DecisionService)
 	mockOut := new(out_mocks.DecisionPrint)
@@ -39,7 +80,7 @@ func TestPrint_FailsOnInvalidID(t *testing.T) {
 	mockSvc.On("GetDecisionContent", "model", "001").Return(nil, errors.New("not found"))
 
 	interactor := NewPrintDecisionsInteractor(mockSvc, mockOut)
-	err := interactor.Print("model", []string{"001"}, nil, nil)
+	err := interactor.Print("model", []string{"001"}, nil, nil, "text")
 
 	assert.ErrorContains(t, err, "failed to load content for ID")
 	mockSvc.AssertExpectations(t)
@@ -52,7 +93,7 @@ func TestPrint_FailsOnInvalidTitle(t *testing.T) {
 	mockSvc.On("GetDecisionByTitle", "model", "Missing").Return(nil, errors.New("not found"))
 
 	interactor := NewPrintDecisionsInteractor(mockSvc, mockOut)
-	err := interactor.Print("model", nil, []string{"Missing"}, nil)
+	err := interactor.Print("model", nil, []string{"Missing"}, nil, "text")
 
 	assert.ErrorContains(t, err, "failed to resolve title")
 	mockSvc.AssertExpectations(t)
@@ -68,7 +109,7 @@ func TestPrint_FailsOnContentLoadFromResolvedTitle(t *testing.T) {
 		Return(nil, errors.New("bad content"))
 
 	interactor := NewPrintDecisionsInteractor(mockSvc, mockOut)
-	err := interactor.Print("model", nil, []string{"D1"}, nil)
+	err := interactor.Print("model", nil, []string{"D1"}, nil, "text")
 
 	assert.ErrorContains(t, err, "failed to load content for title")
 	mockSvc.AssertExpectations(t)
@@ -0,0 +1,36 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type RemoveDecisionInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionRemove
+}
+
+func NewRemoveDecisionInteractor(service domain.DecisionService, output outputport.DecisionRemove) inputport.DecisionRemove {
+	return &RemoveDecisionInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *RemoveDecisionInteractor) Remove(modelPath, id, title string, force bool) error {
+	decision, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return err
+	}
+
+	unlinked, err := i.service.Remove(modelPath, decision, force)
+	if err != nil {
+		return fmt.Errorf("failed to remove decision %s: %w", decision.ID, err)
+	}
+
+	i.output.Removed(decision.ID, unlinked)
+	return nil
+}
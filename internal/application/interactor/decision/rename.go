@@ -0,0 +1,35 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+)
+
+type RenameDecisionInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionRename
+}
+
+func NewRenameDecisionInteractor(service domain.DecisionService, output outputport.DecisionRename) inputport.DecisionRename {
+	return &RenameDecisionInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *RenameDecisionInteractor) Rename(modelPath, id, title, newTitle string) error {
+	decision, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return err
+	}
+
+	oldTitle := decision.Title
+	if err := i.service.Rename(modelPath, decision, newTitle); err != nil {
+		return err
+	}
+
+	i.output.Renamed(decision.ID, oldTitle, decision.Title)
+	return nil
+}
@@ -0,0 +1,61 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRename_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionRename)
+
+	d := &decision.Decision{ID: "0004", Title: "old title"}
+
+	mockService.On("GetDecisionByID", "model", "0004").Return(d, nil)
+	mockService.On("Rename", "model", d, "New title").Run(func(args mock.Arguments) {
+		args.Get(1).(*decision.Decision).Title = "New title"
+	}).Return(nil)
+	mockOutput.On("Renamed", "0004", "old title", "New title").Return()
+
+	interactor := NewRenameDecisionInteractor(mockService, mockOutput)
+	err := interactor.Rename("model", "0004", "", "New title")
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestRename_ResolveError(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionRename)
+
+	mockService.On("GetDecisionByTitle", "model", "missing").Return(nil, errors.New("no decision title matched"))
+
+	interactor := NewRenameDecisionInteractor(mockService, mockOutput)
+	err := interactor.Rename("model", "", "missing", "New title")
+
+	assert.ErrorContains(t, err, "no decision title matched")
+	mockService.AssertNotCalled(t, "Rename")
+}
+
+func TestRename_RenameFails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionRename)
+
+	d := &decision.Decision{ID: "0004", Title: "old title"}
+
+	mockService.On("GetDecisionByID", "model", "0004").Return(d, nil)
+	mockService.On("Rename", "model", d, "taken").Return(errors.New("decision 0002 already has the title"))
+
+	interactor := NewRenameDecisionInteractor(mockService, mockOutput)
+	err := interactor.Rename("model", "0004", "", "taken")
+
+	assert.ErrorContains(t, err, "already has the title")
+	mockOutput.AssertNotCalled(t, "Renamed")
+}
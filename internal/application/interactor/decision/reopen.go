@@ -0,0 +1,43 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type ReopenDecisionInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionReopen
+}
+
+func NewReopenDecisionInteractor(service domain.DecisionService, output outputport.DecisionReopen) inputport.DecisionReopen {
+	return &ReopenDecisionInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *ReopenDecisionInteractor) Reopen(modelPath, id, title, reason, author string) error {
+	decision, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return err
+	}
+
+	if err := i.service.Reopen(modelPath, decision, reason); err != nil {
+		return err
+	}
+
+	comment := "reopened decision"
+	if reason != "" {
+		comment += ": " + reason
+	}
+	if err := i.service.Comment(modelPath, decision, author, comment); err != nil {
+		return fmt.Errorf("failed to record reopening: %w", err)
+	}
+
+	i.output.Reopened(decision.ID, decision.Status)
+	return nil
+}
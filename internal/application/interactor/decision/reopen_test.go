@@ -0,0 +1,66 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReopen_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionReopen)
+
+	d := &decision.Decision{ID: "0005", Status: "decided"}
+
+	mockService.On("GetDecisionByID", "model", "0005").Return(d, nil)
+	mockService.On("Reopen", "model", d, "wrong option picked").Run(func(args mock.Arguments) {
+		args.Get(1).(*decision.Decision).Status = "open"
+	}).Return(nil)
+	mockService.On("Comment", "model", d, "alice", "reopened decision: wrong option picked").Return(nil)
+	mockOutput.On("Reopened", "0005", "open").Return()
+
+	interactor := NewReopenDecisionInteractor(mockService, mockOutput)
+	err := interactor.Reopen("model", "0005", "", "wrong option picked", "alice")
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestReopen_NotDecided(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionReopen)
+
+	d := &decision.Decision{ID: "0005", Status: "open"}
+
+	mockService.On("GetDecisionByID", "model", "0005").Return(d, nil)
+	mockService.On("Reopen", "model", d, "").Return(errors.New("only decisions with status \"decided\" can be reopened"))
+
+	interactor := NewReopenDecisionInteractor(mockService, mockOutput)
+	err := interactor.Reopen("model", "0005", "", "", "alice")
+
+	assert.ErrorContains(t, err, "can be reopened")
+	mockService.AssertNotCalled(t, "Comment")
+	mockOutput.AssertNotCalled(t, "Reopened")
+}
+
+func TestReopen_CommentFails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionReopen)
+
+	d := &decision.Decision{ID: "0005", Status: "decided"}
+
+	mockService.On("GetDecisionByID", "model", "0005").Return(d, nil)
+	mockService.On("Reopen", "model", d, "").Return(nil)
+	mockService.On("Comment", "model", d, "alice", "reopened decision").Return(errors.New("write error"))
+
+	interactor := NewReopenDecisionInteractor(mockService, mockOutput)
+	err := interactor.Reopen("model", "0005", "", "", "alice")
+
+	assert.ErrorContains(t, err, "failed to record reopening")
+}
@@ -0,0 +1,43 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type ReviewDecisionInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionReview
+}
+
+func NewReviewDecisionInteractor(service domain.DecisionService, output outputport.DecisionReview) inputport.DecisionReview {
+	return &ReviewDecisionInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *ReviewDecisionInteractor) Review(modelPath, id, title, result, note, next, author string) error {
+	decision, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return err
+	}
+
+	if err := i.service.Review(modelPath, decision, result, next); err != nil {
+		return err
+	}
+
+	comment := "reviewed decision: " + result
+	if note != "" {
+		comment += ", " + note
+	}
+	if err := i.service.Comment(modelPath, decision, author, comment); err != nil {
+		return fmt.Errorf("failed to record review: %w", err)
+	}
+
+	i.output.Reviewed(decision.ID, result, decision.ReviewBy)
+	return nil
+}
@@ -0,0 +1,50 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReview_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionReview)
+
+	d := &decision.Decision{ID: "0004", Status: "decided"}
+
+	mockService.On("GetDecisionByID", "model", "0004").Return(d, nil)
+	mockService.On("Review", "model", d, "still-valid", "").Run(func(args mock.Arguments) {
+		args.Get(1).(*decision.Decision).ReviewBy = "2027-04-01"
+	}).Return(nil)
+	mockService.On("Comment", "model", d, "alice", "reviewed decision: still-valid, costs unchanged").Return(nil)
+	mockOutput.On("Reviewed", "0004", "still-valid", "2027-04-01").Return()
+
+	interactor := NewReviewDecisionInteractor(mockService, mockOutput)
+	err := interactor.Review("model", "0004", "", "still-valid", "costs unchanged", "", "alice")
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestReview_ServiceFails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionReview)
+
+	d := &decision.Decision{ID: "0004", Status: "open"}
+
+	mockService.On("GetDecisionByID", "model", "0004").Return(d, nil)
+	mockService.On("Review", "model", d, "still-valid", "").Return(errors.New("only decided decisions can be reviewed"))
+
+	interactor := NewReviewDecisionInteractor(mockService, mockOutput)
+	err := interactor.Review("model", "0004", "", "still-valid", "", "", "alice")
+
+	assert.EqualError(t, err, "only decided decisions can be reviewed")
+	mockService.AssertNotCalled(t, "Comment")
+	mockOutput.AssertNotCalled(t, "Reviewed")
+}
@@ -0,0 +1,35 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type ScoreDecisionInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionScore
+}
+
+func NewScoreDecisionInteractor(service domain.DecisionService, output outputport.DecisionScore) inputport.DecisionScore {
+	return &ScoreDecisionInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *ScoreDecisionInteractor) Score(modelPath, id, title, option, criterion string, value int) error {
+	decision, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return err
+	}
+
+	if err := i.service.Score(modelPath, decision, option, criterion, value); err != nil {
+		return fmt.Errorf("failed to score decision %s: %w", decision.ID, err)
+	}
+
+	i.output.Scored(decision.ID, option, criterion, value)
+	return nil
+}
@@ -0,0 +1,45 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScore_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionScore)
+
+	d := &decision.Decision{ID: "0002"}
+
+	mockService.On("GetDecisionByID", "model", "0002").Return(d, nil)
+	mockService.On("Score", "model", d, "1", "2", 4).Return(nil)
+	mockOutput.On("Scored", "0002", "1", "2", 4).Return()
+
+	interactor := NewScoreDecisionInteractor(mockService, mockOutput)
+	err := interactor.Score("model", "0002", "", "1", "2", 4)
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestScore_ServiceError(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionScore)
+
+	d := &decision.Decision{ID: "0002"}
+
+	mockService.On("GetDecisionByID", "model", "0002").Return(d, nil)
+	mockService.On("Score", "model", d, "1", "9", 4).Return(errors.New("criterion number 9 not found"))
+
+	interactor := NewScoreDecisionInteractor(mockService, mockOutput)
+	err := interactor.Score("model", "0002", "", "1", "9", 4)
+
+	assert.EqualError(t, err, "failed to score decision 0002: criterion number 9 not found")
+	mockOutput.AssertNotCalled(t, "Scored")
+}
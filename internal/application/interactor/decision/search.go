@@ -0,0 +1,35 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+)
+
+type SearchDecisionsInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionSearch
+}
+
+func NewSearchDecisionsInteractor(service domain.DecisionService, output outputport.DecisionSearch) inputport.DecisionSearch {
+	return &SearchDecisionsInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+// Search shows the best matches first, limit 0 shows all of them.
+func (i *SearchDecisionsInteractor) Search(modelPath string, query domain.SearchQuery, limit int) error {
+	results, err := i.service.Search(modelPath, query)
+	if err != nil {
+		return err
+	}
+
+	total := len(results)
+	if limit > 0 && total > limit {
+		results = results[:limit]
+	}
+
+	i.output.Searched(results, total)
+	return nil
+}
@@ -0,0 +1,59 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch_LimitsResults(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionSearch)
+
+	query := decision.SearchQuery{Terms: []string{"kafka"}}
+	results := []decision.SearchResult{{ID: "0001"}, {ID: "0002"}, {ID: "0003"}}
+
+	mockService.On("Search", "model", query).Return(results, nil)
+	mockOutput.On("Searched", results[:2], 3).Return()
+
+	interactor := NewSearchDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Search("model", query, 2)
+
+	assert.NoError(t, err)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestSearch_NoLimit(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionSearch)
+
+	query := decision.SearchQuery{Terms: []string{"kafka"}}
+	results := []decision.SearchResult{{ID: "0001"}, {ID: "0002"}}
+
+	mockService.On("Search", "model", query).Return(results, nil)
+	mockOutput.On("Searched", results, 2).Return()
+
+	interactor := NewSearchDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Search("model", query, 0)
+
+	assert.NoError(t, err)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestSearch_ServiceFails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionSearch)
+
+	query := decision.SearchQuery{Terms: []string{"kafka"}}
+	mockService.On("Search", "model", query).Return(nil, errors.New("failed to write search index"))
+
+	interactor := NewSearchDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Search("model", query, 10)
+
+	assert.EqualError(t, err, "failed to write search index")
+	mockOutput.AssertNotCalled(t, "Searched")
+}
@@ -0,0 +1,34 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+)
+
+type SetFieldDecisionInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionSetField
+}
+
+func NewSetFieldDecisionInteractor(service domain.DecisionService, output outputport.DecisionSetField) inputport.DecisionSetField {
+	return &SetFieldDecisionInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *SetFieldDecisionInteractor) SetFields(modelPath, id, title string, values map[string]string) error {
+	decision, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return err
+	}
+
+	if err := i.service.SetFields(modelPath, decision, values); err != nil {
+		return err
+	}
+
+	i.output.FieldsSet(decision.ID, values)
+	return nil
+}
@@ -0,0 +1,47 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFields_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionSetField)
+
+	d := &decision.Decision{ID: "0003"}
+	values := map[string]string{"risk": "high"}
+
+	mockService.On("GetDecisionByID", "model", "0003").Return(d, nil)
+	mockService.On("SetFields", "model", d, values).Return(nil)
+	mockOutput.On("FieldsSet", "0003", values).Return()
+
+	interactor := NewSetFieldDecisionInteractor(mockService, mockOutput)
+	err := interactor.SetFields("model", "0003", "", values)
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestSetFields_ServiceError(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionSetField)
+
+	d := &decision.Decision{ID: "0003"}
+	values := map[string]string{"owner": "jane"}
+
+	mockService.On("GetDecisionByID", "model", "0003").Return(d, nil)
+	mockService.On("SetFields", "model", d, values).Return(errors.New(`field "owner" is not declared`))
+
+	interactor := NewSetFieldDecisionInteractor(mockService, mockOutput)
+	err := interactor.SetFields("model", "0003", "", values)
+
+	assert.EqualError(t, err, `field "owner" is not declared`)
+	mockOutput.AssertNotCalled(t, "FieldsSet")
+}
@@ -0,0 +1,44 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type StatusDecisionInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionStatus
+}
+
+func NewStatusDecisionInteractor(service domain.DecisionService, output outputport.DecisionStatus) inputport.DecisionStatus {
+	return &StatusDecisionInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *StatusDecisionInteractor) ChangeStatus(modelPath, id, title, status, reason, author string) error {
+	decision, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return err
+	}
+
+	from := decision.Status
+	if err := i.service.Transition(modelPath, decision, status); err != nil {
+		return err
+	}
+
+	comment := fmt.Sprintf("changed status from %s to %s", from, status)
+	if reason != "" {
+		comment += ": " + reason
+	}
+	if err := i.service.Comment(modelPath, decision, author, comment); err != nil {
+		return fmt.Errorf("failed to record status change: %w", err)
+	}
+
+	i.output.StatusChanged(decision.ID, from, status)
+	return nil
+}
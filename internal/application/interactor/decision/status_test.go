@@ -0,0 +1,98 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeStatus_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionStatus)
+
+	modelPath := "model"
+	d := &decision.Decision{ID: "0007", Status: "decided"}
+
+	mockService.On("GetDecisionByID", modelPath, "0007").Return(d, nil)
+	mockService.On("Transition", modelPath, d, "deprecated").Return(nil)
+	mockService.On("Comment", modelPath, d, "alice", "changed status from decided to deprecated: replaced by cloud offering").Return(nil)
+	mockOutput.On("StatusChanged", "0007", "decided", "deprecated").Return()
+
+	interactor := NewStatusDecisionInteractor(mockService, mockOutput)
+	err := interactor.ChangeStatus(modelPath, "0007", "", "deprecated", "replaced by cloud offering", "alice")
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestChangeStatus_WithoutReason(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionStatus)
+
+	modelPath := "model"
+	d := &decision.Decision{ID: "0007", Status: "open"}
+
+	mockService.On("GetDecisionByID", modelPath, "0007").Return(d, nil)
+	mockService.On("Transition", modelPath, d, "rejected").Return(nil)
+	mockService.On("Comment", modelPath, d, "alice", "changed status from open to rejected").Return(nil)
+	mockOutput.On("StatusChanged", "0007", "open", "rejected").Return()
+
+	interactor := NewStatusDecisionInteractor(mockService, mockOutput)
+	err := interactor.ChangeStatus(modelPath, "0007", "", "rejected", "", "alice")
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestChangeStatus_ResolveError(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionStatus)
+
+	mockService.On("GetDecisionByID", "model", "0007").Return(nil, errors.New("not found"))
+
+	interactor := NewStatusDecisionInteractor(mockService, mockOutput)
+	err := interactor.ChangeStatus("model", "0007", "", "deprecated", "", "alice")
+
+	assert.ErrorContains(t, err, "not found")
+	mockService.AssertExpectations(t)
+}
+
+func TestChangeStatus_TransitionFails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionStatus)
+
+	d := &decision.Decision{ID: "0007", Status: "open"}
+
+	mockService.On("GetDecisionByID", "model", "0007").Return(d, nil)
+	mockService.On("Transition", "model", d, "deprecated").Return(errors.New("cannot change status from \"open\" to \"deprecated\""))
+
+	interactor := NewStatusDecisionInteractor(mockService, mockOutput)
+	err := interactor.ChangeStatus("model", "0007", "", "deprecated", "", "alice")
+
+	assert.ErrorContains(t, err, "cannot change status")
+	mockService.AssertNotCalled(t, "Comment")
+	mockOutput.AssertNotCalled(t, "StatusChanged")
+}
+
+func TestChangeStatus_CommentFails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionStatus)
+
+	d := &decision.Decision{ID: "0007", Status: "decided"}
+
+	mockService.On("GetDecisionByID", "model", "0007").Return(d, nil)
+	mockService.On("Transition", "model", d, "deprecated").Return(nil)
+	mockService.On("Comment", "model", d, "alice", "changed status from decided to deprecated").Return(errors.New("write error"))
+
+	interactor := NewStatusDecisionInteractor(mockService, mockOutput)
+	err := interactor.ChangeStatus("model", "0007", "", "deprecated", "", "alice")
+
+	assert.ErrorContains(t, err, "failed to record status change")
+	mockOutput.AssertNotCalled(t, "StatusChanged")
+}
@@ -0,0 +1,58 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type SupersedeDecisionInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionSupersede
+}
+
+func NewSupersedeDecisionInteractor(service domain.DecisionService, output outputport.DecisionSupersede) inputport.DecisionSupersede {
+	return &SupersedeDecisionInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *SupersedeDecisionInteractor) Supersede(modelPath, id, title, byID, byTitle, newTitle, reason, author string) error {
+	original, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return fmt.Errorf("failed to find decision to supersede: %w", err)
+	}
+
+	var replacement *domain.Decision
+	created := newTitle != ""
+	if created {
+		replacement, err = i.service.AddNew(modelPath, newTitle)
+		if err != nil {
+			return fmt.Errorf("failed to create replacement decision: %w", err)
+		}
+	} else {
+		replacement, err = util.ResolveDecisionByIdOrTitle(modelPath, byID, byTitle, i.service)
+		if err != nil {
+			return fmt.Errorf("failed to find replacement decision: %w", err)
+		}
+	}
+
+	from := original.Status
+	if err := i.service.Supersede(modelPath, original, replacement); err != nil {
+		return err
+	}
+
+	comment := fmt.Sprintf("changed status from %s to %s, superseded by %s", from, original.Status, replacement.ID)
+	if reason != "" {
+		comment += ": " + reason
+	}
+	if err := i.service.Comment(modelPath, original, author, comment); err != nil {
+		return fmt.Errorf("failed to record status change: %w", err)
+	}
+
+	i.output.Superseded(original.ID, replacement.ID, created)
+	return nil
+}
@@ -0,0 +1,93 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSupersede_ByExistingDecision(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionSupersede)
+
+	original := &decision.Decision{ID: "0003", Status: "decided"}
+	replacement := &decision.Decision{ID: "0011", Status: "decided"}
+
+	mockService.On("GetDecisionByID", "model", "0003").Return(original, nil)
+	mockService.On("GetDecisionByID", "model", "0011").Return(replacement, nil)
+	mockService.On("Supersede", "model", original, replacement).Run(func(args mock.Arguments) {
+		args.Get(1).(*decision.Decision).Status = "superseded"
+	}).Return(nil)
+	mockService.On("Comment", "model", original, "alice", "changed status from decided to superseded, superseded by 0011: cheaper").Return(nil)
+	mockOutput.On("Superseded", "0003", "0011", false).Return()
+
+	interactor := NewSupersedeDecisionInteractor(mockService, mockOutput)
+	err := interactor.Supersede("model", "0003", "", "0011", "", "", "cheaper", "alice")
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestSupersede_WithNewDecision(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionSupersede)
+
+	original := &decision.Decision{ID: "0003", Status: "decided"}
+	replacement := &decision.Decision{ID: "0012", Title: "new approach", Status: "open"}
+
+	mockService.On("GetDecisionByID", "model", "0003").Return(original, nil)
+	mockService.On("AddNew", "model", "new approach").Return(replacement, nil)
+	mockService.On("Supersede", "model", original, replacement).Run(func(args mock.Arguments) {
+		args.Get(1).(*decision.Decision).Status = "superseded"
+	}).Return(nil)
+	mockService.On("Comment", "model", original, "alice", "changed status from decided to superseded, superseded by 0012").Return(nil)
+	mockOutput.On("Superseded", "0003", "0012", true).Return()
+
+	interactor := NewSupersedeDecisionInteractor(mockService, mockOutput)
+	err := interactor.Supersede("model", "0003", "", "", "", "new approach", "", "alice")
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestSupersede_ReplacementNotFound(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionSupersede)
+
+	original := &decision.Decision{ID: "0003", Status: "decided"}
+
+	mockService.On("GetDecisionByID", "model", "0003").Return(original, nil)
+	mockService.On("GetDecisionByID", "model", "0011").Return(nil, errors.New("not found"))
+
+	interactor := NewSupersedeDecisionInteractor(mockService, mockOutput)
+	err := interactor.Supersede("model", "0003", "", "0011", "", "", "", "alice")
+
+	assert.ErrorContains(t, err, "failed to find replacement decision")
+	mockService.AssertNotCalled(t, "Supersede")
+}
+
+func TestSupersede_SupersedeFails(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionSupersede)
+
+	original := &decision.Decision{ID: "0003", Status: "open"}
+	replacement := &decision.Decision{ID: "0011"}
+
+	mockService.On("GetDecisionByID", "model", "0003").Return(original, nil)
+	mockService.On("GetDecisionByID", "model", "0011").Return(replacement, nil)
+	mockService.On("Supersede", "model", original, replacement).Return(errors.New("cannot change status"))
+
+	interactor := NewSupersedeDecisionInteractor(mockService, mockOutput)
+	err := interactor.Supersede("model", "0003", "", "0011", "", "", "", "alice")
+
+	assert.ErrorContains(t, err, "cannot change status")
+	mockService.AssertNotCalled(t, "Comment")
+	mockOutput.AssertNotCalled(t, "Superseded")
+}
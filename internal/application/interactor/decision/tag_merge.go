@@ -0,0 +1,30 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type MergeTagsInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionTagMerge
+}
+
+func NewMergeTagsInteractor(service domain.DecisionService, output outputport.DecisionTagMerge) inputport.DecisionTagMerge {
+	return &MergeTagsInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *MergeTagsInteractor) MergeTags(modelPath string, sourceTags []string, targetTag string) error {
+	updated, err := i.service.ReplaceTags(modelPath, sourceTags, targetTag)
+	if err != nil {
+		return fmt.Errorf("failed to merge tags into %q: %w", targetTag, err)
+	}
+
+	i.output.TagsMerged(sourceTags, targetTag, updated)
+	return nil
+}
@@ -0,0 +1,39 @@
+package decision
+
+import (
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeTags_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionTagMerge)
+
+	sources := []string{"sec", "secu"}
+	mockService.On("ReplaceTags", "model", sources, "security").Return([]string{"0002"}, nil)
+	mockOutput.On("TagsMerged", sources, "security", []string{"0002"}).Return()
+
+	interactor := NewMergeTagsInteractor(mockService, mockOutput)
+	err := interactor.MergeTags("model", sources, "security")
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestMergeTags_ServiceError(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionTagMerge)
+
+	mockService.On("ReplaceTags", "model", []string{"sec"}, "security").Return(nil, errors.New("write error"))
+
+	interactor := NewMergeTagsInteractor(mockService, mockOutput)
+	err := interactor.MergeTags("model", []string{"sec"}, "security")
+
+	assert.ErrorContains(t, err, "failed to merge tags")
+	mockOutput.AssertNotCalled(t, "TagsMerged")
+}
@@ -0,0 +1,34 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type RenameTagInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionTagRename
+}
+
+func NewRenameTagInteractor(service domain.DecisionService, output outputport.DecisionTagRename) inputport.DecisionTagRename {
+	return &RenameTagInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *RenameTagInteractor) RenameTag(modelPath, oldTag, newTag string) error {
+	if oldTag == newTag {
+		return fmt.Errorf("the new tag is the same as the old tag")
+	}
+
+	updated, err := i.service.ReplaceTags(modelPath, []string{oldTag}, newTag)
+	if err != nil {
+		return fmt.Errorf("failed to rename tag %q: %w", oldTag, err)
+	}
+
+	i.output.TagRenamed(oldTag, newTag, updated)
+	return nil
+}
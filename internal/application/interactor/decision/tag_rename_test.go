@@ -0,0 +1,49 @@
+package decision
+
+import (
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenameTag_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionTagRename)
+
+	mockService.On("ReplaceTags", "model", []string{"perf"}, "performance").Return([]string{"0001", "0004"}, nil)
+	mockOutput.On("TagRenamed", "perf", "performance", []string{"0001", "0004"}).Return()
+
+	interactor := NewRenameTagInteractor(mockService, mockOutput)
+	err := interactor.RenameTag("model", "perf", "performance")
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestRenameTag_SameTag(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionTagRename)
+
+	interactor := NewRenameTagInteractor(mockService, mockOutput)
+	err := interactor.RenameTag("model", "perf", "perf")
+
+	assert.ErrorContains(t, err, "same as the old tag")
+	mockService.AssertNotCalled(t, "ReplaceTags")
+}
+
+func TestRenameTag_ServiceError(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionTagRename)
+
+	mockService.On("ReplaceTags", "model", []string{"perf"}, "performance").Return(nil, errors.New("no decision is tagged with \"perf\""))
+
+	interactor := NewRenameTagInteractor(mockService, mockOutput)
+	err := interactor.RenameTag("model", "perf", "performance")
+
+	assert.ErrorContains(t, err, "no decision is tagged")
+	mockOutput.AssertNotCalled(t, "TagRenamed")
+}
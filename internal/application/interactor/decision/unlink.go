@@ -0,0 +1,57 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type UnlinkDecisionsInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionUnlink
+}
+
+func NewUnlinkDecisionsInteractor(service domain.DecisionService, output outputport.DecisionUnlink) inputport.DecisionUnlink {
+	return &UnlinkDecisionsInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *UnlinkDecisionsInteractor) Unlink(
+	modelPath string,
+	sourceID, sourceTitle string,
+	targetID, targetTitle string,
+	tag, reverseTag string,
+) error {
+	source, err := util.ResolveDecisionByIdOrTitle(modelPath, sourceID, sourceTitle, i.service)
+	if err != nil {
+		return fmt.Errorf("could not find source decision: %w", err)
+	}
+
+	target, err := util.ResolveDecisionByIdOrTitle(modelPath, targetID, targetTitle, i.service)
+	if err != nil {
+		return fmt.Errorf("could not find target decision: %w", err)
+	}
+
+	if source.ID == target.ID {
+		return fmt.Errorf("source and target decision are the same")
+	}
+
+	if tag != "" && reverseTag == "" {
+		settings, err := i.service.GetSettings(modelPath)
+		if err != nil {
+			return err
+		}
+		reverseTag = settings.InverseLinkTag(tag)
+	}
+
+	if err := i.service.Unlink(modelPath, source, target, tag, reverseTag); err != nil {
+		return fmt.Errorf("unlinking failed: %w", err)
+	}
+
+	i.output.Unlinked(source.ID, target.ID, tag, reverseTag)
+	return nil
+}
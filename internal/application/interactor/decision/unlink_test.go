@@ -0,0 +1,85 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnlink_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionUnlink)
+
+	source := &decision.Decision{ID: "0001"}
+	target := &decision.Decision{ID: "0002"}
+
+	mockService.On("GetDecisionByID", "model", "0001").Return(source, nil)
+	mockService.On("GetDecisionByID", "model", "0002").Return(target, nil)
+	mockService.On("Unlink", "model", source, target, "precedes", "succeeds").Return(nil)
+	mockOutput.On("Unlinked", "0001", "0002", "precedes", "succeeds").Return()
+
+	interactor := NewUnlinkDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Unlink("model", "0001", "", "0002", "", "precedes", "succeeds")
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestUnlink_SameDecision(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionUnlink)
+
+	d := &decision.Decision{ID: "0001"}
+	mockService.On("GetDecisionByID", "model", "0001").Return(d, nil)
+
+	interactor := NewUnlinkDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Unlink("model", "0001", "", "0001", "", "", "")
+
+	assert.ErrorContains(t, err, "source and target decision are the same")
+	mockService.AssertNotCalled(t, "Unlink")
+}
+
+func TestUnlink_ServiceError(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionUnlink)
+
+	source := &decision.Decision{ID: "0001"}
+	target := &decision.Decision{ID: "0002"}
+
+	mockService.On("GetDecisionByID", "model", "0001").Return(source, nil)
+	mockService.On("GetDecisionByID", "model", "0002").Return(target, nil)
+	mockService.On("Unlink", "model", source, target, "", "").Return(errors.New("no matching link found"))
+
+	interactor := NewUnlinkDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Unlink("model", "0001", "", "0002", "", "", "")
+
+	assert.ErrorContains(t, err, "unlinking failed")
+	mockOutput.AssertNotCalled(t, "Unlinked")
+}
+
+func TestUnlink_DerivesReverseTagFromLinkType(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionUnlink)
+
+	source := &decision.Decision{ID: "0001"}
+	target := &decision.Decision{ID: "0002"}
+	settings := decision.DefaultModelSettings()
+	settings.LinkTypes = map[string]decision.LinkType{"depends on": {Inverse: "required by"}}
+
+	mockService.On("GetDecisionByID", "model", "0001").Return(source, nil)
+	mockService.On("GetDecisionByID", "model", "0002").Return(target, nil)
+	mockService.On("GetSettings", "model").Return(settings, nil)
+	mockService.On("Unlink", "model", source, target, "depends on", "required by").Return(nil)
+	mockOutput.On("Unlinked", "0001", "0002", "depends on", "required by").Return()
+
+	interactor := NewUnlinkDecisionsInteractor(mockService, mockOutput)
+	err := interactor.Unlink("model", "0001", "", "0002", "", "depends on", "")
+
+	assert.NoError(t, err)
+	mockOutput.AssertExpectations(t)
+}
@@ -0,0 +1,37 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	util "github.com/adr/ad-guidance-tool/internal/application/interactor"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"fmt"
+)
+
+type UntagDecisionInteractor struct {
+	service domain.DecisionService
+	output  outputport.DecisionUntag
+}
+
+func NewUntagDecisionInteractor(service domain.DecisionService, output outputport.DecisionUntag) inputport.DecisionUntag {
+	return &UntagDecisionInteractor{
+		service: service,
+		output:  output,
+	}
+}
+
+func (i *UntagDecisionInteractor) Untag(modelPath, id, title string, tags []string) error {
+	decision, err := util.ResolveDecisionByIdOrTitle(modelPath, id, title, i.service)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if err := i.service.Untag(modelPath, decision, tag); err != nil {
+			return fmt.Errorf("failed to remove tag %q from decision: %w", tag, err)
+		}
+	}
+
+	i.output.Untagged(decision.ID, tags)
+	return nil
+}
@@ -0,0 +1,47 @@
+package decision
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUntag_Success(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionUntag)
+
+	d := &decision.Decision{ID: "0012", Tags: []string{"critical", "backend"}}
+	tags := []string{"critical", "backend"}
+
+	mockService.On("GetDecisionByID", "model", "0012").Return(d, nil)
+	mockService.On("Untag", "model", d, "critical").Return(nil)
+	mockService.On("Untag", "model", d, "backend").Return(nil)
+	mockOutput.On("Untagged", "0012", tags).Return()
+
+	interactor := NewUntagDecisionInteractor(mockService, mockOutput)
+	err := interactor.Untag("model", "0012", "", tags)
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestUntag_ServiceError(t *testing.T) {
+	mockService := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.DecisionUntag)
+
+	d := &decision.Decision{ID: "0012"}
+
+	mockService.On("GetDecisionByID", "model", "0012").Return(d, nil)
+	mockService.On("Untag", "model", d, "missing").Return(errors.New("tag \"missing\" does not exist in this decision"))
+
+	interactor := NewUntagDecisionInteractor(mockService, mockOutput)
+	err := interactor.Untag("model", "0012", "", []string{"missing"})
+
+	assert.ErrorContains(t, err, "failed to remove tag")
+	mockOutput.AssertNotCalled(t, "Untagged")
+}
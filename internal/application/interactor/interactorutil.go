@@ -2,11 +2,33 @@ package interactor
 
 import (
 	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	"regexp"
 )
 
+var titleLetterPattern = regexp.MustCompile(`[a-zA-Z]`)
+
+// ResolveDecisionByIdOrTitle loads a decision by its ID or else by its title. An ID that is not
+// found but contains letters, e.g. Kafka2, is tried as a title as well.
 func ResolveDecisionByIdOrTitle(modelPath, id, title string, service decision.DecisionService) (*decision.Decision, error) {
-	if id != "" {
-		return service.GetDecisionByID(modelPath, id)
+	if id == "" {
+		return service.GetDecisionByTitle(modelPath, title)
+	}
+
+	d, err := service.GetDecisionByID(modelPath, id)
+	if err != nil {
+		if byTitle, ok := ResolveIdAsTitle(modelPath, id, service); ok {
+			return byTitle, nil
+		}
+	}
+	return d, err
+}
+
+// ResolveIdAsTitle loads the decision titled like an ID that was not found. IDs such as SEC-0001
+// may also be titles such as Kafka2, IDs without letters are never tried.
+func ResolveIdAsTitle(modelPath, id string, service decision.DecisionService) (*decision.Decision, bool) {
+	if !titleLetterPattern.MatchString(id) {
+		return nil, false
 	}
-	return service.GetDecisionByTitle(modelPath, title)
+	d, err := service.GetDecisionByTitle(modelPath, id)
+	return d, err == nil
 }
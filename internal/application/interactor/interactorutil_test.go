@@ -58,3 +58,27 @@ func TestResolveDecisionByIdOrTitle_ResolveByTitle_Error(t *testing.T) {
 	assert.ErrorContains(t, err, "not found")
 	mockSvc.AssertExpectations(t)
 }
+
+func TestResolveDecisionByIdOrTitle_FallsBackToTitle(t *testing.T) {
+	mockSvc := new(svc_mocks.DecisionService)
+	expected := &decision.Decision{ID: "0003", Title: "Kafka2"}
+
+	mockSvc.On("GetDecisionByID", "test-model", "Kafka2").Return(nil, errors.New("not found"))
+	mockSvc.On("GetDecisionByTitle", "test-model", "Kafka2").Return(expected, nil)
+
+	result, err := ResolveDecisionByIdOrTitle("test-model", "Kafka2", "", mockSvc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestResolveIdAsTitle_SkipsIDsWithoutLetters(t *testing.T) {
+	mockSvc := new(svc_mocks.DecisionService)
+
+	result, ok := ResolveIdAsTitle("test-model", "0001", mockSvc)
+
+	assert.False(t, ok)
+	assert.Nil(t, result)
+	mockSvc.AssertNotCalled(t, "GetDecisionByTitle", "test-model", "0001")
+}
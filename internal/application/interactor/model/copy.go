@@ -37,7 +37,7 @@ func (i *CopyModelInteractor) Copy(modelPath, targetPath string, filters map[str
 	}
 
 	if len(filters) > 0 {
-		decisions, err = i.decisionService.FilterDecisions(decisions, filters)
+		decisions, err = i.decisionService.FilterDecisions(modelPath, decisions, filters)
 		if err != nil {
 			return fmt.Errorf("failed to apply filters: %w", err)
 		}
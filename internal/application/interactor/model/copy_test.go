@@ -52,7 +52,7 @@ func TestCopy_FilterDecisionsFails(t *testing.T) {
 
 	mockModelSvc.On("Exists", "target").Return(false)
 	mockDecisionSvc.On("GetAllDecisions", "source").Return([]decision.Decision{}, nil)
-	mockDecisionSvc.On("FilterDecisions", mock.Anything, mock.Anything).Return(nil, errors.New("filter error"))
+	mockDecisionSvc.On("FilterDecisions", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("filter error"))
 
 	err := interactor.Copy("source", "target", map[string][]string{"tag": {"core"}})
 
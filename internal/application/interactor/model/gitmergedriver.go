@@ -0,0 +1,48 @@
+package model
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	modeldomain "github.com/adr/ad-guidance-tool/internal/domain/model"
+	"fmt"
+)
+
+type GitMergeDriverInteractor struct {
+	modelService modeldomain.ModelService
+	output       outputport.ModelGitMergeDriver
+}
+
+func NewGitMergeDriverInteractor(
+	modelService modeldomain.ModelService,
+	output outputport.ModelGitMergeDriver,
+) inputport.ModelGitMergeDriver {
+	return &GitMergeDriverInteractor{
+		modelService: modelService,
+		output:       output,
+	}
+}
+
+// Merge fails if the merged decision file contains conflicts, so git reports the file as
+// conflicted. Conflicts in the index keep our version and are resolved by rebuilding it.
+func (i *GitMergeDriverInteractor) Merge(basePath, oursPath, theirsPath, filePath string) error {
+	result, err := i.modelService.MergeFile(basePath, oursPath, theirsPath, filePath)
+	if err != nil {
+		return fmt.Errorf("failed to merge %s: %w", filePath, err)
+	}
+
+	i.output.Merged(filePath, result)
+	if !result.Index && len(result.Conflicts) > 0 {
+		return fmt.Errorf("%s has conflicts that have to be resolved by hand", filePath)
+	}
+	return nil
+}
+
+func (i *GitMergeDriverInteractor) Install(modelPath string) error {
+	attributesPath, err := i.modelService.InstallMergeDriver(modelPath)
+	if err != nil {
+		return fmt.Errorf("failed to install the merge driver: %w", err)
+	}
+
+	i.output.Installed(modelPath, attributesPath)
+	return nil
+}
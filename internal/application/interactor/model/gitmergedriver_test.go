@@ -0,0 +1,94 @@
+package model
+
+import (
+	modeldomain "github.com/adr/ad-guidance-tool/internal/domain/model"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitMergeDriver_MergeClean(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockOutput := new(out_mocks.ModelGitMergeDriver)
+
+	result := &modeldomain.MergeResult{Index: true, Collisions: []string{"0002"}}
+	mockModelSvc.On("MergeFile", "base", "ours", "theirs", "m/index.yaml").Return(result, nil)
+	mockOutput.On("Merged", "m/index.yaml", result).Return()
+
+	interactor := NewGitMergeDriverInteractor(mockModelSvc, mockOutput)
+	err := interactor.Merge("base", "ours", "theirs", "m/index.yaml")
+
+	assert.NoError(t, err)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestGitMergeDriver_IndexConflictsDoNotFail(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockOutput := new(out_mocks.ModelGitMergeDriver)
+
+	result := &modeldomain.MergeResult{Index: true, Conflicts: []string{"0001.title"}}
+	mockModelSvc.On("MergeFile", "base", "ours", "theirs", "m/index.yaml").Return(result, nil)
+	mockOutput.On("Merged", "m/index.yaml", result).Return()
+
+	interactor := NewGitMergeDriverInteractor(mockModelSvc, mockOutput)
+	err := interactor.Merge("base", "ours", "theirs", "m/index.yaml")
+
+	assert.NoError(t, err)
+}
+
+func TestGitMergeDriver_DecisionConflictsFail(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockOutput := new(out_mocks.ModelGitMergeDriver)
+
+	result := &modeldomain.MergeResult{Conflicts: []string{"text"}}
+	mockModelSvc.On("MergeFile", "base", "ours", "theirs", "m/AD0001-a.md").Return(result, nil)
+	mockOutput.On("Merged", "m/AD0001-a.md", result).Return()
+
+	interactor := NewGitMergeDriverInteractor(mockModelSvc, mockOutput)
+	err := interactor.Merge("base", "ours", "theirs", "m/AD0001-a.md")
+
+	assert.EqualError(t, err, "m/AD0001-a.md has conflicts that have to be resolved by hand")
+	mockOutput.AssertExpectations(t)
+}
+
+func TestGitMergeDriver_MergeError(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockOutput := new(out_mocks.ModelGitMergeDriver)
+
+	mockModelSvc.On("MergeFile", "base", "ours", "theirs", "m/index.yaml").Return(nil, errors.New("invalid index format"))
+
+	interactor := NewGitMergeDriverInteractor(mockModelSvc, mockOutput)
+	err := interactor.Merge("base", "ours", "theirs", "m/index.yaml")
+
+	assert.EqualError(t, err, "failed to merge m/index.yaml: invalid index format")
+	mockOutput.AssertNotCalled(t, "Merged")
+}
+
+func TestGitMergeDriver_Install(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockOutput := new(out_mocks.ModelGitMergeDriver)
+
+	mockModelSvc.On("InstallMergeDriver", "m").Return("/repo/.gitattributes", nil)
+	mockOutput.On("Installed", "m", "/repo/.gitattributes").Return()
+
+	interactor := NewGitMergeDriverInteractor(mockModelSvc, mockOutput)
+	err := interactor.Install("m")
+
+	assert.NoError(t, err)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestGitMergeDriver_InstallError(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockOutput := new(out_mocks.ModelGitMergeDriver)
+
+	mockModelSvc.On("InstallMergeDriver", "m").Return("", errors.New("m is not in a git repository"))
+
+	interactor := NewGitMergeDriverInteractor(mockModelSvc, mockOutput)
+	err := interactor.Install("m")
+
+	assert.EqualError(t, err, "failed to install the merge driver: m is not in a git repository")
+}
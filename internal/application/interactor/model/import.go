@@ -7,7 +7,6 @@ import (
 	modeldomain "github.com/adr/ad-guidance-tool/internal/domain/model"
 	"fmt"
 	"sort"
-	"strconv"
 )
 
 type ImportModelInteractor struct {
@@ -49,7 +48,7 @@ func (i *ImportModelInteractor) Import(sourcePath, targetPath string, filters ma
 	}
 
 	sort.Slice(decisions, func(a, b int) bool {
-		return decisions[a].ID < decisions[b].ID
+		return decisiondomain.CompareIDs(decisions[a].ID, decisions[b].ID) < 0
 	})
 
 	for _, d := range decisions {
@@ -75,11 +74,10 @@ func (i *ImportModelInteractor) calculateHighestID(targetPath string) (int, erro
 
 	highest := 0
 	for _, d := range decisions {
-		id, err := strconv.Atoi(d.ID)
-		if err != nil {
-			return 0, fmt.Errorf("invalid decision ID %q: %w", d.ID, err)
+		if !decisiondomain.IsDecisionID(d.ID) {
+			return 0, fmt.Errorf("invalid decision ID %q", d.ID)
 		}
-		if id > highest {
+		if id, ok := decisiondomain.IDNumber(d.ID); ok && id > highest {
 			highest = id
 		}
 	}
@@ -94,7 +92,7 @@ func (i *ImportModelInteractor) loadAndFilterDecisions(sourcePath string, filter
 	}
 
 	if len(filters) > 0 {
-		decisions, err = i.decisionService.FilterDecisions(decisions, filters)
+		decisions, err = i.decisionService.FilterDecisions(sourcePath, decisions, filters)
 		if err != nil {
 			return nil, fmt.Errorf("failed to apply filters: %w", err)
 		}
@@ -58,7 +58,7 @@ func TestImport_FilterAndAddSuccess(t *testing.T) {
 	mockModelSvc.On("Exists", "target").Return(true)
 	mockDecisionSvc.On("GetAllDecisions", "target").Return(target, nil)
 	mockDecisionSvc.On("GetAllDecisions", "source").Return(source, nil)
-	mockDecisionSvc.On("FilterDecisions", source, mock.Anything).Return(source, nil)
+	mockDecisionSvc.On("FilterDecisions", mock.Anything, source, mock.Anything).Return(source, nil)
 	mockDecisionSvc.On("GetDecisionContent", "source", mock.Anything).Return(content, nil)
 	mockDecisionSvc.On("AddExisting", "source", "target", mock.Anything, content, 3).Return(&decision.Decision{}, nil).Twice()
 	mockModelSvc.On("RebuildIndex", "target").Return(nil)
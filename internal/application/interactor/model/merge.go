@@ -7,7 +7,6 @@ import (
 	modeldomain "github.com/adr/ad-guidance-tool/internal/domain/model"
 	"fmt"
 	"sort"
-	"strconv"
 )
 
 type MergeModelsInteractor struct {
@@ -72,7 +71,7 @@ func (i *MergeModelsInteractor) copyDecisions(fromModel, toModel string, filters
 	}
 
 	if len(filters) > 0 {
-		decisions, err = i.decisionService.FilterDecisions(decisions, filters)
+		decisions, err = i.decisionService.FilterDecisions(fromModel, decisions, filters)
 		if err != nil {
 			return 0, fmt.Errorf("failed to apply filters: %w", err)
 		}
@@ -80,7 +79,7 @@ func (i *MergeModelsInteractor) copyDecisions(fromModel, toModel string, filters
 	}
 
 	sort.Slice(decisions, func(a, b int) bool {
-		return decisions[a].ID < decisions[b].ID
+		return decisiondomain.CompareIDs(decisions[a].ID, decisions[b].ID) < 0
 	})
 
 	for _, d := range decisions {
@@ -100,11 +99,10 @@ func (i *MergeModelsInteractor) copyDecisions(fromModel, toModel string, filters
 func getHighestID(decisions []decisiondomain.Decision) (int, error) {
 	highest := 0
 	for _, d := range decisions {
-		id, err := strconv.Atoi(d.ID)
-		if err != nil {
-			return 0, err
+		if !decisiondomain.IsDecisionID(d.ID) {
+			return 0, fmt.Errorf("%q is not a decision ID", d.ID)
 		}
-		if id > highest {
+		if id, ok := decisiondomain.IDNumber(d.ID); ok && id > highest {
 			highest = id
 		}
 	}
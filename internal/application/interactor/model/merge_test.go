@@ -46,8 +46,8 @@ func TestMerge_SuccessfulMerge(t *testing.T) {
 
 	mockDecisionSvc.On("GetAllDecisions", "modelA").Return(modelADecisions, nil)
 	mockDecisionSvc.On("GetAllDecisions", "modelB").Return(modelBDecisions, nil)
-	mockDecisionSvc.On("FilterDecisions", modelADecisions, filters).Return(modelADecisions, nil)
-	mockDecisionSvc.On("FilterDecisions", modelBDecisions, filters).Return(modelBDecisions, nil)
+	mockDecisionSvc.On("FilterDecisions", mock.Anything, modelADecisions, filters).Return(modelADecisions, nil)
+	mockDecisionSvc.On("FilterDecisions", mock.Anything, modelBDecisions, filters).Return(modelBDecisions, nil)
 
 	mockDecisionSvc.On("GetDecisionContent", "modelA", "0002").Return(content, nil)
 	mockDecisionSvc.On("AddExisting", "modelA", "target", &modelADecisions[0], content, 0).Return(&decision.Decision{}, nil)
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	decisiondomain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	modeldomain "github.com/adr/ad-guidance-tool/internal/domain/model"
+	"fmt"
+)
+
+type RenumberModelInteractor struct {
+	modelService    modeldomain.ModelService
+	decisionService decisiondomain.DecisionService
+	output          outputport.ModelRenumber
+}
+
+func NewRenumberModelInteractor(
+	modelService modeldomain.ModelService,
+	decisionService decisiondomain.DecisionService,
+	output outputport.ModelRenumber,
+) inputport.ModelRenumber {
+	return &RenumberModelInteractor{
+		modelService:    modelService,
+		decisionService: decisionService,
+		output:          output,
+	}
+}
+
+func (i *RenumberModelInteractor) Renumber(modelPath, order string, mapping map[string]string, dryRun bool) error {
+	changes, err := i.decisionService.Renumber(modelPath, order, mapping, dryRun)
+	if err != nil {
+		return fmt.Errorf("renumbering failed: %w", err)
+	}
+
+	if !dryRun && len(changes) > 0 {
+		if err := i.modelService.RebuildIndex(modelPath); err != nil {
+			return fmt.Errorf("failed to rebuild index: %w", err)
+		}
+	}
+
+	i.output.Renumbered(modelPath, changes, dryRun)
+	return nil
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenumber_RebuildsIndex(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockDecisionSvc := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.ModelRenumber)
+
+	changes := []decision.IDChange{{From: "0003", To: "0002", Title: "A"}}
+
+	mockDecisionSvc.On("Renumber", "model", "id", map[string]string(nil), false).Return(changes, nil)
+	mockModelSvc.On("RebuildIndex", "model").Return(nil)
+	mockOutput.On("Renumbered", "model", changes, false).Return()
+
+	interactor := NewRenumberModelInteractor(mockModelSvc, mockDecisionSvc, mockOutput)
+	err := interactor.Renumber("model", "id", nil, false)
+
+	assert.NoError(t, err)
+	mockDecisionSvc.AssertExpectations(t)
+	mockModelSvc.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestRenumber_DryRunKeepsIndex(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockDecisionSvc := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.ModelRenumber)
+
+	changes := []decision.IDChange{{From: "0003", To: "0002", Title: "A"}}
+	mapping := map[string]string{"0003": "0002"}
+
+	mockDecisionSvc.On("Renumber", "model", "id", mapping, true).Return(changes, nil)
+	mockOutput.On("Renumbered", "model", changes, true).Return()
+
+	interactor := NewRenumberModelInteractor(mockModelSvc, mockDecisionSvc, mockOutput)
+	err := interactor.Renumber("model", "id", mapping, true)
+
+	assert.NoError(t, err)
+	mockModelSvc.AssertNotCalled(t, "RebuildIndex", "model")
+	mockOutput.AssertExpectations(t)
+}
+
+func TestRenumber_ServiceError(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockDecisionSvc := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.ModelRenumber)
+
+	mockDecisionSvc.On("Renumber", "model", "id", map[string]string(nil), false).Return(nil, errors.New("decisions 0001 and 0002 would both get the ID 0002"))
+
+	interactor := NewRenumberModelInteractor(mockModelSvc, mockDecisionSvc, mockOutput)
+	err := interactor.Renumber("model", "id", nil, false)
+
+	assert.EqualError(t, err, "renumbering failed: decisions 0001 and 0002 would both get the ID 0002")
+	mockOutput.AssertNotCalled(t, "Renumbered")
+}
+
+func TestRenumber_RebuildIndexError(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockDecisionSvc := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.ModelRenumber)
+
+	changes := []decision.IDChange{{From: "0003", To: "0002"}}
+
+	mockDecisionSvc.On("Renumber", "model", "id", map[string]string(nil), false).Return(changes, nil)
+	mockModelSvc.On("RebuildIndex", "model").Return(errors.New("write error"))
+
+	interactor := NewRenumberModelInteractor(mockModelSvc, mockDecisionSvc, mockOutput)
+	err := interactor.Renumber("model", "id", nil, false)
+
+	assert.EqualError(t, err, "failed to rebuild index: write error")
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/application/inputport"
+	"github.com/adr/ad-guidance-tool/internal/application/outputport"
+	decisiondomain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	modeldomain "github.com/adr/ad-guidance-tool/internal/domain/model"
+	"fmt"
+)
+
+type ResolveCollisionsInteractor struct {
+	modelService    modeldomain.ModelService
+	decisionService decisiondomain.DecisionService
+	output          outputport.ModelResolveCollisions
+}
+
+func NewResolveCollisionsInteractor(
+	modelService modeldomain.ModelService,
+	decisionService decisiondomain.DecisionService,
+	output outputport.ModelResolveCollisions,
+) inputport.ModelResolveCollisions {
+	return &ResolveCollisionsInteractor{
+		modelService:    modelService,
+		decisionService: decisionService,
+		output:          output,
+	}
+}
+
+// ResolveCollisions rebuilds the index even without collisions, as an index merged by git may be
+// out of date.
+func (i *ResolveCollisionsInteractor) ResolveCollisions(modelPath string, dryRun bool) error {
+	changes, ambiguous, err := i.decisionService.ResolveCollisions(modelPath, dryRun)
+	if err != nil {
+		return fmt.Errorf("resolving collisions failed: %w", err)
+	}
+
+	if !dryRun {
+		if err := i.modelService.RebuildIndex(modelPath); err != nil {
+			return fmt.Errorf("failed to rebuild index: %w", err)
+		}
+	}
+
+	i.output.CollisionsResolved(modelPath, changes, ambiguous, dryRun)
+	return nil
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+	out_mocks "github.com/adr/ad-guidance-tool/mocks/outputport"
+	svc_mocks "github.com/adr/ad-guidance-tool/mocks/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveCollisions_RebuildsIndex(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockDecisionSvc := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.ModelResolveCollisions)
+
+	changes := []decision.IDChange{{From: "0002", To: "0003", Title: "Newer"}}
+	ambiguous := []decision.AmbiguousLink{{From: "0001", Tag: "precedes", ID: "0002", Targets: []string{"0002"}}}
+
+	mockDecisionSvc.On("ResolveCollisions", "model", false).Return(changes, ambiguous, nil)
+	mockModelSvc.On("RebuildIndex", "model").Return(nil)
+	mockOutput.On("CollisionsResolved", "model", changes, ambiguous, false).Return()
+
+	interactor := NewResolveCollisionsInteractor(mockModelSvc, mockDecisionSvc, mockOutput)
+	err := interactor.ResolveCollisions("model", false)
+
+	assert.NoError(t, err)
+	mockModelSvc.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
+
+func TestResolveCollisions_DryRunKeepsIndex(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockDecisionSvc := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.ModelResolveCollisions)
+
+	mockDecisionSvc.On("ResolveCollisions", "model", true).Return([]decision.IDChange(nil), []decision.AmbiguousLink(nil), nil)
+	mockOutput.On("CollisionsResolved", "model", []decision.IDChange(nil), []decision.AmbiguousLink(nil), true).Return()
+
+	interactor := NewResolveCollisionsInteractor(mockModelSvc, mockDecisionSvc, mockOutput)
+	err := interactor.ResolveCollisions("model", true)
+
+	assert.NoError(t, err)
+	mockModelSvc.AssertNotCalled(t, "RebuildIndex", "model")
+	mockOutput.AssertExpectations(t)
+}
+
+func TestResolveCollisions_ServiceError(t *testing.T) {
+	mockModelSvc := new(svc_mocks.ModelService)
+	mockDecisionSvc := new(svc_mocks.DecisionService)
+	mockOutput := new(out_mocks.ModelResolveCollisions)
+
+	mockDecisionSvc.On("ResolveCollisions", "model", false).Return(nil, nil, errors.New("file exists"))
+
+	interactor := NewResolveCollisionsInteractor(mockModelSvc, mockDecisionSvc, mockOutput)
+	err := interactor.ResolveCollisions("model", false)
+
+	assert.EqualError(t, err, "resolving collisions failed: file exists")
+	mockOutput.AssertNotCalled(t, "CollisionsResolved")
+}
@@ -21,7 +21,7 @@ func NewModelValidateInteractor(
 	}
 }
 
-func (i *ModelValidateInteractor) Validate(modelPath string) error {
+func (i *ModelValidateInteractor) Validate(modelPath string, fix bool) error {
 	indexErr := i.service.ValidateIndexDataCorrectness(modelPath)
 
 	// TODO: also validate that decision metadata is correct (all required fields are available)
@@ -30,12 +30,17 @@ func (i *ModelValidateInteractor) Validate(modelPath string) error {
 	if indexErr == nil {
 		dataErr = i.service.ValidateDecisionDataCorrectness(modelPath)
 	}
+	// links are checked in the decision files, so hand edits are found before the index is rebuilt
+	linkErr := i.service.ValidateLinkIntegrity(modelPath, fix)
 
-	i.output.ModelValidated(modelPath, indexErr, dataErr)
+	i.output.ModelValidated(modelPath, indexErr, dataErr, linkErr)
 
 	if indexErr != nil {
 		return indexErr
 	}
+	if dataErr != nil {
+		return dataErr
+	}
 
-	return dataErr
+	return linkErr
 }
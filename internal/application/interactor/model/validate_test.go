@@ -17,11 +17,12 @@ func TestValidate_Success(t *testing.T) {
 
 	mockSvc.On("ValidateIndexDataCorrectness", modelPath).Return(nil)
 	mockSvc.On("ValidateDecisionDataCorrectness", modelPath).Return(nil)
-	mockOutput.On("ModelValidated", modelPath, nil, nil).Return()
+	mockSvc.On("ValidateLinkIntegrity", modelPath, false).Return(nil)
+	mockOutput.On("ModelValidated", modelPath, nil, nil, nil).Return()
 
 	interactor := NewModelValidateInteractor(mockSvc, mockOutput)
 
-	err := interactor.Validate(modelPath)
+	err := interactor.Validate(modelPath, false)
 
 	assert.NoError(t, err)
 	mockSvc.AssertExpectations(t)
@@ -36,18 +37,40 @@ func TestValidate_IndexError(t *testing.T) {
 	indexErr := errors.New("index is broken")
 
 	mockSvc.On("ValidateIndexDataCorrectness", modelPath).Return(indexErr)
-	// Data validation is skipped if indexErr != nil
-	mockOutput.On("ModelValidated", modelPath, indexErr, nil).Return()
+	// Data validation is skipped if indexErr != nil, links are still checked
+	mockSvc.On("ValidateLinkIntegrity", modelPath, false).Return(nil)
+	mockOutput.On("ModelValidated", modelPath, indexErr, nil, nil).Return()
 
 	interactor := NewModelValidateInteractor(mockSvc, mockOutput)
 
-	err := interactor.Validate(modelPath)
+	err := interactor.Validate(modelPath, false)
 
 	assert.EqualError(t, err, indexErr.Error())
 	mockSvc.AssertExpectations(t)
 	mockOutput.AssertExpectations(t)
 }
 
+func TestValidate_ChecksLinksDespiteIndexError(t *testing.T) {
+	mockSvc := new(svc_mocks.ModelService)
+	mockOutput := new(out_mocks.ModelValidate)
+
+	modelPath := "model"
+	indexErr := errors.New("index is out of date")
+	linkErr := errors.New("validation of links completed with errors")
+
+	mockSvc.On("ValidateIndexDataCorrectness", modelPath).Return(indexErr)
+	mockSvc.On("ValidateLinkIntegrity", modelPath, true).Return(linkErr)
+	mockOutput.On("ModelValidated", modelPath, indexErr, nil, linkErr).Return()
+
+	interactor := NewModelValidateInteractor(mockSvc, mockOutput)
+
+	err := interactor.Validate(modelPath, true)
+
+	assert.EqualError(t, err, indexErr.Error())
+	mockSvc.AssertNotCalled(t, "ValidateDecisionDataCorrectness", modelPath)
+	mockOutput.AssertExpectations(t)
+}
+
 func TestValidate_DataError(t *testing.T) {
 	mockSvc := new(svc_mocks.ModelService)
 	mockOutput := new(out_mocks.ModelValidate)
@@ -57,13 +80,35 @@ func TestValidate_DataError(t *testing.T) {
 
 	mockSvc.On("ValidateIndexDataCorrectness", modelPath).Return(nil)
 	mockSvc.On("ValidateDecisionDataCorrectness", modelPath).Return(dataErr)
-	mockOutput.On("ModelValidated", modelPath, nil, dataErr).Return()
+	mockSvc.On("ValidateLinkIntegrity", modelPath, false).Return(nil)
+	mockOutput.On("ModelValidated", modelPath, nil, dataErr, nil).Return()
 
 	interactor := NewModelValidateInteractor(mockSvc, mockOutput)
 
-	err := interactor.Validate(modelPath)
+	err := interactor.Validate(modelPath, false)
 
 	assert.EqualError(t, err, dataErr.Error())
 	mockSvc.AssertExpectations(t)
 	mockOutput.AssertExpectations(t)
 }
+
+func TestValidate_LinkError(t *testing.T) {
+	mockSvc := new(svc_mocks.ModelService)
+	mockOutput := new(out_mocks.ModelValidate)
+
+	modelPath := "model"
+	linkErr := errors.New("validation of links completed with errors")
+
+	mockSvc.On("ValidateIndexDataCorrectness", modelPath).Return(nil)
+	mockSvc.On("ValidateDecisionDataCorrectness", modelPath).Return(nil)
+	mockSvc.On("ValidateLinkIntegrity", modelPath, true).Return(linkErr)
+	mockOutput.On("ModelValidated", modelPath, nil, nil, linkErr).Return()
+
+	interactor := NewModelValidateInteractor(mockSvc, mockOutput)
+
+	err := interactor.Validate(modelPath, true)
+
+	assert.EqualError(t, err, linkErr.Error())
+	mockSvc.AssertExpectations(t)
+	mockOutput.AssertExpectations(t)
+}
@@ -6,38 +6,117 @@ type DecisionAdd interface {
 	Added(successes []*domain.Decision, failures map[string]error)
 }
 
+type DecisionArchive interface {
+	Archived(decisionID string, unlinked []string)
+}
+
 type DecisionComment interface {
 	Commented(decisionID, author, comment string)
+	CommentEdited(decisionID string, commentID int)
+	CommentDeleted(decisionID string, commentID int)
 }
 
 type DecisionDecide interface {
 	Decided(decisionID string)
+	Recommended(decisionID string, ranking []domain.OptionScore)
+}
+
+type DecisionDue interface {
+	Due(decisions []domain.DueDecision, within int)
+}
+
+type DecisionScore interface {
+	Scored(decisionID, option, criterion string, value int)
 }
 
 type DecisionEdit interface {
 	Edited(decisionID string)
 }
 
+type DecisionGraph interface {
+	Rendered(graph domain.Graph, format string)
+}
+
 type DecisionLink interface {
 	Linked(sourceID, targetID, tag, reverseTag string)
 }
 
 type DecisionList interface {
-	Listed(decisions []domain.Decision, format string)
+	Listed(decisions []domain.Decision, options map[string][]domain.Option, format string)
+}
+
+type DecisionNext interface {
+	Next(readiness domain.Readiness)
+}
+
+type DecisionPlan interface {
+	Planned(plan domain.Plan)
 }
 
 type DecisionPrint interface {
-	Printed(content []domain.DecisionContent, sections map[string]bool)
+	Printed(content []domain.DecisionContent, links map[string][]domain.ResolvedLink, sections map[string]bool, format string)
+}
+
+type DecisionReopen interface {
+	Reopened(decisionID, status string)
+}
+
+type DecisionRemove interface {
+	Removed(decisionID string, unlinked []string)
+}
+
+type DecisionMetadata interface {
+	Metadata(decision *domain.Decision, updated bool)
+}
+
+type DecisionSearch interface {
+	Searched(results []domain.SearchResult, total int)
+}
+
+type DecisionSetField interface {
+	FieldsSet(decisionID string, values map[string]string)
+}
+
+type DecisionRename interface {
+	Renamed(decisionID, oldTitle, newTitle string)
+}
+
+type DecisionReview interface {
+	Reviewed(decisionID, result, reviewBy string)
 }
 
 type DecisionRevise interface {
 	Revised(originalID, revisedID string)
 }
 
+type DecisionStatus interface {
+	StatusChanged(decisionID, from, to string)
+}
+
+type DecisionSupersede interface {
+	Superseded(originalID, replacementID string, created bool)
+}
+
 type DecisionTag interface {
 	Tagged(decisionID string, tags []string)
 }
 
+type DecisionUntag interface {
+	Untagged(decisionID string, tags []string)
+}
+
+type DecisionTagRename interface {
+	TagRenamed(oldTag, newTag string, updated []string)
+}
+
+type DecisionTagMerge interface {
+	TagsMerged(sourceTags []string, targetTag string, updated []string)
+}
+
+type DecisionUnlink interface {
+	Unlinked(sourceID, targetID, tag, reverseTag string)
+}
+
 type DecisionRule interface {
 	RuleGenerated(decisionID, ruleFilePath string)
 }
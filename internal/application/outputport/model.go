@@ -1,9 +1,19 @@
 package outputport
 
+import (
+	decision "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	model "github.com/adr/ad-guidance-tool/internal/domain/model"
+)
+
 type ModelCopy interface {
 	Copied(source, target string, copiedDecisions int)
 }
 
+type ModelGitMergeDriver interface {
+	Merged(filePath string, result *model.MergeResult)
+	Installed(modelPath, attributesPath string)
+}
+
 type ModelImport interface {
 	Imported(sourcePath, targetPath string, importedDecisions int) error
 }
@@ -20,6 +30,14 @@ type ModelRebuildIndex interface {
 	IndexRebuilt(modelName string)
 }
 
+type ModelRenumber interface {
+	Renumbered(modelPath string, changes []decision.IDChange, dryRun bool)
+}
+
+type ModelResolveCollisions interface {
+	CollisionsResolved(modelPath string, changes []decision.IDChange, ambiguous []decision.AmbiguousLink, dryRun bool)
+}
+
 type ModelValidate interface {
-	ModelValidated(modelName string, indexErr, dataErr error)
+	ModelValidated(modelName string, indexErr, dataErr, linkErr error)
 }
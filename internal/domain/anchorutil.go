@@ -8,6 +8,11 @@ const (
 	AnchorSectionCriteria = "criteria"
 	AnchorSectionOutcome  = "outcome"
 	AnchorSectionComments = "comments"
+
+	AnchorSectionPreviousOutcomes = "previous-outcomes"
+	AnchorSectionDecisionMatrix   = "decision-matrix"
+
+	AnchorNoticeSuperseded = "superseded-notice"
 )
 
 func AnchorForSection(section string) string {
@@ -26,6 +31,14 @@ func AnchorLinkToOption(number int) string {
 	return fmt.Sprintf("[Option %d](#option-%d)", number, number)
 }
 
+func AnchorForCriterion(number int) string {
+	return fmt.Sprintf(`<a name="criterion-%d"></a>`, number)
+}
+
+func AnchorLinkToCriterion(number int, label string) string {
+	return fmt.Sprintf("[%s](#criterion-%d)", label, number)
+}
+
 func AnchorForComment(number int, author, date, text string) string {
 	return fmt.Sprintf(`<a name="comment-%d"></a>%d. (%s) %s: %s`, number, number, date, author, text)
 }
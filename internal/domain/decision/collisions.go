@@ -0,0 +1,146 @@
+package decision
+
+import (
+	"slices"
+	"sort"
+	"time"
+)
+
+// DecisionFile is a decision together with the path of its file.
+type DecisionFile struct {
+	Path     string
+	Decision Decision
+}
+
+// IDCollision is an ID used by several decision files, as happens when two branches that each
+// added a decision are merged.
+type IDCollision struct {
+	ID    string
+	Files []DecisionFile
+}
+
+// DuplicateIDs returns the IDs used by more than one decision, in order.
+func DuplicateIDs(decisions []Decision) []string {
+	counts := make(map[string]int, len(decisions))
+	for _, d := range decisions {
+		counts[d.ID]++
+	}
+	var duplicates []string
+	for id, count := range counts {
+		if count > 1 {
+			duplicates = append(duplicates, id)
+		}
+	}
+	slices.SortFunc(duplicates, CompareIDs)
+	return duplicates
+}
+
+// CollisionResolution holds the decision files that get a new ID and the other decisions whose
+// links changed.
+type CollisionResolution struct {
+	Changes   []IDChange
+	Moved     []DecisionFile
+	Updated   []Decision
+	Ambiguous []AmbiguousLink
+}
+
+// AmbiguousLink is a link to a colliding ID that cannot be assigned to one of the decisions with
+// that ID, because none or several of them have the reverse entry. Targets holds the IDs the link
+// points to once the collision is resolved.
+type AmbiguousLink struct {
+	From    string
+	Tag     string
+	ID      string
+	Targets []string
+}
+
+// ResolveCollisions keeps the ID of the oldest decision of every collision and allocates new IDs
+// for the others. Decisions created on the same day are ordered by their file path. A link to a
+// colliding ID is redirected to the decisions that have the reverse entry, and kept if none has.
+// Links kept or redirected to several decisions are reported as ambiguous. decisions holds the decisions whose IDs do not collide, existing the IDs already in use.
+func (s *ModelSettings) ResolveCollisions(decisions []Decision, collisions []IDCollision, existing []string, now time.Time) CollisionResolution {
+	type entry struct {
+		decision Decision
+		path     string
+		newID    string
+	}
+
+	var entries []*entry
+	for _, d := range decisions {
+		entries = append(entries, &entry{decision: d, newID: d.ID})
+	}
+
+	var result CollisionResolution
+	used := slices.Clone(existing)
+	groups := make(map[string][]*entry, len(collisions))
+	for _, c := range collisions {
+		files := slices.Clone(c.Files)
+		sort.SliceStable(files, func(i, j int) bool {
+			if files[i].Decision.Created != files[j].Decision.Created {
+				return files[i].Decision.Created < files[j].Decision.Created
+			}
+			return files[i].Path < files[j].Path
+		})
+
+		for i, f := range files {
+			e := &entry{decision: f.Decision, path: f.Path, newID: c.ID}
+			if i > 0 {
+				e.newID = s.IDs.Next(used, now)
+				used = append(used, e.newID)
+				result.Changes = append(result.Changes, IDChange{From: c.ID, To: e.newID, Title: f.Decision.Title})
+			}
+			entries = append(entries, e)
+			groups[c.ID] = append(groups[c.ID], e)
+		}
+	}
+
+	// the links are redirected based on the original links of all decisions
+	links := make([]Links, len(entries))
+	for i, e := range entries {
+		links[i] = mapLinkTargets(e.decision.Links, func(tag, id string) []string {
+			group, collided := groups[id]
+			if !collided {
+				return []string{id}
+			}
+			var owners []string
+			for _, owner := range group {
+				if owner != e && slices.Contains(linkTargets(owner.decision.Links, s.InverseLinkTag(tag)), e.decision.ID) {
+					owners = append(owners, owner.newID)
+				}
+			}
+			if len(owners) == 1 {
+				return owners
+			}
+			if len(owners) == 0 {
+				owners = []string{id}
+			}
+			result.Ambiguous = append(result.Ambiguous, AmbiguousLink{From: e.newID, Tag: tag, ID: id, Targets: owners})
+			return owners
+		})
+	}
+	sort.SliceStable(result.Ambiguous, func(i, j int) bool {
+		a, b := result.Ambiguous[i], result.Ambiguous[j]
+		if a.From != b.From {
+			return CompareIDs(a.From, b.From) < 0
+		}
+		return a.Tag < b.Tag
+	})
+
+	for i, e := range entries {
+		changed := e.newID != e.decision.ID || !linksEqual(links[i], e.decision.Links)
+		e.decision.ID = e.newID
+		e.decision.Links = links[i]
+		switch {
+		case e.path != "" && slices.ContainsFunc(result.Changes, func(c IDChange) bool { return c.To == e.newID }):
+			result.Moved = append(result.Moved, DecisionFile{Path: e.path, Decision: e.decision})
+		case changed:
+			result.Updated = append(result.Updated, e.decision)
+		}
+	}
+	return result
+}
+
+func linksEqual(a, b Links) bool {
+	edgesA, edgesB := sortedLinks(a), sortedLinks(b)
+	return slices.Equal(edgesA, edgesB)
+}
@@ -0,0 +1,70 @@
+package decision
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuplicateIDs(t *testing.T) {
+	decisions := []Decision{{ID: "0010"}, {ID: "0002"}, {ID: "0010"}, {ID: "0003"}, {ID: "0002"}}
+
+	assert.Equal(t, []string{"0002", "0010"}, DuplicateIDs(decisions))
+	assert.Empty(t, DuplicateIDs([]Decision{{ID: "0001"}, {ID: "0002"}}))
+}
+
+func TestResolveCollisions_RenumbersNewerDecision(t *testing.T) {
+	settings := DefaultModelSettings()
+	others := []Decision{
+		{ID: "0001", Title: "Base", Links: Links{Precedes: []string{"0002"}, Succeeds: []string{"0002"}}},
+	}
+	collisions := []IDCollision{{ID: "0002", Files: []DecisionFile{
+		{Path: "m/AD0002-mainline.md", Decision: Decision{ID: "0002", Title: "Mainline", Created: "2026-10-18", Links: Links{Succeeds: []string{"0001"}}}},
+		{Path: "m/AD0002-feature.md", Decision: Decision{ID: "0002", Title: "Feature", Created: "2026-10-17", Links: Links{Precedes: []string{"0001"}}}},
+	}}}
+
+	resolution := settings.ResolveCollisions(others, collisions, []string{"0001", "0002"}, time.Now())
+
+	assert.Equal(t, []IDChange{{From: "0002", To: "0003", Title: "Mainline"}}, resolution.Changes)
+	assert.Equal(t, []DecisionFile{{
+		Path:     "m/AD0002-mainline.md",
+		Decision: Decision{ID: "0003", Title: "Mainline", Created: "2026-10-18", Links: Links{Succeeds: []string{"0001"}}},
+	}}, resolution.Moved)
+	assert.Equal(t, []Decision{
+		{ID: "0001", Title: "Base", Links: Links{Precedes: []string{"0003"}, Succeeds: []string{"0002"}}},
+	}, resolution.Updated)
+	assert.Empty(t, resolution.Ambiguous)
+}
+
+func TestResolveCollisions_LinkOfBothPointsToBoth(t *testing.T) {
+	settings := DefaultModelSettings()
+	others := []Decision{{ID: "0001", Links: Links{Custom: map[string][]string{"relates": {"0002"}}}}}
+	collisions := []IDCollision{{ID: "0002", Files: []DecisionFile{
+		{Path: "m/AD0002-a.md", Decision: Decision{ID: "0002", Links: Links{Custom: map[string][]string{"relates": {"0001"}}}}},
+		{Path: "m/AD0002-b.md", Decision: Decision{ID: "0002", Links: Links{Custom: map[string][]string{"relates": {"0001"}}}}},
+	}}}
+
+	resolution := settings.ResolveCollisions(others, collisions, []string{"0001", "0002", "0007"}, time.Now())
+
+	assert.Equal(t, []IDChange{{From: "0002", To: "0008"}}, resolution.Changes)
+	assert.Len(t, resolution.Updated, 1)
+	assert.Equal(t, []string{"0002", "0008"}, resolution.Updated[0].Links.Custom["relates"])
+	assert.Equal(t, []AmbiguousLink{{From: "0001", Tag: "relates", ID: "0002", Targets: []string{"0002", "0008"}}}, resolution.Ambiguous)
+}
+
+func TestResolveCollisions_KeepsLinksWithoutReverseEntry(t *testing.T) {
+	settings := DefaultModelSettings()
+	settings.IDs = IDScheme{Prefix: "SEC-"}
+	others := []Decision{{ID: "SEC-0001", Links: Links{Precedes: []string{"SEC-0002"}}}}
+	collisions := []IDCollision{{ID: "SEC-0002", Files: []DecisionFile{
+		{Path: "m/ADSEC-0002-b.md", Decision: Decision{ID: "SEC-0002", Title: "B"}},
+		{Path: "m/ADSEC-0002-a.md", Decision: Decision{ID: "SEC-0002", Title: "A"}},
+	}}}
+
+	resolution := settings.ResolveCollisions(others, collisions, []string{"SEC-0001", "SEC-0002"}, time.Now())
+
+	assert.Equal(t, []IDChange{{From: "SEC-0002", To: "SEC-0003", Title: "B"}}, resolution.Changes)
+	assert.Empty(t, resolution.Updated)
+	assert.Equal(t, []AmbiguousLink{{From: "SEC-0001", Tag: "precedes", ID: "SEC-0002", Targets: []string{"SEC-0002"}}}, resolution.Ambiguous)
+}
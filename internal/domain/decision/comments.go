@@ -0,0 +1,129 @@
+package decision
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/adr/ad-guidance-tool/internal/domain"
+)
+
+var commentLinePattern = regexp.MustCompile(`<a name="comment-(\d+)"></a>\d+\. \([^)]*\) (.*)$`)
+
+// upgradeComments gives comments written by older versions, which only stored their number in the
+// metadata, an ID and recovers their text from the comments section.
+func upgradeComments(comments []Comment, section string) []Comment {
+	texts := make(map[int]string)
+	for _, line := range strings.Split(section, "\n") {
+		if m := commentLinePattern.FindStringSubmatch(line); m != nil {
+			number, _ := strconv.Atoi(m[1])
+			texts[number] = m[2]
+		}
+	}
+
+	for i := range comments {
+		c := &comments[i]
+		if c.ID != 0 {
+			continue
+		}
+		c.ID = i + 1
+		if c.Comment != strconv.Itoa(c.ID) {
+			continue
+		}
+		text, ok := texts[c.ID]
+		if !ok {
+			c.Comment = ""
+			continue
+		}
+		if rest, found := strings.CutPrefix(text, c.Author+": "); found {
+			c.Comment = rest
+		} else if _, rest, found := strings.Cut(text, ": "); found {
+			c.Comment = rest
+		}
+	}
+	return comments
+}
+
+func nextCommentID(comments []Comment) int {
+	next := 1
+	for _, c := range comments {
+		if c.ID >= next {
+			next = c.ID + 1
+		}
+	}
+	return next
+}
+
+func findComment(comments []Comment, id int) (int, error) {
+	for i, c := range comments {
+		if c.ID == id {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("comment %d does not exist", id)
+}
+
+// removeComment deletes a comment, its replies are attached to the parent of the deleted comment.
+func removeComment(comments []Comment, id int) []Comment {
+	var parent int
+	var result []Comment
+	for _, c := range comments {
+		if c.ID == id {
+			parent = c.ReplyTo
+			continue
+		}
+		result = append(result, c)
+	}
+	for i := range result {
+		if result[i].ReplyTo == id {
+			result[i].ReplyTo = parent
+		}
+	}
+	return result
+}
+
+// RenderComments renders the comments as threads. Replies follow their parent and are quoted once
+// per level. Replies to comments that no longer exist, or that are not older than the reply, are
+// shown at the top level.
+func RenderComments(comments []Comment) []string {
+	ids := make(map[int]bool, len(comments))
+	for _, c := range comments {
+		ids[c.ID] = true
+	}
+	parentOf := func(c Comment) int {
+		if c.ReplyTo < c.ID && ids[c.ReplyTo] {
+			return c.ReplyTo
+		}
+		return 0
+	}
+
+	var lines []string
+	var render func(parent, depth int)
+	render = func(parent, depth int) {
+		for _, c := range comments {
+			if parentOf(c) != parent {
+				continue
+			}
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, strings.Repeat("> ", depth)+formatComment(c, parentOf(c)))
+			render(c.ID, depth+1)
+		}
+	}
+	render(0, 0)
+	return lines
+}
+
+func formatComment(c Comment, parent int) string {
+	author := c.Author
+	if parent != 0 {
+		author += ", in reply to " + domain.AnchorLinkToComment(parent)
+	}
+	text := c.Comment
+	if c.Edited != "" {
+		text += fmt.Sprintf(" _(edited %s)_", c.Edited)
+	}
+	return domain.AnchorForComment(c.ID, author, c.Date, text)
+}
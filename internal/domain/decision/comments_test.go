@@ -0,0 +1,66 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpgradeComments(t *testing.T) {
+	comments := []Comment{
+		{Author: "al", Date: "2025-01-01 10:00:00", Comment: "1"},
+		{Author: "bo", Date: "2025-01-02 10:00:00", Comment: "2"},
+		{Author: "cy", Date: "2025-01-03 10:00:00", Comment: "3"},
+	}
+	section := `<a name="comment-1"></a>1. (2025-01-01 10:00:00) al: see: the benchmark
+<a name="comment-2"></a>2. (2025-01-02 10:00:00) bo: agreed`
+
+	upgraded := upgradeComments(comments, section)
+
+	assert.Equal(t, []Comment{
+		{ID: 1, Author: "al", Date: "2025-01-01 10:00:00", Comment: "see: the benchmark"},
+		{ID: 2, Author: "bo", Date: "2025-01-02 10:00:00", Comment: "agreed"},
+		{ID: 3, Author: "cy", Date: "2025-01-03 10:00:00", Comment: ""},
+	}, upgraded)
+}
+
+func TestUpgradeComments_KeepsCurrentComments(t *testing.T) {
+	comments := []Comment{{ID: 4, Author: "al", Comment: "2"}}
+
+	assert.Equal(t, comments, upgradeComments(comments, ""))
+}
+
+func TestRenderComments_Threads(t *testing.T) {
+	comments := []Comment{
+		{ID: 1, Author: "al", Date: "d1", Comment: "first"},
+		{ID: 2, Author: "bo", Date: "d2", Comment: "second", Edited: "d5"},
+		{ID: 3, Author: "cy", Date: "d3", Comment: "reply", ReplyTo: 1},
+		{ID: 4, Author: "al", Date: "d4", Comment: "nested", ReplyTo: 3},
+		{ID: 6, Author: "bo", Date: "d6", Comment: "orphan", ReplyTo: 5},
+	}
+
+	assert.Equal(t, []string{
+		`<a name="comment-1"></a>1. (d1) al: first`,
+		"",
+		`> <a name="comment-3"></a>3. (d3) cy, in reply to [Comment 1](#comment-1): reply`,
+		"",
+		`> > <a name="comment-4"></a>4. (d4) al, in reply to [Comment 3](#comment-3): nested`,
+		"",
+		`<a name="comment-2"></a>2. (d2) bo: second _(edited d5)_`,
+		"",
+		`<a name="comment-6"></a>6. (d6) bo: orphan`,
+	}, RenderComments(comments))
+}
+
+func TestRemoveComment_KeepsReplies(t *testing.T) {
+	comments := []Comment{
+		{ID: 1, Comment: "first"},
+		{ID: 2, Comment: "reply", ReplyTo: 1},
+		{ID: 3, Comment: "nested", ReplyTo: 2},
+	}
+
+	remaining := removeComment(comments, 2)
+
+	assert.Equal(t, []Comment{{ID: 1, Comment: "first"}, {ID: 3, Comment: "nested", ReplyTo: 1}}, remaining)
+	assert.Equal(t, 4, nextCommentID(remaining))
+}
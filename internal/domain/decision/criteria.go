@@ -0,0 +1,189 @@
+package decision
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/adr/ad-guidance-tool/internal/domain"
+)
+
+const (
+	defaultCriterionWeight = 1
+	minScore               = 0
+	maxScore               = 5
+)
+
+var criterionLinePattern = regexp.MustCompile(`^\s*\d+\.\s*<a name="criterion-(\d+)"></a>\s*(.*?)\s*(?:\(weight:\s*(\d+)\))?\s*$`)
+
+// Criterion is a numbered entry of the criteria section. Criteria can be mixed with free text.
+type Criterion struct {
+	Number int    `json:"number" yaml:"number"`
+	Title  string `json:"title" yaml:"title"`
+	Weight int    `json:"weight" yaml:"weight"`
+	line   int
+}
+
+type CriterionWeight struct {
+	Criterion string
+	Weight    int
+}
+
+// OptionScore is the weighted score of an option over all criteria.
+type OptionScore struct {
+	Option Option
+	Total  int
+}
+
+// ParseCriteria returns the numbered criteria of a criteria section.
+func ParseCriteria(section string) []Criterion {
+	var criteria []Criterion
+	for i, line := range strings.Split(section, "\n") {
+		m := criterionLinePattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		number, _ := strconv.Atoi(m[1])
+		weight := defaultCriterionWeight
+		if m[3] != "" {
+			weight, _ = strconv.Atoi(m[3])
+		}
+		criteria = append(criteria, Criterion{Number: number, Title: m[2], Weight: weight, line: i})
+	}
+	return criteria
+}
+
+func formatCriterionLine(num int, title string, weight int) string {
+	return fmt.Sprintf("%d. %s %s (weight: %d)", num, domain.AnchorForCriterion(num), title, weight)
+}
+
+// resolveCriterion returns the index of the criterion referenced by its number or title.
+func resolveCriterion(criteria []Criterion, ref string) (int, error) {
+	ref = strings.TrimSpace(ref)
+	if number, err := strconv.Atoi(ref); err == nil {
+		for i, c := range criteria {
+			if c.Number == number {
+				return i, nil
+			}
+		}
+		return -1, fmt.Errorf("criterion number %d not found", number)
+	}
+	for i, c := range criteria {
+		if strings.EqualFold(c.Title, ref) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("criterion %q not found", ref)
+}
+
+// editCriteriaLines adds criteria and changes weights in the lines of a criteria section.
+func editCriteriaLines(section string, add []string, weights []CriterionWeight) ([]string, error) {
+	var lines []string
+	if strings.TrimSpace(section) != "" {
+		lines = strings.Split(section, "\n")
+	}
+	criteria := ParseCriteria(section)
+
+	next := 0
+	for _, c := range criteria {
+		next = max(next, c.Number)
+	}
+	for _, title := range add {
+		title = strings.TrimSpace(title)
+		if title == "" {
+			return nil, fmt.Errorf("criterion title must not be empty")
+		}
+		if _, err := resolveCriterion(criteria, title); err == nil {
+			return nil, fmt.Errorf("cannot create criterion %s because it already exists", title)
+		}
+		next++
+		criteria = append(criteria, Criterion{Number: next, Title: title, Weight: defaultCriterionWeight, line: len(lines)})
+		lines = append(lines, formatCriterionLine(next, title, defaultCriterionWeight))
+	}
+
+	for _, w := range weights {
+		idx, err := resolveCriterion(criteria, w.Criterion)
+		if err != nil {
+			return nil, err
+		}
+		if w.Weight < 1 {
+			return nil, fmt.Errorf("weight of criterion %s must be a positive number", w.Criterion)
+		}
+		c := &criteria[idx]
+		c.Weight = w.Weight
+		lines[c.line] = formatCriterionLine(c.Number, c.Title, c.Weight)
+	}
+
+	return lines, nil
+}
+
+// rankOptions computes the weighted score of every option, best first. Unscored cells count as zero.
+func rankOptions(options []Option, criteria []Criterion, scores map[string]map[string]int) []OptionScore {
+	ranking := make([]OptionScore, 0, len(options))
+	for _, opt := range options {
+		ranking = append(ranking, OptionScore{Option: opt, Total: weightedScore(opt, criteria, scores)})
+	}
+	sort.SliceStable(ranking, func(i, j int) bool {
+		return ranking[i].Total > ranking[j].Total
+	})
+	return ranking
+}
+
+func weightedScore(opt Option, criteria []Criterion, scores map[string]map[string]int) int {
+	total := 0
+	for _, c := range criteria {
+		total += c.Weight * scores[strconv.Itoa(opt.Number)][strconv.Itoa(c.Number)]
+	}
+	return total
+}
+
+// renderDecisionMatrix renders the scores as a Markdown table with one row per option.
+func renderDecisionMatrix(options []Option, criteria []Criterion, scores map[string]map[string]int) []string {
+	header := []string{"Option"}
+	separator := []string{"---"}
+	for _, c := range criteria {
+		header = append(header, fmt.Sprintf("%s (weight %d)", domain.AnchorLinkToCriterion(c.Number, c.Title), c.Weight))
+		separator = append(separator, "---:")
+	}
+	header = append(header, "Weighted score")
+	separator = append(separator, "---:")
+
+	lines := []string{tableRow(header), tableRow(separator)}
+	for _, opt := range options {
+		row := []string{fmt.Sprintf("[%s](#option-%d)", opt.Title, opt.Number)}
+		for _, c := range criteria {
+			cell := "-"
+			if score, ok := scores[strconv.Itoa(opt.Number)][strconv.Itoa(c.Number)]; ok {
+				cell = strconv.Itoa(score)
+			}
+			row = append(row, cell)
+		}
+		row = append(row, strconv.Itoa(weightedScore(opt, criteria, scores)))
+		lines = append(lines, tableRow(row))
+	}
+	return lines
+}
+
+func tableRow(cells []string) string {
+	return "| " + strings.Join(cells, " | ") + " |"
+}
+
+// remapScores moves the scores to the new option numbers. Scores of removed options are dropped.
+func remapScores(scores map[string]map[string]int, mapping map[int]int) map[string]map[string]int {
+	if len(scores) == 0 {
+		return scores
+	}
+	remapped := make(map[string]map[string]int)
+	for option, row := range scores {
+		number, err := strconv.Atoi(option)
+		if err != nil {
+			continue
+		}
+		if newNumber, ok := mapping[number]; ok {
+			remapped[strconv.Itoa(newNumber)] = row
+		}
+	}
+	return remapped
+}
@@ -0,0 +1,95 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const twoCriteria = `Must be cheap to operate.
+1. <a name="criterion-1"></a> Performance (weight: 3)
+2. <a name="criterion-2"></a> Cost`
+
+func TestParseCriteria_ReadsWeights(t *testing.T) {
+	criteria := ParseCriteria(twoCriteria)
+
+	assert.Len(t, criteria, 2)
+	assert.Equal(t, "Performance", criteria[0].Title)
+	assert.Equal(t, 3, criteria[0].Weight)
+	assert.Equal(t, "Cost", criteria[1].Title)
+	assert.Equal(t, defaultCriterionWeight, criteria[1].Weight)
+}
+
+func TestEditCriteriaLines_AddAndWeigh(t *testing.T) {
+	lines, err := editCriteriaLines(twoCriteria, []string{"Security"}, []CriterionWeight{{Criterion: "cost", Weight: 2}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"Must be cheap to operate.",
+		`1. <a name="criterion-1"></a> Performance (weight: 3)`,
+		`2. <a name="criterion-2"></a> Cost (weight: 2)`,
+		`3. <a name="criterion-3"></a> Security (weight: 1)`,
+	}, lines)
+}
+
+func TestEditCriteriaLines_Errors(t *testing.T) {
+	_, err := editCriteriaLines(twoCriteria, []string{"Performance"}, nil)
+	assert.EqualError(t, err, "cannot create criterion Performance because it already exists")
+
+	_, err = editCriteriaLines(twoCriteria, nil, []CriterionWeight{{Criterion: "1", Weight: 0}})
+	assert.EqualError(t, err, "weight of criterion 1 must be a positive number")
+
+	_, err = editCriteriaLines(twoCriteria, nil, []CriterionWeight{{Criterion: "9", Weight: 2}})
+	assert.EqualError(t, err, "criterion number 9 not found")
+}
+
+func TestRankOptions_WeightedAndStable(t *testing.T) {
+	options := []Option{{Number: 1, Title: "A"}, {Number: 2, Title: "B"}, {Number: 3, Title: "C"}}
+	criteria := ParseCriteria(twoCriteria)
+	scores := map[string]map[string]int{
+		"1": {"1": 2, "2": 5},
+		"2": {"1": 4},
+		"3": {"1": 1, "2": 8},
+	}
+
+	ranking := rankOptions(options, criteria, scores)
+
+	assert.Equal(t, 2, ranking[0].Option.Number)
+	assert.Equal(t, 12, ranking[0].Total)
+	assert.Equal(t, 1, ranking[1].Option.Number)
+	assert.Equal(t, 11, ranking[1].Total)
+	assert.Equal(t, 3, ranking[2].Option.Number)
+	assert.Equal(t, 11, ranking[2].Total)
+}
+
+func TestRenderDecisionMatrix(t *testing.T) {
+	options := []Option{{Number: 1, Title: "A"}, {Number: 2, Title: "B"}}
+	scores := map[string]map[string]int{"1": {"1": 2, "2": 5}}
+
+	lines := renderDecisionMatrix(options, ParseCriteria(twoCriteria), scores)
+
+	assert.Equal(t, []string{
+		"| Option | [Performance](#criterion-1) (weight 3) | [Cost](#criterion-2) (weight 1) | Weighted score |",
+		"| --- | ---: | ---: | ---: |",
+		"| [A](#option-1) | 2 | 5 | 11 |",
+		"| [B](#option-2) | - | - | 0 |",
+	}, lines)
+}
+
+func TestRemapScores_DropsRemovedOptions(t *testing.T) {
+	scores := map[string]map[string]int{"1": {"1": 2}, "2": {"1": 4}}
+
+	remapped := remapScores(scores, map[int]int{2: 1})
+
+	assert.Equal(t, map[string]map[string]int{"1": {"1": 4}}, remapped)
+}
+
+func TestEditCriteriaLines_WeighNewCriterion(t *testing.T) {
+	lines, err := editCriteriaLines("", []string{"Performance", "Cost"}, []CriterionWeight{{Criterion: "Performance", Weight: 3}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`1. <a name="criterion-1"></a> Performance (weight: 3)`,
+		`2. <a name="criterion-2"></a> Cost (weight: 1)`,
+	}, lines)
+}
@@ -9,6 +9,19 @@ type Decision struct {
 	Tags     []string  `yaml:"tags,omitempty"`
 	Links    Links     `yaml:"links,omitempty"`
 	Comments []Comment `yaml:"comments,omitempty"`
+	// Created, DecidedAt and ReviewBy are dates in the format YYYY-MM-DD
+	Created   string   `yaml:"created,omitempty"`
+	DecidedAt string   `yaml:"decided_at,omitempty"`
+	ReviewBy  string   `yaml:"review_by,omitempty"`
+	Deciders  []string `yaml:"deciders,omitempty"`
+	Consulted []string `yaml:"consulted,omitempty"`
+	Informed  []string `yaml:"informed,omitempty"`
+	// ChosenOptions holds the numbers of the options selected by 'adg decide'
+	ChosenOptions []int `yaml:"chosen_options,omitempty"`
+	// Scores holds the decision matrix as option number -> criterion number -> score
+	Scores map[string]map[string]int `yaml:"scores,omitempty"`
+	// Fields holds all other frontmatter keys, such as the custom fields declared in the model settings
+	Fields map[string]any `yaml:",inline"`
 }
 
 type Links struct {
@@ -17,19 +30,37 @@ type Links struct {
 	Custom   map[string][]string `yaml:"custom,omitempty"`
 }
 
+// Comment holds the text of a comment. IDs are never reused, replies refer to the ID of their parent.
 type Comment struct {
+	ID      int    `yaml:"id,omitempty"`
 	Author  string `yaml:"author"`
 	Date    string `yaml:"date"`
 	Comment string `yaml:"comment"`
+	ReplyTo int    `yaml:"reply_to,omitempty"`
+	Edited  string `yaml:"edited,omitempty"`
 }
 
 type DecisionContent struct {
-	ID       string
-	Question string
-	Criteria string
-	Options  string
-	Outcome  string
-	Comments string
+	ID               string
+	Question         string
+	Criteria         string
+	DecisionMatrix   string
+	Options          string
+	Outcome          string
+	PreviousOutcomes string
+	Comments         string
+	// Sections holds every section of the file in order, including the ones with a field above
+	Sections []Section
+}
+
+// keeps custom date fields as strings
+func (d *Decision) UnmarshalYAML(unmarshal func(any) error) error {
+	type plain Decision
+	if err := unmarshal((*plain)(d)); err != nil {
+		return err
+	}
+	NormalizeDates(d.Fields)
+	return nil
 }
 
 // flattens custom links into the main links block
@@ -155,3 +155,25 @@ func TestLinks_MarshalYAML_OmitsEmptyArrays(t *testing.T) {
 	assert.Contains(t, asMap, "nonempty")
 	assert.ElementsMatch(t, []string{"X"}, asMap["nonempty"])
 }
+
+func TestDecision_UnmarshalYAML_KeepsCustomFields(t *testing.T) {
+	input := `
+adr_id: "0001"
+title: test
+status: open
+risk: high
+review: 2025-04-01
+components: [api, web]
+`
+
+	var d Decision
+	err := yaml.Unmarshal([]byte(input), &d)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0001", d.ID)
+	assert.Equal(t, map[string]any{"risk": "high", "review": "2025-04-01", "components": []any{"api", "web"}}, d.Fields)
+
+	out, err := yaml.Marshal(d)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `review: "2025-04-01"`)
+}
@@ -0,0 +1,217 @@
+package decision
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Types of custom fields that can be declared in the model settings.
+const (
+	FieldTypeString  = "string"
+	FieldTypeNumber  = "number"
+	FieldTypeBoolean = "boolean"
+	FieldTypeDate    = "date"
+	FieldTypeList    = "list"
+)
+
+var fieldTypes = []string{FieldTypeString, FieldTypeNumber, FieldTypeBoolean, FieldTypeDate, FieldTypeList}
+
+// FieldDefinition declares a custom metadata field of the decisions in a model.
+// Values restricts string fields and the items of list fields to a fixed set.
+type FieldDefinition struct {
+	Type     string   `yaml:"type"`
+	Values   []string `yaml:"values,omitempty"`
+	Required bool     `yaml:"required,omitempty"`
+}
+
+// reservedFieldNames returns the frontmatter keys managed by the Decision type itself.
+func reservedFieldNames() []string {
+	t := reflect.TypeOf(Decision{})
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func validateFieldDefinitions(fields map[string]FieldDefinition) error {
+	reserved := reservedFieldNames()
+	for _, name := range sortedFieldNames(fields) {
+		def := fields[name]
+		if slices.Contains(reserved, name) {
+			return fmt.Errorf("field %q is a built-in metadata key and cannot be redefined", name)
+		}
+		if !slices.Contains(fieldTypes, def.Type) {
+			return fmt.Errorf("field %q has unknown type %q (allowed: %s)", name, def.Type, strings.Join(fieldTypes, ", "))
+		}
+		if len(def.Values) > 0 && def.Type != FieldTypeString && def.Type != FieldTypeList {
+			return fmt.Errorf("field %q of type %s cannot restrict its values", name, def.Type)
+		}
+	}
+	return nil
+}
+
+func sortedFieldNames(fields map[string]FieldDefinition) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Parse converts a value given on the command line to the type of the field.
+func (f FieldDefinition) Parse(raw string) (any, error) {
+	raw = strings.TrimSpace(raw)
+	var value any
+	switch f.Type {
+	case FieldTypeNumber:
+		if n, err := strconv.Atoi(raw); err == nil {
+			value = n
+		} else if n, err := strconv.ParseFloat(raw, 64); err == nil {
+			value = n
+		} else {
+			return nil, fmt.Errorf("%q is not a number", raw)
+		}
+	case FieldTypeBoolean:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", raw)
+		}
+		value = b
+	case FieldTypeList:
+		var items []any
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		value = items
+	default:
+		value = raw
+	}
+
+	if err := f.Check(value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// Check reports whether a value read from the metadata of a decision matches the definition.
+func (f FieldDefinition) Check(value any) error {
+	switch f.Type {
+	case FieldTypeNumber:
+		switch value.(type) {
+		case int, int64, float64:
+			return nil
+		}
+		return fmt.Errorf("%v is not a number", value)
+	case FieldTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%v is not a boolean", value)
+		}
+		return nil
+	case FieldTypeDate:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%v is not a date in the format YYYY-MM-DD", value)
+		}
+		if _, err := time.Parse(DateLayout, s); err != nil {
+			return fmt.Errorf("%q is not a date in the format YYYY-MM-DD", s)
+		}
+		return nil
+	case FieldTypeList:
+		items, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%v is not a list", value)
+		}
+		for _, item := range items {
+			if err := f.checkAllowed(fmt.Sprint(item)); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%v is not a string", value)
+		}
+		return f.checkAllowed(s)
+	}
+}
+
+func (f FieldDefinition) checkAllowed(value string) error {
+	if len(f.Values) > 0 && !slices.Contains(f.Values, value) {
+		return fmt.Errorf("%q is not allowed (allowed: %s)", value, strings.Join(f.Values, ", "))
+	}
+	return nil
+}
+
+// ValidateFields checks the custom fields of a decision against the fields declared in the model settings.
+func (s *ModelSettings) ValidateFields(d Decision) []error {
+	var problems []error
+	for _, name := range sortedFieldNames(s.Fields) {
+		def := s.Fields[name]
+		value, ok := d.Fields[name]
+		if !ok || value == nil {
+			if def.Required {
+				problems = append(problems, fmt.Errorf("missing required field %q", name))
+			}
+			continue
+		}
+		if err := def.Check(value); err != nil {
+			problems = append(problems, fmt.Errorf("invalid value for field %q: %w", name, err))
+		}
+	}
+	return problems
+}
+
+// matchesField reports whether a decision has one of the given field values, given as key=value.
+// The items of list fields are matched individually.
+func matchesField(d Decision, filters []string) bool {
+	for _, f := range filters {
+		key, want, ok := strings.Cut(f, "=")
+		if !ok {
+			continue
+		}
+		switch value := d.Fields[strings.TrimSpace(key)].(type) {
+		case nil:
+		case []any:
+			for _, item := range value {
+				if fmt.Sprint(item) == want {
+					return true
+				}
+			}
+		default:
+			if fmt.Sprint(value) == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NormalizeDates turns dates that YAML decoded as timestamps back into strings so that they
+// keep their format when written again. Dates without a time of day use DateLayout.
+func NormalizeDates(fields map[string]any) {
+	for key, value := range fields {
+		if t, ok := value.(time.Time); ok {
+			fields[key] = formatTimestamp(t)
+		}
+	}
+}
+
+func formatTimestamp(t time.Time) string {
+	if t.Equal(t.Truncate(24 * time.Hour)) {
+		return t.Format(DateLayout)
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,72 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldDefinition_Parse(t *testing.T) {
+	number := FieldDefinition{Type: FieldTypeNumber}
+	value, err := number.Parse("4711")
+	assert.NoError(t, err)
+	assert.Equal(t, 4711, value)
+
+	_, err = number.Parse("many")
+	assert.EqualError(t, err, `"many" is not a number`)
+
+	list := FieldDefinition{Type: FieldTypeList, Values: []string{"api", "frontend"}}
+	value, err = list.Parse("api, frontend")
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"api", "frontend"}, value)
+
+	_, err = list.Parse("api,backend")
+	assert.EqualError(t, err, `"backend" is not allowed (allowed: api, frontend)`)
+
+	date := FieldDefinition{Type: FieldTypeDate}
+	_, err = date.Parse("next week")
+	assert.EqualError(t, err, `"next week" is not a date in the format YYYY-MM-DD`)
+}
+
+func TestValidateFieldDefinitions(t *testing.T) {
+	assert.NoError(t, validateFieldDefinitions(map[string]FieldDefinition{"risk": {Type: FieldTypeString, Values: []string{"low"}}}))
+
+	err := validateFieldDefinitions(map[string]FieldDefinition{"status": {Type: FieldTypeString}})
+	assert.EqualError(t, err, `field "status" is a built-in metadata key and cannot be redefined`)
+
+	err = validateFieldDefinitions(map[string]FieldDefinition{"risk": {Type: "enum"}})
+	assert.EqualError(t, err, `field "risk" has unknown type "enum" (allowed: string, number, boolean, date, list)`)
+
+	err = validateFieldDefinitions(map[string]FieldDefinition{"cost": {Type: FieldTypeNumber, Values: []string{"1"}}})
+	assert.EqualError(t, err, `field "cost" of type number cannot restrict its values`)
+}
+
+func TestValidateFields_ReportsMissingAndInvalid(t *testing.T) {
+	settings := &ModelSettings{Fields: map[string]FieldDefinition{
+		"risk":     {Type: FieldTypeString, Values: []string{"low", "high"}, Required: true},
+		"reviewed": {Type: FieldTypeBoolean},
+		"owner":    {Type: FieldTypeString, Required: true},
+	}}
+
+	problems := settings.ValidateFields(Decision{ID: "0001", Fields: map[string]any{"risk": "medium", "reviewed": "yes"}})
+
+	assert.Len(t, problems, 3)
+	assert.EqualError(t, problems[0], `missing required field "owner"`)
+	assert.EqualError(t, problems[1], `invalid value for field "reviewed": yes is not a boolean`)
+	assert.EqualError(t, problems[2], `invalid value for field "risk": "medium" is not allowed (allowed: low, high)`)
+}
+
+func TestFilterDecisions_ByField(t *testing.T) {
+	service := &DecisionServiceImplementation{}
+	decisions := []Decision{
+		{ID: "1", Fields: map[string]any{"risk": "high"}},
+		{ID: "2", Fields: map[string]any{"components": []any{"api", "frontend"}}},
+		{ID: "3", Fields: map[string]any{"cost_center": 4711}},
+		{ID: "4"},
+	}
+
+	filtered, err := service.FilterDecisions("model", decisions, map[string][]string{"field": {"risk=high", "components=frontend", "cost_center=4711"}})
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 3)
+	assert.Equal(t, "3", filtered[2].ID)
+}
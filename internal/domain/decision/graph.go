@@ -0,0 +1,137 @@
+package decision
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// GraphEdge is a link from one decision to another, Type is the link tag such as "precedes".
+type GraphEdge struct {
+	From string
+	To   string
+	Type string
+}
+
+// Graph holds decisions and the links between them. Focus is the decision a neighbourhood was
+// built around, if any.
+type Graph struct {
+	Nodes     []Decision
+	Edges     []GraphEdge
+	Lifecycle Lifecycle
+	Focus     string
+}
+
+// BuildGraph collects the links between the given decisions. Links to decisions outside of the
+// list are left out. Each link is stored on both decisions, so the reverse entry is dropped:
+// "succeeds" when the matching "precedes" exists and, for other tags, the entry of the decision
+// with the higher ID when the other one links back.
+func BuildGraph(decisions []Decision, lifecycle Lifecycle) Graph {
+	nodes := slices.Clone(decisions)
+	sort.SliceStable(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+
+	byID := make(map[string]Decision, len(nodes))
+	for _, d := range nodes {
+		byID[d.ID] = d
+	}
+
+	var edges []GraphEdge
+	for _, d := range nodes {
+		for _, link := range sortedLinks(d.Links) {
+			target, ok := byID[link.To]
+			if !ok || link.To == d.ID {
+				continue
+			}
+			edge := GraphEdge{From: d.ID, To: link.To, Type: link.Type}
+			if isReverseEdge(edge, target, edges) {
+				continue
+			}
+			edges = append(edges, edge)
+		}
+	}
+
+	return Graph{Nodes: nodes, Edges: edges, Lifecycle: lifecycle}
+}
+
+// sortedLinks returns the links of a decision with precedes and succeeds first and custom tags in alphabetical order.
+func sortedLinks(links Links) []GraphEdge {
+	var result []GraphEdge
+	for _, id := range links.Precedes {
+		result = append(result, GraphEdge{To: id, Type: "precedes"})
+	}
+	for _, id := range links.Succeeds {
+		result = append(result, GraphEdge{To: id, Type: "succeeds"})
+	}
+
+	tags := make([]string, 0, len(links.Custom))
+	for tag := range links.Custom {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		for _, id := range links.Custom[tag] {
+			result = append(result, GraphEdge{To: id, Type: tag})
+		}
+	}
+	return result
+}
+
+func isReverseEdge(edge GraphEdge, target Decision, existing []GraphEdge) bool {
+	switch edge.Type {
+	case "precedes":
+		return false
+	case "succeeds":
+		return slices.Contains(target.Links.Precedes, edge.From)
+	}
+	return slices.ContainsFunc(existing, func(e GraphEdge) bool {
+		return e.From == edge.To && e.To == edge.From && e.Type != "precedes" && e.Type != "succeeds"
+	})
+}
+
+// Neighbourhood returns the part of the graph within the given number of links of a decision,
+// following links in both directions.
+func (g Graph) Neighbourhood(id string, depth int) (Graph, error) {
+	if !slices.ContainsFunc(g.Nodes, func(d Decision) bool { return d.ID == id }) {
+		return Graph{}, fmt.Errorf("decision %s is not part of the graph", id)
+	}
+
+	distance := map[string]int{id: 0}
+	queue := []string{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if distance[current] == depth {
+			continue
+		}
+		for _, e := range g.Edges {
+			var next string
+			switch current {
+			case e.From:
+				next = e.To
+			case e.To:
+				next = e.From
+			default:
+				continue
+			}
+			if _, seen := distance[next]; !seen {
+				distance[next] = distance[current] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	result := Graph{Lifecycle: g.Lifecycle, Focus: id}
+	for _, d := range g.Nodes {
+		if _, ok := distance[d.ID]; ok {
+			result.Nodes = append(result.Nodes, d)
+		}
+	}
+	for _, e := range g.Edges {
+		_, from := distance[e.From]
+		_, to := distance[e.To]
+		if from && to {
+			result.Edges = append(result.Edges, e)
+		}
+	}
+	return result, nil
+}
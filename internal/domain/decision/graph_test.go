@@ -0,0 +1,61 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func graphTestDecisions() []Decision {
+	return []Decision{
+		{ID: "0002", Links: Links{Succeeds: []string{"0001"}, Custom: map[string][]string{"depends on": {"0003"}}}},
+		{ID: "0001", Links: Links{Precedes: []string{"0002"}}},
+		{ID: "0003", Links: Links{Custom: map[string][]string{"required by": {"0002"}, "relates to": {"0004", "0009"}}}},
+		{ID: "0004", Links: Links{Custom: map[string][]string{"relates to": {"0003"}}}},
+		{ID: "0005"},
+	}
+}
+
+func TestBuildGraph_DropsReverseLinks(t *testing.T) {
+	graph := BuildGraph(graphTestDecisions(), DefaultLifecycle())
+
+	assert.Len(t, graph.Nodes, 5)
+	assert.Equal(t, "0001", graph.Nodes[0].ID)
+	assert.Equal(t, []GraphEdge{
+		{From: "0001", To: "0002", Type: "precedes"},
+		{From: "0002", To: "0003", Type: "depends on"},
+		{From: "0003", To: "0004", Type: "relates to"},
+	}, graph.Edges)
+}
+
+func TestBuildGraph_KeepsSucceedsWithoutPrecedes(t *testing.T) {
+	graph := BuildGraph([]Decision{
+		{ID: "0001"},
+		{ID: "0002", Links: Links{Succeeds: []string{"0001"}}},
+	}, DefaultLifecycle())
+
+	assert.Equal(t, []GraphEdge{{From: "0002", To: "0001", Type: "succeeds"}}, graph.Edges)
+}
+
+func TestGraph_Neighbourhood(t *testing.T) {
+	graph := BuildGraph(graphTestDecisions(), DefaultLifecycle())
+
+	focused, err := graph.Neighbourhood("0002", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "0002", focused.Focus)
+	assert.Len(t, focused.Nodes, 3)
+	assert.Len(t, focused.Edges, 2)
+
+	focused, err = graph.Neighbourhood("0001", 2)
+	assert.NoError(t, err)
+	assert.Len(t, focused.Nodes, 3)
+	assert.Equal(t, "0003", focused.Nodes[2].ID)
+
+	focused, err = graph.Neighbourhood("0005", 3)
+	assert.NoError(t, err)
+	assert.Len(t, focused.Nodes, 1)
+	assert.Empty(t, focused.Edges)
+
+	_, err = graph.Neighbourhood("0009", 1)
+	assert.EqualError(t, err, "decision 0009 is not part of the graph")
+}
@@ -0,0 +1,224 @@
+package decision
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ID strategies. Sequential IDs are numbered per model, e.g. 0001 or SEC-0001. Timestamp IDs are
+// derived from the creation time in milliseconds and a random suffix, e.g. 20261018080530123kqfz,
+// so they sort by creation and do not collide when decisions are added on parallel branches.
+const (
+	IDStrategySequential = "sequential"
+	IDStrategyTimestamp  = "timestamp"
+)
+
+const (
+	defaultIDWidth  = 4
+	maxIDWidth      = 12
+	timestampLayout = "20060102150405.000"
+	suffixAlphabet  = "abcdefghijklmnopqrstuvwxyz"
+	suffixLength    = 4
+)
+
+// IDPattern matches the IDs of every strategy: an optional prefix of letters followed by - or _,
+// digits and, for timestamp IDs, a suffix of lowercase letters.
+const IDPattern = `(?:[A-Za-z]+[-_]?)?\d+[a-z]*`
+
+var (
+	idPattern       = regexp.MustCompile(`^` + IDPattern + `$`)
+	idPartsPattern  = regexp.MustCompile(`^([A-Za-z]+[-_]?)?(\d+)([a-z]*)$`)
+	idPrefixPattern = regexp.MustCompile(`^(?:[A-Za-z]+[-_]?)?$`)
+	idSuffixPattern = regexp.MustCompile(`^[a-z]+$`)
+)
+
+// IDScheme configures how the IDs of new decisions are allocated. Width is the minimum number of
+// digits of sequential IDs and defaults to 4, longer numbers are not cut off.
+type IDScheme struct {
+	Strategy string `yaml:"strategy,omitempty"`
+	Prefix   string `yaml:"prefix,omitempty"`
+	Width    int    `yaml:"width,omitempty"`
+}
+
+func (s IDScheme) strategy() string {
+	if s.Strategy == "" {
+		return IDStrategySequential
+	}
+	return s.Strategy
+}
+
+func (s IDScheme) width() int {
+	if s.Width == 0 {
+		return defaultIDWidth
+	}
+	return s.Width
+}
+
+// Sequential reports whether IDs are numbered per model.
+func (s IDScheme) Sequential() bool {
+	return s.strategy() == IDStrategySequential
+}
+
+func (s IDScheme) validate() error {
+	switch s.strategy() {
+	case IDStrategySequential:
+		if s.Width < 0 || s.Width > maxIDWidth {
+			return fmt.Errorf("ID width must be between 1 and %d, got %d", maxIDWidth, s.Width)
+		}
+	case IDStrategyTimestamp:
+		if s.Width != 0 {
+			return fmt.Errorf("ID width applies to sequential IDs only")
+		}
+	default:
+		return fmt.Errorf("unknown ID strategy %q (allowed: %s, %s)", s.Strategy, IDStrategySequential, IDStrategyTimestamp)
+	}
+	if !idPrefixPattern.MatchString(s.Prefix) {
+		return fmt.Errorf("invalid ID prefix %q, prefixes consist of letters optionally followed by - or _", s.Prefix)
+	}
+	return nil
+}
+
+// Format returns the sequential ID with the given number.
+func (s IDScheme) Format(number int) string {
+	return fmt.Sprintf("%s%0*d", s.Prefix, s.width(), number)
+}
+
+// Next allocates the ID of a new decision. existing holds the IDs already in use, including
+// those of archived decisions, which are never reused.
+func (s IDScheme) Next(existing []string, now time.Time) string {
+	if s.strategy() == IDStrategyTimestamp {
+		base := s.Prefix + strings.Replace(now.UTC().Format(timestampLayout), ".", "", 1)
+		// IDs created within the same millisecond count up from the highest suffix in use, so
+		// they keep the order they were created in
+		highest := ""
+		for _, id := range existing {
+			if suffix, ok := strings.CutPrefix(id, base); ok && idSuffixPattern.MatchString(suffix) && suffix > highest {
+				highest = suffix
+			}
+		}
+		if highest != "" {
+			return base + nextSuffix(highest)
+		}
+		return base + randomSuffix()
+	}
+
+	highest := 0
+	for _, id := range existing {
+		if prefix, number, ok := splitSequentialID(id); ok && prefix == s.Prefix && number > highest {
+			highest = number
+		}
+	}
+	return s.Format(highest + 1)
+}
+
+// nextSuffix returns the suffix following the given one in alphabetical order, e.g. abcz is
+// followed by abda and zzzz by zzzza.
+func nextSuffix(suffix string) string {
+	b := []byte(suffix)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] != suffixAlphabet[len(suffixAlphabet)-1] {
+			b[i]++
+			return string(b)
+		}
+		b[i] = suffixAlphabet[0]
+	}
+	return suffix + suffixAlphabet[:1]
+}
+
+func randomSuffix() string {
+	var sb strings.Builder
+	for range suffixLength {
+		sb.WriteByte(suffixAlphabet[rand.IntN(len(suffixAlphabet))])
+	}
+	return sb.String()
+}
+
+// IsDecisionID reports whether the value has the form of a decision ID of any strategy.
+func IsDecisionID(value string) bool {
+	return idPattern.MatchString(value)
+}
+
+// IDNumber returns the number of a sequential ID without its prefix. Timestamp IDs have none.
+func IDNumber(id string) (int, bool) {
+	_, number, ok := splitSequentialID(id)
+	return number, ok
+}
+
+func splitSequentialID(id string) (prefix string, number int, ok bool) {
+	match := idPartsPattern.FindStringSubmatch(id)
+	if match == nil || match[3] != "" {
+		return "", 0, false
+	}
+	number, err := strconv.Atoi(match[2])
+	if err != nil {
+		return "", 0, false
+	}
+	return match[1], number, true
+}
+
+// CompareIDs orders IDs by prefix and then by number, so 10000 follows 9999. IDs of other forms
+// are compared as text.
+func CompareIDs(a, b string) int {
+	pa, pb := idPartsPattern.FindStringSubmatch(a), idPartsPattern.FindStringSubmatch(b)
+	if pa == nil || pb == nil {
+		return strings.Compare(a, b)
+	}
+	if c := strings.Compare(pa[1], pb[1]); c != 0 {
+		return c
+	}
+	da, db := strings.TrimLeft(pa[2], "0"), strings.TrimLeft(pb[2], "0")
+	if len(da) != len(db) {
+		return len(da) - len(db)
+	}
+	if c := strings.Compare(da, db); c != 0 {
+		return c
+	}
+	return strings.Compare(pa[3], pb[3])
+}
+
+// shiftID adds delta to the number of a sequential ID and formats it with the scheme. Other IDs
+// are returned unchanged.
+func (s IDScheme) shiftID(id string, delta int) string {
+	if number, ok := IDNumber(id); ok {
+		return s.Format(number + delta)
+	}
+	return id
+}
+
+// idRange is an inclusive range of IDs such as 0003-0007 or SEC-0003-SEC-0007.
+type idRange struct {
+	from, to string
+}
+
+func (r idRange) contains(id string) bool {
+	return CompareIDs(r.from, id) <= 0 && CompareIDs(id, r.to) <= 0
+}
+
+// parseIDRange splits a range at the dash that separates two IDs. The end may leave out the
+// prefix of the start, e.g. SEC-0003-0007.
+func parseIDRange(value string) (idRange, error) {
+	for i, c := range value {
+		if c != '-' {
+			continue
+		}
+		from, to := value[:i], value[i+1:]
+		if !IsDecisionID(from) || !IsDecisionID(to) {
+			continue
+		}
+		fromParts, toParts := idPartsPattern.FindStringSubmatch(from), idPartsPattern.FindStringSubmatch(to)
+		if toParts[1] == "" {
+			to = fromParts[1] + to
+		} else if toParts[1] != fromParts[1] {
+			continue
+		}
+		if CompareIDs(from, to) > 0 {
+			break
+		}
+		return idRange{from: from, to: to}, nil
+	}
+	return idRange{}, fmt.Errorf("invalid ID range: %s", value)
+}
@@ -0,0 +1,83 @@
+package decision
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDScheme_NextSequential(t *testing.T) {
+	assert.Equal(t, "0001", IDScheme{}.Next(nil, time.Now()))
+	assert.Equal(t, "0004", IDScheme{}.Next([]string{"0001", "0003", "SEC-0007"}, time.Now()))
+	assert.Equal(t, "10000", IDScheme{}.Next([]string{"9999"}, time.Now()))
+	assert.Equal(t, "SEC-008", IDScheme{Prefix: "SEC-", Width: 3}.Next([]string{"0009", "SEC-0007"}, time.Now()))
+}
+
+func TestIDScheme_NextTimestamp(t *testing.T) {
+	scheme := IDScheme{Strategy: IDStrategyTimestamp, Prefix: "ADR-"}
+	now := time.Date(2026, 10, 18, 8, 5, 30, 123456789, time.UTC)
+
+	id := scheme.Next(nil, now)
+
+	assert.Regexp(t, regexp.MustCompile(`^ADR-20261018080530123[a-z]{4}$`), id)
+	assert.True(t, IsDecisionID(id))
+	_, sequential := IDNumber(id)
+	assert.False(t, sequential)
+
+	later := scheme.Next([]string{id}, now.Add(time.Millisecond))
+	assert.Negative(t, CompareIDs(id, later))
+
+	ids := []string{id}
+	for range 30 {
+		ids = append(ids, scheme.Next(ids, now))
+	}
+	assert.True(t, slices.IsSortedFunc(ids, CompareIDs), "IDs of the same millisecond must keep their creation order: %v", ids)
+}
+
+func TestNextSuffix(t *testing.T) {
+	assert.Equal(t, "abcf", nextSuffix("abce"))
+	assert.Equal(t, "abda", nextSuffix("abcz"))
+	assert.Equal(t, "zzzza", nextSuffix("zzzz"))
+}
+
+func TestIDScheme_Validate(t *testing.T) {
+	assert.NoError(t, IDScheme{}.validate())
+	assert.NoError(t, IDScheme{Prefix: "SEC-", Width: 6}.validate())
+	assert.NoError(t, IDScheme{Strategy: IDStrategyTimestamp, Prefix: "ADR_"}.validate())
+
+	assert.ErrorContains(t, IDScheme{Strategy: "uuid"}.validate(), `unknown ID strategy "uuid"`)
+	assert.ErrorContains(t, IDScheme{Width: 13}.validate(), "ID width must be between 1 and 12")
+	assert.ErrorContains(t, IDScheme{Strategy: IDStrategyTimestamp, Width: 4}.validate(), "sequential IDs only")
+	assert.ErrorContains(t, IDScheme{Prefix: "SEC:"}.validate(), `invalid ID prefix "SEC:"`)
+	assert.ErrorContains(t, IDScheme{Prefix: "2025-"}.validate(), `invalid ID prefix "2025-"`)
+}
+
+func TestIsDecisionID(t *testing.T) {
+	for _, id := range []string{"0001", "12345", "SEC-0001", "ADR_7", "20261018080530kqfz"} {
+		assert.True(t, IsDecisionID(id), id)
+	}
+	for _, value := range []string{"my-decision", "0001-0003", "SEC-", "Use Kafka", "platform:0012"} {
+		assert.False(t, IsDecisionID(value), value)
+	}
+}
+
+func TestCompareIDs(t *testing.T) {
+	ids := []string{"10000", "SEC-0002", "0002", "9999", "SEC-0010", "20261018080530zzzz", "20261018080530aaaa"}
+	slices.SortFunc(ids, CompareIDs)
+
+	assert.Equal(t, []string{"0002", "9999", "10000", "20261018080530aaaa", "20261018080530zzzz", "SEC-0002", "SEC-0010"}, ids)
+}
+
+func TestParseIDRange(t *testing.T) {
+	r, err := parseIDRange("SEC-0003-0007")
+	assert.NoError(t, err)
+	assert.Equal(t, idRange{from: "SEC-0003", to: "SEC-0007"}, r)
+
+	_, err = parseIDRange("0007-0003")
+	assert.ErrorContains(t, err, "invalid ID range")
+	_, err = parseIDRange("SEC-0003-ADR-0007")
+	assert.ErrorContains(t, err, "invalid ID range")
+}
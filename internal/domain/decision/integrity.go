@@ -0,0 +1,183 @@
+package decision
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// LinkProblem is a link of decision ID that is inconsistent. Repair is set if the problem can be
+// resolved by adding the missing reverse entry.
+type LinkProblem struct {
+	ID      string
+	Problem string
+	Repair  *LinkRepair
+}
+
+// LinkRepair is a link entry that has to be added to decision ID.
+type LinkRepair struct {
+	ID     string
+	Tag    string
+	Target string
+}
+
+// CheckLinks reports links to missing decisions, links of a decision to itself, links without
+// their reverse entry, links exceeding the cardinality of their link type and cycles of acyclic
+// link types such as precedes. Links to decisions of other models are checked by CheckReferences.
+// The problems are ordered by decision ID.
+func (s *ModelSettings) CheckLinks(decisions []Decision) []LinkProblem {
+	sorted := slices.Clone(decisions)
+	sort.SliceStable(sorted, func(i, j int) bool { return CompareIDs(sorted[i].ID, sorted[j].ID) < 0 })
+
+	byID := make(map[string]Decision, len(sorted))
+	for _, d := range sorted {
+		byID[d.ID] = d
+	}
+
+	var problems []LinkProblem
+	add := func(id string, repair *LinkRepair, format string, args ...any) {
+		problems = append(problems, LinkProblem{ID: id, Problem: fmt.Sprintf(format, args...), Repair: repair})
+	}
+
+	for _, d := range sorted {
+		for _, link := range sortedLinks(d.Links) {
+			other, exists := byID[link.To]
+			switch {
+			case IsReference(link.To):
+				continue
+			case link.To == d.ID:
+				add(d.ID, nil, "a %q link to itself", link.Type)
+			case !exists:
+				add(d.ID, nil, "a %q link to %s, which does not exist", link.Type, link.To)
+			case !s.isLinkType(link.Type):
+				// the reverse tag of an undeclared tag is unknown, any link back will do
+				if !slices.ContainsFunc(sortedLinks(other.Links), func(e GraphEdge) bool { return e.To == d.ID }) {
+					add(d.ID, nil, "a %q link to %s without a link back", link.Type, link.To)
+				}
+			default:
+				back := s.InverseLinkTag(link.Type)
+				if !slices.Contains(linkTargets(other.Links, back), d.ID) {
+					add(d.ID, &LinkRepair{ID: other.ID, Tag: back, Target: d.ID}, "a %q link to %s without the %q link back", link.Type, link.To, back)
+				}
+			}
+		}
+	}
+
+	types := s.linkTypes()
+	types["precedes"] = precedesLinkType
+	for _, name := range sortedLinkTypeNames(types) {
+		t := types[name]
+		inverse := t.inverseOf(name)
+
+		for _, d := range sorted {
+			if targets := linkTargets(d.Links, name); t.oneTarget() && len(targets) > 1 {
+				add(d.ID, nil, "%d %q links but the link type allows only one", len(targets), name)
+			}
+			if sources := linkTargets(d.Links, inverse); t.oneSource() && inverse != name && len(sources) > 1 {
+				add(d.ID, nil, "%d %q links but the link type allows only one", len(sources), inverse)
+			}
+		}
+
+		if !t.Acyclic {
+			continue
+		}
+		edges := make(map[string][]string)
+		for _, d := range sorted {
+			// self-links are reported above
+			for _, id := range otherIDs(linkTargets(d.Links, name), d.ID) {
+				edges[d.ID] = appendUnique(edges[d.ID], id)
+			}
+			for _, id := range otherIDs(linkTargets(d.Links, inverse), d.ID) {
+				edges[id] = appendUnique(edges[id], d.ID)
+			}
+		}
+		for _, d := range sorted {
+			if cycle := findCycle(d.ID, edges); cycle != nil {
+				add(d.ID, nil, "%q links forming a cycle: %s", name, strings.Join(cycle, " -> "))
+			}
+		}
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool { return CompareIDs(problems[i].ID, problems[j].ID) < 0 })
+	return problems
+}
+
+// CheckReferences reports links to decisions of other models whose model alias is not declared
+// or whose target does not exist. exists reports whether a model contains a decision.
+func (s *ModelSettings) CheckReferences(modelPath string, decisions []Decision, exists func(modelPath, id string) bool) []LinkProblem {
+	sorted := slices.Clone(decisions)
+	sort.SliceStable(sorted, func(i, j int) bool { return CompareIDs(sorted[i].ID, sorted[j].ID) < 0 })
+
+	var problems []LinkProblem
+	for _, d := range sorted {
+		for _, link := range sortedLinks(d.Links) {
+			alias, id, ok := ParseReference(link.To)
+			if !ok {
+				continue
+			}
+			path, err := s.ModelPath(modelPath, alias)
+			switch {
+			case err != nil:
+				problems = append(problems, LinkProblem{ID: d.ID, Problem: fmt.Sprintf("a %q link to %s of the undeclared model alias %q", link.Type, link.To, alias)})
+			case !exists(path, id):
+				problems = append(problems, LinkProblem{ID: d.ID, Problem: fmt.Sprintf("a %q link to %s, which does not exist in %s", link.Type, link.To, path)})
+			}
+		}
+	}
+	return problems
+}
+
+// Apply adds the missing link entry to the decision and reports whether it changed.
+func (r LinkRepair) Apply(d *Decision) bool {
+	switch r.Tag {
+	case "precedes":
+		if slices.Contains(d.Links.Precedes, r.Target) {
+			return false
+		}
+		d.Links.Precedes = append(d.Links.Precedes, r.Target)
+	case "succeeds":
+		if slices.Contains(d.Links.Succeeds, r.Target) {
+			return false
+		}
+		d.Links.Succeeds = append(d.Links.Succeeds, r.Target)
+	default:
+		if slices.Contains(d.Links.Custom[r.Tag], r.Target) {
+			return false
+		}
+		if d.Links.Custom == nil {
+			d.Links.Custom = make(map[string][]string)
+		}
+		d.Links.Custom[r.Tag] = append(d.Links.Custom[r.Tag], r.Target)
+	}
+	return true
+}
+
+// findCycle returns a path of edges leading from id back to itself, or nil if there is none.
+func findCycle(id string, edges map[string][]string) []string {
+	visited := make(map[string]bool)
+	var walk func(current string, path []string) []string
+	walk = func(current string, path []string) []string {
+		for _, next := range edges[current] {
+			if next == id {
+				return append(path, next)
+			}
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			if cycle := walk(next, append(path, next)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return walk(id, []string{id})
+}
+
+func appendUnique(values []string, value string) []string {
+	if slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
@@ -0,0 +1,80 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func problemTexts(problems []LinkProblem) []string {
+	var texts []string
+	for _, p := range problems {
+		texts = append(texts, p.ID+": "+p.Problem)
+	}
+	return texts
+}
+
+func TestCheckLinks_Consistent(t *testing.T) {
+	decisions := []Decision{
+		{ID: "0001", Links: Links{Precedes: []string{"0002"}, Custom: map[string][]string{"superseded by": {"0003"}}}},
+		{ID: "0002", Links: Links{Succeeds: []string{"0001"}}},
+		{ID: "0003", Links: Links{Custom: map[string][]string{"supersedes": {"0001"}}}},
+	}
+
+	assert.Empty(t, DefaultModelSettings().CheckLinks(decisions))
+}
+
+func TestCheckLinks_ReportsProblems(t *testing.T) {
+	decisions := []Decision{
+		{ID: "0002", Links: Links{Succeeds: []string{"0001"}, Precedes: []string{"0003"}}},
+		{ID: "0001", Links: Links{Precedes: []string{"0001", "0099"}, Custom: map[string][]string{"relates to": {"0003"}}}},
+		{ID: "0003", Links: Links{Succeeds: []string{"0002"}, Precedes: []string{"0002"}}},
+	}
+
+	problems := DefaultModelSettings().CheckLinks(decisions)
+
+	assert.Equal(t, []string{
+		`0001: a "precedes" link to itself`,
+		`0001: a "precedes" link to 0099, which does not exist`,
+		`0001: a "relates to" link to 0003 without a link back`,
+		`0002: a "succeeds" link to 0001 without the "precedes" link back`,
+		`0002: "precedes" links forming a cycle: 0002 -> 0003 -> 0002`,
+		`0003: a "precedes" link to 0002 without the "succeeds" link back`,
+		`0003: "precedes" links forming a cycle: 0003 -> 0002 -> 0003`,
+	}, problemTexts(problems))
+
+	assert.Nil(t, problems[0].Repair)
+	assert.Nil(t, problems[2].Repair)
+	assert.Equal(t, &LinkRepair{ID: "0001", Tag: "precedes", Target: "0002"}, problems[3].Repair)
+}
+
+func TestCheckLinks_UndeclaredTagPair(t *testing.T) {
+	decisions := []Decision{
+		{ID: "0001", Links: Links{Custom: map[string][]string{"invalidated by": {"0002"}}}},
+		{ID: "0002", Links: Links{Custom: map[string][]string{"invalidates": {"0001"}}}},
+	}
+
+	assert.Empty(t, DefaultModelSettings().CheckLinks(decisions))
+}
+
+func TestCheckLinks_Cardinality(t *testing.T) {
+	settings := linkTypeTestSettings()
+	decisions := []Decision{
+		{ID: "0002", Links: Links{Custom: map[string][]string{"replaced by": {"0003", "0004"}}}},
+		{ID: "0003", Links: Links{Custom: map[string][]string{"replaces": {"0002"}}}},
+		{ID: "0004", Links: Links{Custom: map[string][]string{"replaces": {"0002"}}}},
+	}
+
+	assert.Equal(t, []string{`0002: 2 "replaced by" links but the link type allows only one`}, problemTexts(settings.CheckLinks(decisions)))
+}
+
+func TestLinkRepair_Apply(t *testing.T) {
+	d := &Decision{ID: "0002"}
+
+	assert.True(t, LinkRepair{ID: "0002", Tag: "succeeds", Target: "0001"}.Apply(d))
+	assert.True(t, LinkRepair{ID: "0002", Tag: "required by", Target: "0003"}.Apply(d))
+	assert.False(t, LinkRepair{ID: "0002", Tag: "succeeds", Target: "0001"}.Apply(d))
+
+	assert.Equal(t, []string{"0001"}, d.Links.Succeeds)
+	assert.Equal(t, []string{"0003"}, d.Links.Custom["required by"])
+}
@@ -0,0 +1,161 @@
+package decision
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Lifecycle describes the statuses a decision can have and which status changes are allowed.
+// Archived is the status given to archived decisions, it is reachable from every status.
+// Reopening is the other exception to the transitions: it moves a decided decision back to the
+// initial status together with its outcome, whether or not that transition is declared.
+type Lifecycle struct {
+	Initial     string              `yaml:"initial"`
+	Decided     string              `yaml:"decided"`
+	Superseded  string              `yaml:"superseded"`
+	Archived    string              `yaml:"archived"`
+	States      []string            `yaml:"states"`
+	Transitions map[string][]string `yaml:"transitions"`
+}
+
+func DefaultLifecycle() Lifecycle {
+	return Lifecycle{
+		Initial:    "open",
+		Decided:    "decided",
+		Superseded: "superseded",
+		Archived:   "archived",
+		States:     []string{"open", "decided", "rejected", "deprecated", "superseded", "archived"},
+		Transitions: map[string][]string{
+			"open":       {"decided", "rejected"},
+			"decided":    {"deprecated", "superseded"},
+			"deprecated": {"superseded"},
+		},
+	}
+}
+
+// HasState reports whether the given status is part of the lifecycle.
+func (l Lifecycle) HasState(status string) bool {
+	return slices.Contains(l.States, status)
+}
+
+// CanTransition reports whether a decision may move from one status to another.
+// An empty status is treated as the initial status of the lifecycle.
+func (l Lifecycle) CanTransition(from, to string) bool {
+	if from == "" {
+		from = l.Initial
+	}
+	return slices.Contains(l.Transitions[from], to)
+}
+
+// checkTransition explains why a decision cannot move from one status to another, if it cannot.
+func (l Lifecycle) checkTransition(from, to string) error {
+	if !l.HasState(to) {
+		return fmt.Errorf("unknown status %q, allowed statuses are: %s", to, l.describeStates())
+	}
+	if from == to {
+		return fmt.Errorf("decision already has status %q", to)
+	}
+	if !l.CanTransition(from, to) {
+		allowed := l.AllowedTransitions(from)
+		if len(allowed) == 0 {
+			return fmt.Errorf("cannot change status from %q, it is a final status", from)
+		}
+		return fmt.Errorf("cannot change status from %q to %q (allowed: %s)", from, to, strings.Join(allowed, ", "))
+	}
+	return nil
+}
+
+// AllowedTransitions returns the statuses reachable from the given status.
+func (l Lifecycle) AllowedTransitions(from string) []string {
+	if from == "" {
+		from = l.Initial
+	}
+	return l.Transitions[from]
+}
+
+// IsPending reports whether a decision with the given status still awaits a decision, i.e. the
+// decided status can be reached from it. An empty status is treated as the initial status.
+func (l Lifecycle) IsPending(status string) bool {
+	if status == "" {
+		status = l.Initial
+	}
+	if status == l.Decided {
+		return false
+	}
+
+	visited := map[string]bool{status: true}
+	queue := []string{status}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range l.Transitions[current] {
+			if next == l.Decided {
+				return true
+			}
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
+
+// Validate checks that every status referenced by the lifecycle is declared in its states.
+func (l Lifecycle) Validate() error {
+	if len(l.States) == 0 {
+		return fmt.Errorf("lifecycle must declare at least one state")
+	}
+	if !l.HasState(l.Initial) {
+		return fmt.Errorf("initial status %q is not a declared state", l.Initial)
+	}
+	if !l.HasState(l.Decided) {
+		return fmt.Errorf("decided status %q is not a declared state", l.Decided)
+	}
+	if !l.HasState(l.Superseded) {
+		return fmt.Errorf("superseded status %q is not a declared state", l.Superseded)
+	}
+	if !l.HasState(l.Archived) {
+		return fmt.Errorf("archived status %q is not a declared state", l.Archived)
+	}
+	for from, targets := range l.Transitions {
+		if !l.HasState(from) {
+			return fmt.Errorf("transition source %q is not a declared state", from)
+		}
+		for _, to := range targets {
+			if !l.HasState(to) {
+				return fmt.Errorf("transition target %q (from %q) is not a declared state", to, from)
+			}
+		}
+	}
+	return nil
+}
+
+func (l Lifecycle) describeStates() string {
+	return strings.Join(l.States, ", ")
+}
+
+// applyDefaults fills the fields that were left empty in a model's settings file.
+// If no states are declared the default lifecycle is used as a whole.
+func (l *Lifecycle) applyDefaults() {
+	if len(l.States) == 0 {
+		*l = DefaultLifecycle()
+		return
+	}
+	if l.Initial == "" {
+		l.Initial = l.States[0]
+	}
+	if l.Decided == "" {
+		l.Decided = DefaultLifecycle().Decided
+	}
+	if l.Superseded == "" {
+		l.Superseded = DefaultLifecycle().Superseded
+	}
+	if l.Archived == "" {
+		l.Archived = DefaultLifecycle().Archived
+	}
+	if l.Transitions == nil {
+		l.Transitions = make(map[string][]string)
+	}
+}
@@ -0,0 +1,95 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLifecycle_CanTransition(t *testing.T) {
+	lifecycle := DefaultLifecycle()
+
+	assert.True(t, lifecycle.CanTransition("open", "decided"))
+	assert.True(t, lifecycle.CanTransition("decided", "deprecated"))
+	assert.False(t, lifecycle.CanTransition("open", "superseded"))
+	assert.False(t, lifecycle.CanTransition("superseded", "open"))
+}
+
+func TestLifecycle_CanTransition_EmptyStatusIsInitial(t *testing.T) {
+	lifecycle := DefaultLifecycle()
+
+	assert.True(t, lifecycle.CanTransition("", "decided"))
+	assert.Equal(t, []string{"decided", "rejected"}, lifecycle.AllowedTransitions(""))
+}
+
+func TestLifecycle_Validate_Default(t *testing.T) {
+	assert.NoError(t, DefaultLifecycle().Validate())
+}
+
+func TestLifecycle_Validate_UndeclaredTransitionTarget(t *testing.T) {
+	lifecycle := Lifecycle{
+		Initial:     "proposed",
+		Decided:     "accepted",
+		Superseded:  "replaced",
+		Archived:    "retired",
+		States:      []string{"proposed", "accepted", "replaced", "retired"},
+		Transitions: map[string][]string{"proposed": {"accepted", "rejected"}},
+	}
+
+	err := lifecycle.Validate()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `transition target "rejected"`)
+}
+
+func TestLifecycle_Validate_UndeclaredInitial(t *testing.T) {
+	lifecycle := Lifecycle{Initial: "draft", Decided: "accepted", States: []string{"accepted"}}
+
+	err := lifecycle.Validate()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `initial status "draft"`)
+}
+
+func TestLifecycle_Validate_UndeclaredSupersededAndArchived(t *testing.T) {
+	lifecycle := Lifecycle{
+		Initial: "proposed",
+		Decided: "accepted",
+		States:  []string{"proposed", "accepted", "archived"},
+	}
+	lifecycle.applyDefaults()
+
+	assert.EqualError(t, lifecycle.Validate(), `superseded status "superseded" is not a declared state`)
+
+	lifecycle.States = []string{"proposed", "accepted", "superseded"}
+	assert.EqualError(t, lifecycle.Validate(), `archived status "archived" is not a declared state`)
+}
+
+func TestLifecycle_ApplyDefaults_Empty(t *testing.T) {
+	lifecycle := Lifecycle{}
+	lifecycle.applyDefaults()
+
+	assert.Equal(t, DefaultLifecycle(), lifecycle)
+}
+
+func TestLifecycle_ApplyDefaults_CustomStates(t *testing.T) {
+	lifecycle := Lifecycle{
+		Decided: "accepted",
+		States:  []string{"proposed", "accepted"},
+	}
+	lifecycle.applyDefaults()
+
+	assert.Equal(t, "proposed", lifecycle.Initial)
+	assert.Equal(t, "accepted", lifecycle.Decided)
+	assert.NotNil(t, lifecycle.Transitions)
+}
+
+func TestLifecycle_IsPending(t *testing.T) {
+	lifecycle := DefaultLifecycle()
+
+	assert.True(t, lifecycle.IsPending(""))
+	assert.True(t, lifecycle.IsPending("open"))
+	assert.False(t, lifecycle.IsPending("decided"))
+	assert.False(t, lifecycle.IsPending("rejected"))
+	assert.False(t, lifecycle.IsPending("superseded"))
+}
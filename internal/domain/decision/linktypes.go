@@ -0,0 +1,249 @@
+package decision
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Cardinalities of a link type, given as <sources per target>-to-<targets per source>.
+// "one-to-many" e.g. allows a decision to link to many others, each of which is linked from at most one.
+const (
+	CardinalityOneToOne   = "one-to-one"
+	CardinalityOneToMany  = "one-to-many"
+	CardinalityManyToOne  = "many-to-one"
+	CardinalityManyToMany = "many-to-many"
+)
+
+var cardinalities = []string{CardinalityOneToOne, CardinalityOneToMany, CardinalityManyToOne, CardinalityManyToMany}
+
+// LinkType declares a kind of custom link in the model settings. Inverse is the tag stored on
+// the target decision, symmetric link types are their own inverse. Acyclic forbids cycles of
+// links of the type. Cardinality defaults to many-to-many.
+type LinkType struct {
+	Inverse     string `yaml:"inverse,omitempty"`
+	Symmetric   bool   `yaml:"symmetric,omitempty"`
+	Acyclic     bool   `yaml:"acyclic,omitempty"`
+	Cardinality string `yaml:"cardinality,omitempty"`
+}
+
+// precedesLinkType describes the built-in precedes/succeeds links.
+var precedesLinkType = LinkType{Inverse: "succeeds", Acyclic: true}
+
+// defaultLinkTypes are the custom links created by supersede and revise. They can be redeclared
+// in the model settings.
+var defaultLinkTypes = map[string]LinkType{
+	"superseded by": {Inverse: "supersedes"},
+	"revised by":    {Inverse: "revises"},
+}
+
+// linkTypes returns the custom link types of the model including the defaults.
+func (s *ModelSettings) linkTypes() map[string]LinkType {
+	types := make(map[string]LinkType, len(defaultLinkTypes)+len(s.LinkTypes))
+	for name, t := range defaultLinkTypes {
+		if _, redeclared := s.LinkTypes[t.Inverse]; !redeclared {
+			types[name] = t
+		}
+	}
+	for name, t := range s.LinkTypes {
+		types[name] = t
+	}
+	return types
+}
+
+func (t LinkType) inverseOf(name string) string {
+	if t.Symmetric || t.Inverse == "" {
+		return name
+	}
+	return t.Inverse
+}
+
+// oneSource reports whether a decision may be linked from at most one decision.
+func (t LinkType) oneSource() bool {
+	return strings.HasPrefix(t.Cardinality, "one-to-")
+}
+
+// oneTarget reports whether a decision may link to at most one decision.
+func (t LinkType) oneTarget() bool {
+	return strings.HasSuffix(t.Cardinality, "-to-one")
+}
+
+func validateLinkTypes(types map[string]LinkType) error {
+	inverses := make(map[string]string)
+	for _, name := range sortedLinkTypeNames(types) {
+		t := types[name]
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("link types must have a name")
+		}
+		if isBuiltinLinkTag(name) || isBuiltinLinkTag(t.Inverse) {
+			return fmt.Errorf("link type %q cannot use the built-in precedes/succeeds tags", name)
+		}
+		if t.Symmetric && t.Inverse != "" && t.Inverse != name {
+			return fmt.Errorf("link type %q is symmetric and cannot declare the inverse %q", name, t.Inverse)
+		}
+		if !t.Symmetric && t.Inverse == "" {
+			return fmt.Errorf("link type %q must declare an inverse or be symmetric", name)
+		}
+		if t.Symmetric && t.Acyclic {
+			return fmt.Errorf("link type %q is symmetric and cannot forbid cycles", name)
+		}
+		if t.Cardinality != "" && !slices.Contains(cardinalities, t.Cardinality) {
+			return fmt.Errorf("link type %q has unknown cardinality %q (allowed: %s)", name, t.Cardinality, strings.Join(cardinalities, ", "))
+		}
+		if t.Symmetric && t.oneSource() != t.oneTarget() {
+			return fmt.Errorf("link type %q is symmetric and needs the same cardinality on both sides", name)
+		}
+
+		inverse := t.inverseOf(name)
+		if inverse == name {
+			continue
+		}
+		if _, ok := types[inverse]; ok {
+			return fmt.Errorf("inverse %q of link type %q is already declared as a link type", inverse, name)
+		}
+		if other, ok := inverses[inverse]; ok {
+			return fmt.Errorf("inverse %q of link type %q is already the inverse of link type %q", inverse, name, other)
+		}
+		inverses[inverse] = name
+	}
+	return nil
+}
+
+func sortedLinkTypeNames(types map[string]LinkType) []string {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func isBuiltinLinkTag(tag string) bool {
+	return strings.EqualFold(tag, "precedes") || strings.EqualFold(tag, "succeeds")
+}
+
+// lookupLinkType finds the link type a tag belongs to, either by its name or by its inverse.
+// The built-in precedes/succeeds links are included. forward is false for inverse tags.
+func (s *ModelSettings) lookupLinkType(tag string) (name string, t LinkType, forward bool, ok bool) {
+	if tag == "precedes" {
+		return tag, precedesLinkType, true, true
+	}
+	if tag == precedesLinkType.Inverse {
+		return "precedes", precedesLinkType, false, true
+	}
+	types := s.linkTypes()
+	if t, ok := types[tag]; ok {
+		return tag, t, true, true
+	}
+	for _, name := range sortedLinkTypeNames(types) {
+		if t := types[name]; t.inverseOf(name) == tag {
+			return name, t, false, true
+		}
+	}
+	return "", LinkType{}, false, false
+}
+
+// isLinkType reports whether a tag is precedes, succeeds or a tag of a declared or built-in link
+// type, including their inverses.
+func (s *ModelSettings) isLinkType(tag string) bool {
+	_, _, _, ok := s.lookupLinkType(tag)
+	return ok
+}
+
+// checkExternalLinkType applies the cardinality of a declared link type to a link into another
+// model. Links there are not updated, so only the links of the source decision are checked.
+func (s *ModelSettings) checkExternalLinkType(source *Decision, tag, reference string) error {
+	name, t, forward, ok := s.lookupLinkType(tag)
+	if !ok {
+		return nil
+	}
+
+	others := otherIDs(linkTargets(source.Links, tag), reference)
+	switch {
+	case len(others) == 0:
+	case forward && t.oneTarget():
+		return fmt.Errorf("link type %q allows only one link per decision, %s already links to %s", name, source.ID, strings.Join(others, ", "))
+	case !forward && t.oneSource():
+		return fmt.Errorf("link type %q allows only one link to a decision, %s is already linked from %s", name, source.ID, strings.Join(others, ", "))
+	}
+	return nil
+}
+
+// checkQueryLinkTypes fails for link types of a query that are neither declared nor used by any
+// of the decisions, which usually is a typo.
+func (s *ModelSettings) checkQueryLinkTypes(query *Query, decisions []Decision) error {
+	for _, tag := range query.LinkTypes() {
+		used := slices.ContainsFunc(decisions, func(d Decision) bool { return len(linkTargets(d.Links, tag)) > 0 })
+		if !used && !s.isLinkType(tag) {
+			return fmt.Errorf("unknown link type %q in query %q", tag, query.expr)
+		}
+	}
+	return nil
+}
+
+// InverseLinkTag returns the tag stored on the target of a link. Tags that are not declared as
+// a link type are their own inverse.
+func (s *ModelSettings) InverseLinkTag(tag string) string {
+	name, t, forward, ok := s.lookupLinkType(tag)
+	switch {
+	case !ok:
+		return tag
+	case forward:
+		return t.inverseOf(name)
+	default:
+		return name
+	}
+}
+
+// linkTargets returns the IDs a decision links to with the given tag.
+func linkTargets(links Links, tag string) []string {
+	switch tag {
+	case "precedes":
+		return links.Precedes
+	case "succeeds":
+		return links.Succeeds
+	default:
+		return links.Custom[tag]
+	}
+}
+
+// checkLinkType verifies that a link from source to target with the given tags conforms to its
+// link type. reaches reports whether one decision can be reached from another via links of a tag.
+func (s *ModelSettings) checkLinkType(source, target *Decision, tag, reverseTag string, reaches func(fromID, toID, tag string) bool) error {
+	name, t, forward, ok := s.lookupLinkType(tag)
+	if !ok {
+		return nil
+	}
+
+	inverse := t.inverseOf(name)
+	if expected := s.InverseLinkTag(tag); reverseTag != "" && reverseTag != expected {
+		return fmt.Errorf("link type %q has the inverse %q, cannot use the reverse tag %q", tag, expected, reverseTag)
+	}
+
+	// from --name--> to is the direction the link type is declared in
+	from, to := source, target
+	if !forward {
+		from, to = target, source
+	}
+
+	if t.oneTarget() {
+		if others := otherIDs(linkTargets(from.Links, name), to.ID); len(others) > 0 {
+			return fmt.Errorf("link type %q allows only one link per decision, %s already links to %s", name, from.ID, strings.Join(others, ", "))
+		}
+	}
+	if t.oneSource() {
+		if others := otherIDs(linkTargets(to.Links, inverse), from.ID); len(others) > 0 {
+			return fmt.Errorf("link type %q allows only one link to a decision, %s is already linked from %s", name, to.ID, strings.Join(others, ", "))
+		}
+	}
+
+	if t.Acyclic && reaches(to.ID, from.ID, name) {
+		return fmt.Errorf("linking %s -> %s would create a cycle", source.ID, target.ID)
+	}
+	return nil
+}
+
+func otherIDs(ids []string, id string) []string {
+	return slices.DeleteFunc(slices.Clone(ids), func(other string) bool { return other == id })
+}
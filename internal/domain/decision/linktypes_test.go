@@ -0,0 +1,93 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func linkTypeTestSettings() *ModelSettings {
+	settings := DefaultModelSettings()
+	settings.LinkTypes = map[string]LinkType{
+		"depends on": {Inverse: "required by", Acyclic: true},
+		"relates to": {Symmetric: true},
+		"replaces":   {Inverse: "replaced by", Cardinality: CardinalityOneToOne},
+	}
+	return settings
+}
+
+func TestValidateLinkTypes(t *testing.T) {
+	assert.NoError(t, validateLinkTypes(linkTypeTestSettings().LinkTypes))
+
+	tests := map[string]struct {
+		types    map[string]LinkType
+		expected string
+	}{
+		"builtin":          {map[string]LinkType{"precedes": {Inverse: "after"}}, `link type "precedes" cannot use the built-in precedes/succeeds tags`},
+		"no inverse":       {map[string]LinkType{"blocks": {}}, `link type "blocks" must declare an inverse or be symmetric`},
+		"symmetric cycles": {map[string]LinkType{"relates to": {Symmetric: true, Acyclic: true}}, `link type "relates to" is symmetric and cannot forbid cycles`},
+		"cardinality":      {map[string]LinkType{"blocks": {Inverse: "blocked by", Cardinality: "few"}}, `link type "blocks" has unknown cardinality "few" (allowed: one-to-one, one-to-many, many-to-one, many-to-many)`},
+		"inverse is type":  {map[string]LinkType{"blocks": {Inverse: "uses"}, "uses": {Inverse: "used by"}}, `inverse "uses" of link type "blocks" is already declared as a link type`},
+		"shared inverse":   {map[string]LinkType{"blocks": {Inverse: "after"}, "needs": {Inverse: "after"}}, `inverse "after" of link type "needs" is already the inverse of link type "blocks"`},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.EqualError(t, validateLinkTypes(tt.types), tt.expected)
+		})
+	}
+}
+
+func TestInverseLinkTag(t *testing.T) {
+	settings := linkTypeTestSettings()
+
+	assert.Equal(t, "required by", settings.InverseLinkTag("depends on"))
+	assert.Equal(t, "depends on", settings.InverseLinkTag("required by"))
+	assert.Equal(t, "relates to", settings.InverseLinkTag("relates to"))
+	assert.Equal(t, "succeeds", settings.InverseLinkTag("precedes"))
+	assert.Equal(t, "inspired by", settings.InverseLinkTag("inspired by"))
+}
+
+func TestCheckLinkType(t *testing.T) {
+	settings := linkTypeTestSettings()
+	never := func(fromID, toID, tag string) bool { return false }
+
+	a := &Decision{ID: "0001", Links: Links{Custom: map[string][]string{"replaces": {"0003"}}}}
+	b := &Decision{ID: "0002"}
+
+	assert.NoError(t, settings.checkLinkType(b, a, "depends on", "required by", never))
+	assert.NoError(t, settings.checkLinkType(b, a, "unknown", "whatever", never))
+	assert.EqualError(t, settings.checkLinkType(b, a, "depends on", "depends on", never),
+		`link type "depends on" has the inverse "required by", cannot use the reverse tag "depends on"`)
+	assert.EqualError(t, settings.checkLinkType(a, b, "replaces", "replaced by", never),
+		`link type "replaces" allows only one link per decision, 0001 already links to 0003`)
+	assert.EqualError(t, settings.checkLinkType(b, a, "replaced by", "replaces", never),
+		`link type "replaces" allows only one link per decision, 0001 already links to 0003`)
+
+	var reached []string
+	cycle := func(fromID, toID, tag string) bool {
+		reached = []string{fromID, toID, tag}
+		return true
+	}
+	assert.EqualError(t, settings.checkLinkType(a, b, "required by", "", cycle), "linking 0001 -> 0002 would create a cycle")
+	assert.Equal(t, []string{"0001", "0002", "depends on"}, reached)
+}
+
+func TestCheckLinks_LinkTypes(t *testing.T) {
+	settings := linkTypeTestSettings()
+	decisions := []Decision{
+		{ID: "0001", Links: Links{Custom: map[string][]string{"depends on": {"0002"}, "required by": {"0002"}, "relates to": {"0003"}}}},
+		{ID: "0002", Links: Links{Custom: map[string][]string{"depends on": {"0001"}, "required by": {"0001"}, "replaced by": {"0003", "0004"}}}},
+		{ID: "0003", Links: Links{Custom: map[string][]string{"replaces": {"0002"}}}},
+		{ID: "0004", Links: Links{Custom: map[string][]string{"replaces": {"0002"}}, Precedes: []string{"0099"}}},
+	}
+
+	problems := settings.CheckLinks(decisions)
+
+	assert.Equal(t, []string{
+		`0001: a "relates to" link to 0003 without the "relates to" link back`,
+		`0001: "depends on" links forming a cycle: 0001 -> 0002 -> 0001`,
+		`0002: "depends on" links forming a cycle: 0002 -> 0001 -> 0002`,
+		`0002: 2 "replaced by" links but the link type allows only one`,
+		`0004: a "precedes" link to 0099, which does not exist`,
+	}, problemTexts(problems))
+}
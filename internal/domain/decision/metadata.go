@@ -0,0 +1,130 @@
+package decision
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DateLayout is the format of the created, decided_at and review_by dates in the metadata of a decision.
+const DateLayout = "2006-01-02"
+
+// MetadataEdit describes changes to the metadata of a decision. Nil fields are left unchanged,
+// empty values clear a field.
+type MetadataEdit struct {
+	Created   *string
+	DecidedAt *string
+	ReviewBy  *string
+	Deciders  *[]string
+	Consulted *[]string
+	Informed  *[]string
+}
+
+func (e MetadataEdit) IsEmpty() bool {
+	return e.Created == nil && e.DecidedAt == nil && e.ReviewBy == nil && e.Deciders == nil && e.Consulted == nil && e.Informed == nil
+}
+
+func today() string {
+	return time.Now().Format(DateLayout)
+}
+
+func validateDate(field, value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, err := time.Parse(DateLayout, value); err != nil {
+		return fmt.Errorf("invalid %s date %q, expected YYYY-MM-DD", field, value)
+	}
+	return nil
+}
+
+// normalizePeople trims the names and drops empty and duplicate entries.
+func normalizePeople(people []string) []string {
+	var result []string
+	for _, p := range people {
+		p = strings.TrimSpace(p)
+		if p != "" && !slices.Contains(result, p) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func applyMetadataEdit(decision *Decision, edit MetadataEdit) error {
+	if edit.Created != nil {
+		if err := validateDate("created", *edit.Created); err != nil {
+			return err
+		}
+	}
+	if edit.DecidedAt != nil {
+		if err := validateDate("decided_at", *edit.DecidedAt); err != nil {
+			return err
+		}
+	}
+	if edit.ReviewBy != nil {
+		if err := validateDate("review_by", *edit.ReviewBy); err != nil {
+			return err
+		}
+	}
+
+	if edit.Created != nil {
+		decision.Created = *edit.Created
+	}
+	if edit.DecidedAt != nil {
+		decision.DecidedAt = *edit.DecidedAt
+	}
+	if edit.ReviewBy != nil {
+		decision.ReviewBy = *edit.ReviewBy
+	}
+	if edit.Deciders != nil {
+		decision.Deciders = normalizePeople(*edit.Deciders)
+	}
+	if edit.Consulted != nil {
+		decision.Consulted = normalizePeople(*edit.Consulted)
+	}
+	if edit.Informed != nil {
+		decision.Informed = normalizePeople(*edit.Informed)
+	}
+	return nil
+}
+
+// parseDateRange parses a date filter given as a single date or as a range "from..to" with optional ends.
+func parseDateRange(value string) (from, to string, err error) {
+	from, to, isRange := strings.Cut(value, "..")
+	if !isRange {
+		to = from
+	}
+	from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+	if err := validateDate("filter", from); err != nil {
+		return "", "", err
+	}
+	if err := validateDate("filter", to); err != nil {
+		return "", "", err
+	}
+	return from, to, nil
+}
+
+// dates in the format YYYY-MM-DD compare correctly as strings
+func inDateRange(date string, ranges [][2]string) bool {
+	if date == "" {
+		return false
+	}
+	for _, r := range ranges {
+		if (r[0] == "" || date >= r[0]) && (r[1] == "" || date <= r[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesPerson(people, filters []string) bool {
+	for _, f := range filters {
+		for _, p := range people {
+			if strings.EqualFold(p, f) {
+				return true
+			}
+		}
+	}
+	return false
+}
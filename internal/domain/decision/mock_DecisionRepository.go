@@ -9,17 +9,17 @@ type MockDecisionRepository struct {
 	mock.Mock
 }
 
-// AppendCommentSection provides a mock function with given fields: modelPath, decisionID, commentText, commentNumber, author, date
-func (_m *MockDecisionRepository) AppendCommentSection(modelPath string, decisionID string, commentText string, commentNumber int, author string, date string) error {
-	ret := _m.Called(modelPath, decisionID, commentText, commentNumber, author, date)
+// AppendOutcomeSection provides a mock function with given fields: modelPath, decisionID, outcome
+func (_m *MockDecisionRepository) AppendOutcomeSection(modelPath string, decisionID string, outcome string) error {
+	ret := _m.Called(modelPath, decisionID, outcome)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AppendCommentSection")
+		panic("no return value specified for AppendOutcomeSection")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string, int, string, string) error); ok {
-		r0 = rf(modelPath, decisionID, commentText, commentNumber, author, date)
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(modelPath, decisionID, outcome)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -27,17 +27,17 @@ func (_m *MockDecisionRepository) AppendCommentSection(modelPath string, decisio
 	return r0
 }
 
-// AppendOutcomeSection provides a mock function with given fields: modelPath, decisionID, outcome
-func (_m *MockDecisionRepository) AppendOutcomeSection(modelPath string, decisionID string, outcome string) error {
-	ret := _m.Called(modelPath, decisionID, outcome)
+// Archive provides a mock function with given fields: modelPath, decisionID
+func (_m *MockDecisionRepository) Archive(modelPath string, decisionID string) error {
+	ret := _m.Called(modelPath, decisionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AppendOutcomeSection")
+		panic("no return value specified for Archive")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
-		r0 = rf(modelPath, decisionID, outcome)
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(modelPath, decisionID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -93,6 +93,84 @@ func (_m *MockDecisionRepository) Create(modelPath string, subFolderPath string,
 	return r0, r1
 }
 
+// DecisionFileStamps provides a mock function with given fields: modelPath
+func (_m *MockDecisionRepository) DecisionFileStamps(modelPath string) (map[string]string, error) {
+	ret := _m.Called(modelPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecisionFileStamps")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string]string, error)); ok {
+		return rf(modelPath)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string]string); ok {
+		r0 = rf(modelPath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(modelPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: modelPath, decisionID
+func (_m *MockDecisionRepository) Delete(modelPath string, decisionID string) error {
+	ret := _m.Called(modelPath, decisionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(modelPath, decisionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExistingIDs provides a mock function with given fields: modelPath
+func (_m *MockDecisionRepository) ExistingIDs(modelPath string) ([]string, error) {
+	ret := _m.Called(modelPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistingIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(modelPath)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(modelPath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(modelPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindDecisionFile provides a mock function with given fields: modelPath, decisionID
 func (_m *MockDecisionRepository) FindDecisionFile(modelPath string, decisionID string) (string, error) {
 	ret := _m.Called(modelPath, decisionID)
@@ -299,6 +377,114 @@ func (_m *MockDecisionRepository) LoadDecisionContentRaw(modelPath string, decis
 	return r0, r1
 }
 
+// LoadDecisionFiles provides a mock function with given fields: modelPath, decisionID
+func (_m *MockDecisionRepository) LoadDecisionFiles(modelPath string, decisionID string) ([]DecisionFile, error) {
+	ret := _m.Called(modelPath, decisionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadDecisionFiles")
+	}
+
+	var r0 []DecisionFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]DecisionFile, error)); ok {
+		return rf(modelPath, decisionID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []DecisionFile); ok {
+		r0 = rf(modelPath, decisionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DecisionFile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(modelPath, decisionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoadSearchIndex provides a mock function with given fields: modelPath
+func (_m *MockDecisionRepository) LoadSearchIndex(modelPath string) (*SearchIndex, error) {
+	ret := _m.Called(modelPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadSearchIndex")
+	}
+
+	var r0 *SearchIndex
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*SearchIndex, error)); ok {
+		return rf(modelPath)
+	}
+	if rf, ok := ret.Get(0).(func(string) *SearchIndex); ok {
+		r0 = rf(modelPath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SearchIndex)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(modelPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoadSettings provides a mock function with given fields: modelPath
+func (_m *MockDecisionRepository) LoadSettings(modelPath string) (*ModelSettings, error) {
+	ret := _m.Called(modelPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadSettings")
+	}
+
+	var r0 *ModelSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*ModelSettings, error)); ok {
+		return rf(modelPath)
+	}
+	if rf, ok := ret.Get(0).(func(string) *ModelSettings); ok {
+		r0 = rf(modelPath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ModelSettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(modelPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveDecisionFile provides a mock function with given fields: modelPath, filePath, decision
+func (_m *MockDecisionRepository) MoveDecisionFile(modelPath string, filePath string, decision *Decision) error {
+	ret := _m.Called(modelPath, filePath, decision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveDecisionFile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, *Decision) error); ok {
+		r0 = rf(modelPath, filePath, decision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // OptionExists provides a mock function with given fields: modelPath, decisionID, option
 func (_m *MockDecisionRepository) OptionExists(modelPath string, decisionID string, option string) (bool, error) {
 	ret := _m.Called(modelPath, decisionID, option)
@@ -327,6 +513,60 @@ func (_m *MockDecisionRepository) OptionExists(modelPath string, decisionID stri
 	return r0, r1
 }
 
+// RemoveSection provides a mock function with given fields: modelPath, decisionID, anchorName
+func (_m *MockDecisionRepository) RemoveSection(modelPath string, decisionID string, anchorName string) error {
+	ret := _m.Called(modelPath, decisionID, anchorName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(modelPath, decisionID, anchorName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Rename provides a mock function with given fields: modelPath, decision
+func (_m *MockDecisionRepository) Rename(modelPath string, decision *Decision) error {
+	ret := _m.Called(modelPath, decision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rename")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *Decision) error); ok {
+		r0 = rf(modelPath, decision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Renumber provides a mock function with given fields: modelPath, ids, decisions
+func (_m *MockDecisionRepository) Renumber(modelPath string, ids map[string]string, decisions []Decision) error {
+	ret := _m.Called(modelPath, ids, decisions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Renumber")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, map[string]string, []Decision) error); ok {
+		r0 = rf(modelPath, ids, decisions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResolveOptionNumber provides a mock function with given fields: modelPath, decisionID, option
 func (_m *MockDecisionRepository) ResolveOptionNumber(modelPath string, decisionID string, option string) (int, error) {
 	ret := _m.Called(modelPath, decisionID, option)
@@ -373,6 +613,42 @@ func (_m *MockDecisionRepository) Save(modelPath string, decision *Decision) err
 	return r0
 }
 
+// SaveSearchIndex provides a mock function with given fields: modelPath, index
+func (_m *MockDecisionRepository) SaveSearchIndex(modelPath string, index *SearchIndex) error {
+	ret := _m.Called(modelPath, index)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSearchIndex")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *SearchIndex) error); ok {
+		r0 = rf(modelPath, index)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetNotice provides a mock function with given fields: modelPath, decisionID, anchorName, lines
+func (_m *MockDecisionRepository) SetNotice(modelPath string, decisionID string, anchorName string, lines []string) error {
+	ret := _m.Called(modelPath, decisionID, anchorName, lines)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetNotice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, []string) error); ok {
+		r0 = rf(modelPath, decisionID, anchorName, lines)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateSection provides a mock function with given fields: modelPath, decisionID, anchorName, lines
 func (_m *MockDecisionRepository) UpdateSection(modelPath string, decisionID string, anchorName string, lines []string) error {
 	ret := _m.Called(modelPath, decisionID, anchorName, lines)
@@ -0,0 +1,331 @@
+package decision
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/adr/ad-guidance-tool/internal/domain"
+)
+
+var (
+	optionLinePattern = regexp.MustCompile(`^\s*\d+\.\s*<a name="option-(\d+)"></a>\s*(.*)$`)
+	optionLinkPattern = regexp.MustCompile(`\[Option (\d+)\]\(#option-(\d+)\)`)
+)
+
+const (
+	optionProPrefix = "- Pro: "
+	optionConPrefix = "- Con: "
+)
+
+// ContentEdit describes the changes an edit applies to the content of a decision.
+// Option references are option numbers or titles as they are before the edit.
+type ContentEdit struct {
+	Question        *string
+	ReplaceQuestion bool
+	Criteria        *string
+	ReplaceCriteria bool
+	AddOptions      []string
+	RenameOptions   []OptionRename
+	RemoveOptions   []string
+	OptionOrder     []string
+	DescribeOptions []OptionText
+	AddPros         []OptionText
+	AddCons         []OptionText
+	AddCriteria     []string
+	CriterionWeight []CriterionWeight
+	Sections        []SectionEdit
+	RemoveSections  []string
+}
+
+type OptionRename struct {
+	Option string
+	Title  string
+}
+
+// OptionText is a piece of text that belongs to a single option, e.g. a pro or a con.
+type OptionText struct {
+	Option string
+	Text   string
+}
+
+// Option is a single entry of the options section. Description, pros and cons are rendered
+// indented below the numbered option line.
+type Option struct {
+	Number      int      `json:"number" yaml:"number"`
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Pros        []string `json:"pros,omitempty" yaml:"pros,omitempty"`
+	Cons        []string `json:"cons,omitempty" yaml:"cons,omitempty"`
+}
+
+func (e ContentEdit) IsEmpty() bool {
+	return e.Question == nil && e.Criteria == nil && len(e.AddOptions) == 0 && !e.restructuresOptions() && !e.editsCriteria() &&
+		len(e.Sections) == 0 && len(e.RemoveSections) == 0
+}
+
+func (e ContentEdit) editsCriteria() bool {
+	return len(e.AddCriteria) > 0 || len(e.CriterionWeight) > 0
+}
+
+func (e ContentEdit) restructuresOptions() bool {
+	return len(e.RenameOptions) > 0 || len(e.RemoveOptions) > 0 || len(e.OptionOrder) > 0 ||
+		len(e.DescribeOptions) > 0 || len(e.AddPros) > 0 || len(e.AddCons) > 0
+}
+
+func (o Option) hasDetails() bool {
+	return o.Description != "" || len(o.Pros) > 0 || len(o.Cons) > 0
+}
+
+// ParseOptions returns the structured options of an options section.
+func ParseOptions(section string) []Option {
+	_, options := parseOptions(section)
+	return options
+}
+
+// parseOptions splits the options section into the lines before the first option and the options themselves.
+// Lines below an option are read as its pros, cons or description.
+func parseOptions(section string) ([]string, []Option) {
+	var preamble []string
+	var options []Option
+	var description []string
+
+	flushDescription := func() {
+		if len(options) > 0 {
+			options[len(options)-1].Description = strings.Join(description, "\n")
+		}
+		description = nil
+	}
+
+	for _, line := range strings.Split(section, "\n") {
+		if m := optionLinePattern.FindStringSubmatch(line); m != nil {
+			flushDescription()
+			number, _ := strconv.Atoi(m[1])
+			options = append(options, Option{Number: number, Title: strings.TrimSpace(m[2])})
+			continue
+		}
+		if len(options) == 0 {
+			preamble = append(preamble, line)
+			continue
+		}
+
+		last := &options[len(options)-1]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+		case strings.HasPrefix(trimmed, optionProPrefix):
+			last.Pros = append(last.Pros, strings.TrimSpace(strings.TrimPrefix(trimmed, optionProPrefix)))
+		case strings.HasPrefix(trimmed, optionConPrefix):
+			last.Cons = append(last.Cons, strings.TrimSpace(strings.TrimPrefix(trimmed, optionConPrefix)))
+		default:
+			description = append(description, trimmed)
+		}
+	}
+	flushDescription()
+
+	return preamble, options
+}
+
+// renderOptions numbers the options in their current order. Options with details are
+// separated by blank lines so that the details stay part of their list item.
+func renderOptions(preamble []string, options []Option) []string {
+	lines := append([]string{}, preamble...)
+	for i, opt := range options {
+		if i > 0 && options[i-1].hasDetails() {
+			lines = append(lines, "")
+		}
+		lines = append(lines, formatOptionLine(i+1, opt.Title))
+		lines = append(lines, formatOptionDetails(i+1, opt)...)
+	}
+	return lines
+}
+
+// formatOptionDetails indents the details to the content column of the numbered option line.
+func formatOptionDetails(num int, opt Option) []string {
+	indent := strings.Repeat(" ", len(strconv.Itoa(num))+2)
+
+	var lines []string
+	if opt.Description != "" {
+		lines = append(lines, "")
+		for _, line := range strings.Split(opt.Description, "\n") {
+			lines = append(lines, indent+line)
+		}
+	}
+	if len(opt.Pros) > 0 || len(opt.Cons) > 0 {
+		lines = append(lines, "")
+		for _, pro := range opt.Pros {
+			lines = append(lines, indent+optionProPrefix+pro)
+		}
+		for _, con := range opt.Cons {
+			lines = append(lines, indent+optionConPrefix+con)
+		}
+	}
+	return lines
+}
+
+// resolveOption returns the index of the option referenced by its number or title.
+func resolveOption(options []Option, ref string) (int, error) {
+	ref = strings.TrimSpace(ref)
+	if number, err := strconv.Atoi(ref); err == nil {
+		for i, opt := range options {
+			if opt.Number == number {
+				return i, nil
+			}
+		}
+		return -1, fmt.Errorf("option number %d not found", number)
+	}
+	for i, opt := range options {
+		if strings.EqualFold(opt.Title, ref) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("option %q not found", ref)
+}
+
+// restructureOptions applies the option changes of an edit and returns the new options together
+// with a mapping from old to new option numbers. Removed options are missing from the mapping.
+func restructureOptions(options []Option, edit ContentEdit) ([]Option, map[int]int, error) {
+	// new options are appended first so that their details can be given in the same edit
+	existing := len(options)
+	options = slices.Clone(options)
+	for _, title := range edit.AddOptions {
+		options = append(options, Option{Number: len(options) + 1, Title: strings.TrimSpace(title)})
+	}
+	if err := applyOptionDetails(options, edit); err != nil {
+		return nil, nil, err
+	}
+
+	removed := make(map[int]bool)
+	for _, ref := range edit.RemoveOptions {
+		idx, err := resolveOption(options, ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		removed[idx] = true
+	}
+
+	titles := make(map[int]string)
+	for _, rename := range edit.RenameOptions {
+		idx, err := resolveOption(options, rename.Option)
+		if err != nil {
+			return nil, nil, err
+		}
+		if strings.TrimSpace(rename.Title) == "" {
+			return nil, nil, fmt.Errorf("new title of option %s must not be empty", rename.Option)
+		}
+		titles[idx] = strings.TrimSpace(rename.Title)
+	}
+
+	var order []int
+	placed := make(map[int]bool)
+	for _, ref := range edit.OptionOrder {
+		idx, err := resolveOption(options, ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		if removed[idx] {
+			return nil, nil, fmt.Errorf("option %s cannot be reordered because it is removed", ref)
+		}
+		if placed[idx] {
+			return nil, nil, fmt.Errorf("option %s is listed more than once in the new order", ref)
+		}
+		placed[idx] = true
+		order = append(order, idx)
+	}
+	// options that were not listed keep their relative order after the listed ones
+	for idx := range options {
+		if !placed[idx] && !removed[idx] {
+			order = append(order, idx)
+		}
+	}
+
+	result := make([]Option, 0, len(order))
+	mapping := make(map[int]int)
+	for _, idx := range order {
+		opt := options[idx]
+		if title, ok := titles[idx]; ok {
+			opt.Title = title
+		}
+		if idx < existing {
+			mapping[opt.Number] = len(result) + 1
+		}
+		opt.Number = len(result) + 1
+		result = append(result, opt)
+	}
+
+	seen := make(map[string]bool)
+	for _, opt := range result {
+		key := strings.ToLower(opt.Title)
+		if seen[key] {
+			return nil, nil, fmt.Errorf("option %q exists more than once", opt.Title)
+		}
+		seen[key] = true
+	}
+
+	return result, mapping, nil
+}
+
+// applyOptionDetails sets descriptions and adds pros and cons to the referenced options.
+func applyOptionDetails(options []Option, edit ContentEdit) error {
+	for _, description := range edit.DescribeOptions {
+		idx, err := resolveOption(options, description.Option)
+		if err != nil {
+			return err
+		}
+		options[idx].Description = strings.TrimSpace(description.Text)
+	}
+
+	add := func(texts []OptionText, kind string, target func(*Option) *[]string) error {
+		for _, text := range texts {
+			idx, err := resolveOption(options, text.Option)
+			if err != nil {
+				return err
+			}
+			value := strings.TrimSpace(text.Text)
+			if value == "" {
+				return fmt.Errorf("%s of option %s must not be empty", kind, text.Option)
+			}
+			list := target(&options[idx])
+			*list = append(slices.Clone(*list), value)
+		}
+		return nil
+	}
+
+	if err := add(edit.AddPros, "pro", func(o *Option) *[]string { return &o.Pros }); err != nil {
+		return err
+	}
+	return add(edit.AddCons, "con", func(o *Option) *[]string { return &o.Cons })
+}
+
+// renumberOptionLinks rewrites links to options according to the given mapping.
+// It fails if a link points to an option that no longer exists.
+func renumberOptionLinks(text string, mapping map[int]int) (string, error) {
+	var missing []string
+	result := optionLinkPattern.ReplaceAllStringFunc(text, func(link string) string {
+		m := optionLinkPattern.FindStringSubmatch(link)
+		number, _ := strconv.Atoi(m[2])
+		newNumber, ok := mapping[number]
+		if !ok {
+			missing = append(missing, m[2])
+			return link
+		}
+		return domain.AnchorLinkToOption(newNumber)
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("option %s is referenced by the outcome and cannot be removed", strings.Join(missing, ", "))
+	}
+	return result, nil
+}
+
+// remapChosenOptions renumbers the chosen options according to the given mapping.
+func remapChosenOptions(chosen []int, mapping map[int]int) []int {
+	var remapped []int
+	for _, number := range chosen {
+		if newNumber, ok := mapping[number]; ok {
+			remapped = append(remapped, newNumber)
+		}
+	}
+	return remapped
+}
@@ -0,0 +1,149 @@
+package decision
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const threeOptions = `1. <a name="option-1"></a> PostgreSQL
+   mature and well known
+2. <a name="option-2"></a> MySQL
+3. <a name="option-3"></a> SQLite`
+
+func TestParseOptions_ReadsDetails(t *testing.T) {
+	preamble, options := parseOptions("Some intro\n" + threeOptions)
+
+	assert.Equal(t, []string{"Some intro"}, preamble)
+	assert.Len(t, options, 3)
+	assert.Equal(t, "PostgreSQL", options[0].Title)
+	assert.Equal(t, "mature and well known", options[0].Description)
+	assert.Equal(t, 3, options[2].Number)
+}
+
+func TestParseOptions_ProsAndCons(t *testing.T) {
+	options := ParseOptions(`1. <a name="option-1"></a> PostgreSQL
+
+   Open source database.
+   Runs everywhere.
+
+   - Pro: cheap
+   - Con: operating effort
+   - Pro: well known
+
+2. <a name="option-2"></a> MySQL`)
+
+	assert.Equal(t, []Option{
+		{Number: 1, Title: "PostgreSQL", Description: "Open source database.\nRuns everywhere.", Pros: []string{"cheap", "well known"}, Cons: []string{"operating effort"}},
+		{Number: 2, Title: "MySQL"},
+	}, options)
+}
+
+func TestRenderOptions_StableLayout(t *testing.T) {
+	options := []Option{
+		{Title: "PostgreSQL", Description: "Open source database.", Pros: []string{"cheap"}, Cons: []string{"operating effort"}},
+		{Title: "MySQL"},
+	}
+
+	lines := renderOptions(nil, options)
+
+	assert.Equal(t, []string{
+		`1. <a name="option-1"></a> PostgreSQL`,
+		"",
+		"   Open source database.",
+		"",
+		"   - Pro: cheap",
+		"   - Con: operating effort",
+		"",
+		`2. <a name="option-2"></a> MySQL`,
+	}, lines)
+
+	_, parsed := parseOptions(strings.Join(lines, "\n"))
+	assert.Equal(t, renderOptions(nil, parsed), lines)
+}
+
+func TestRestructureOptions_Details(t *testing.T) {
+	_, options := parseOptions(threeOptions)
+
+	result, _, err := restructureOptions(options, ContentEdit{
+		DescribeOptions: []OptionText{{Option: "2", Text: "Popular database"}},
+		AddPros:         []OptionText{{Option: "mysql", Text: "cheap"}, {Option: "2", Text: "fast"}},
+		AddCons:         []OptionText{{Option: "1", Text: "operating effort"}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Popular database", result[1].Description)
+	assert.Equal(t, []string{"cheap", "fast"}, result[1].Pros)
+	assert.Equal(t, []string{"operating effort"}, result[0].Cons)
+	assert.Empty(t, options[0].Cons)
+}
+
+func TestRestructureOptions_EmptyPro(t *testing.T) {
+	_, options := parseOptions(threeOptions)
+
+	_, _, err := restructureOptions(options, ContentEdit{AddPros: []OptionText{{Option: "1", Text: " "}}})
+
+	assert.EqualError(t, err, "pro of option 1 must not be empty")
+}
+
+func TestRestructureOptions_RemoveRenameReorder(t *testing.T) {
+	_, options := parseOptions(threeOptions)
+
+	result, mapping, err := restructureOptions(options, ContentEdit{
+		RemoveOptions: []string{"mysql"},
+		RenameOptions: []OptionRename{{Option: "3", Title: "SQLite (embedded)"}},
+		OptionOrder:   []string{"3"},
+		AddOptions:    []string{"CockroachDB"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`1. <a name="option-1"></a> SQLite (embedded)`,
+		`2. <a name="option-2"></a> PostgreSQL`,
+		"",
+		"   mature and well known",
+		"",
+		`3. <a name="option-3"></a> CockroachDB`,
+	}, renderOptions(nil, result))
+	assert.Equal(t, map[int]int{1: 2, 3: 1}, mapping)
+}
+
+func TestRestructureOptions_UnknownOption(t *testing.T) {
+	_, options := parseOptions(threeOptions)
+
+	_, _, err := restructureOptions(options, ContentEdit{RemoveOptions: []string{"7"}})
+
+	assert.EqualError(t, err, "option number 7 not found")
+}
+
+func TestRestructureOptions_DuplicateTitle(t *testing.T) {
+	_, options := parseOptions(threeOptions)
+
+	_, _, err := restructureOptions(options, ContentEdit{RenameOptions: []OptionRename{{Option: "1", Title: "mysql"}}})
+
+	assert.ErrorContains(t, err, "exists more than once")
+}
+
+func TestRestructureOptions_ReorderRemovedOption(t *testing.T) {
+	_, options := parseOptions(threeOptions)
+
+	_, _, err := restructureOptions(options, ContentEdit{RemoveOptions: []string{"2"}, OptionOrder: []string{"2", "1"}})
+
+	assert.ErrorContains(t, err, "cannot be reordered because it is removed")
+}
+
+func TestRenumberOptionLinks(t *testing.T) {
+	outcome := "We decided for [Option 3](#option-3) because: it is simple"
+
+	result, err := renumberOptionLinks(outcome, map[int]int{1: 2, 3: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "We decided for [Option 1](#option-1) because: it is simple", result)
+}
+
+func TestRenumberOptionLinks_RemovedOption(t *testing.T) {
+	_, err := renumberOptionLinks("We decided for [Option 2](#option-2).", map[int]int{1: 1})
+
+	assert.EqualError(t, err, "option 2 is referenced by the outcome and cannot be removed")
+}
@@ -0,0 +1,120 @@
+package decision
+
+import (
+	"slices"
+	"sort"
+)
+
+// BlockedDecision is a pending decision waiting for the pending decisions in BlockedBy.
+type BlockedDecision struct {
+	Decision  Decision
+	BlockedBy []string
+}
+
+// Readiness splits the pending decisions of a model into the ones that can be decided now because
+// every predecessor is settled and the ones that are blocked by a pending predecessor.
+type Readiness struct {
+	Ready   []Decision
+	Blocked []BlockedDecision
+}
+
+// Plan orders the pending decisions into waves. Every decision can be decided once the decisions
+// of the previous waves are. Cyclic holds the decisions that are part of or depend on a cycle.
+type Plan struct {
+	Waves  [][]Decision
+	Cyclic []Decision
+}
+
+// predecessors returns the IDs of the decisions that precede each decision, taken from both
+// the precedes links of the predecessor and the succeeds links of the successor.
+func predecessors(decisions []Decision) map[string][]string {
+	known := make(map[string]bool, len(decisions))
+	for _, d := range decisions {
+		known[d.ID] = true
+	}
+
+	preds := make(map[string][]string)
+	add := func(id, pred string) {
+		if known[id] && known[pred] && id != pred && !slices.Contains(preds[id], pred) {
+			preds[id] = append(preds[id], pred)
+		}
+	}
+	for _, d := range decisions {
+		for _, next := range d.Links.Precedes {
+			add(next, d.ID)
+		}
+		for _, prev := range d.Links.Succeeds {
+			add(d.ID, prev)
+		}
+	}
+	for id := range preds {
+		sort.Strings(preds[id])
+	}
+	return preds
+}
+
+func pendingDecisions(decisions []Decision, lifecycle Lifecycle) (pending []Decision, isPending map[string]bool) {
+	isPending = make(map[string]bool)
+	for _, d := range decisions {
+		if lifecycle.IsPending(d.Status) {
+			pending = append(pending, d)
+			isPending[d.ID] = true
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].ID < pending[j].ID })
+	return pending, isPending
+}
+
+// AssessReadiness determines which pending decisions can be decided next. Predecessors that are
+// decided or otherwise settled, e.g. rejected, do not block.
+func AssessReadiness(decisions []Decision, lifecycle Lifecycle) Readiness {
+	preds := predecessors(decisions)
+	pending, isPending := pendingDecisions(decisions, lifecycle)
+
+	var readiness Readiness
+	for _, d := range pending {
+		var blockers []string
+		for _, pred := range preds[d.ID] {
+			if isPending[pred] {
+				blockers = append(blockers, pred)
+			}
+		}
+		if len(blockers) == 0 {
+			readiness.Ready = append(readiness.Ready, d)
+		} else {
+			readiness.Blocked = append(readiness.Blocked, BlockedDecision{Decision: d, BlockedBy: blockers})
+		}
+	}
+	return readiness
+}
+
+// PlanDecisions orders the pending decisions into waves with a topological walk of the precedes links.
+func PlanDecisions(decisions []Decision, lifecycle Lifecycle) Plan {
+	preds := predecessors(decisions)
+	pending, isPending := pendingDecisions(decisions, lifecycle)
+
+	planned := make(map[string]bool)
+	remaining := pending
+	var plan Plan
+	for len(remaining) > 0 {
+		var wave, rest []Decision
+		for _, d := range remaining {
+			ready := !slices.ContainsFunc(preds[d.ID], func(pred string) bool { return isPending[pred] && !planned[pred] })
+			if ready {
+				wave = append(wave, d)
+			} else {
+				rest = append(rest, d)
+			}
+		}
+		if len(wave) == 0 {
+			plan.Cyclic = rest
+			break
+		}
+		for _, d := range wave {
+			planned[d.ID] = true
+		}
+		plan.Waves = append(plan.Waves, wave)
+		remaining = rest
+	}
+	return plan
+}
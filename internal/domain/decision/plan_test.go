@@ -0,0 +1,72 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func decisionIDs(decisions []Decision) []string {
+	var result []string
+	for _, d := range decisions {
+		result = append(result, d.ID)
+	}
+	return result
+}
+
+func planTestDecisions() []Decision {
+	return []Decision{
+		{ID: "0001", Status: "decided", Links: Links{Precedes: []string{"0002", "0003"}}},
+		{ID: "0002", Status: "open", Links: Links{Succeeds: []string{"0001"}, Precedes: []string{"0004"}}},
+		{ID: "0003", Status: "open", Links: Links{Succeeds: []string{"0001"}}},
+		{ID: "0004", Status: "open", Links: Links{Succeeds: []string{"0002", "0003"}}},
+		{ID: "0005", Status: "rejected", Links: Links{Precedes: []string{"0006"}}},
+		{ID: "0006", Links: Links{Succeeds: []string{"0005"}}},
+		{ID: "0007", Status: "superseded"},
+	}
+}
+
+func TestAssessReadiness(t *testing.T) {
+	readiness := AssessReadiness(planTestDecisions(), DefaultLifecycle())
+
+	assert.Equal(t, []string{"0002", "0003", "0006"}, decisionIDs(readiness.Ready))
+	assert.Len(t, readiness.Blocked, 1)
+	assert.Equal(t, "0004", readiness.Blocked[0].Decision.ID)
+	assert.Equal(t, []string{"0002", "0003"}, readiness.Blocked[0].BlockedBy)
+}
+
+func TestAssessReadiness_UsesSuccessorLinks(t *testing.T) {
+	decisions := []Decision{
+		{ID: "0001", Status: "open"},
+		{ID: "0002", Status: "open", Links: Links{Succeeds: []string{"0001", "0099"}}},
+	}
+
+	readiness := AssessReadiness(decisions, DefaultLifecycle())
+
+	assert.Equal(t, []string{"0001"}, decisionIDs(readiness.Ready))
+	assert.Equal(t, []string{"0001"}, readiness.Blocked[0].BlockedBy)
+}
+
+func TestPlanDecisions_Waves(t *testing.T) {
+	plan := PlanDecisions(planTestDecisions(), DefaultLifecycle())
+
+	assert.Len(t, plan.Waves, 2)
+	assert.Equal(t, []string{"0002", "0003", "0006"}, decisionIDs(plan.Waves[0]))
+	assert.Equal(t, []string{"0004"}, decisionIDs(plan.Waves[1]))
+	assert.Empty(t, plan.Cyclic)
+}
+
+func TestPlanDecisions_Cycle(t *testing.T) {
+	decisions := []Decision{
+		{ID: "0001", Status: "open", Links: Links{Precedes: []string{"0002"}}},
+		{ID: "0002", Status: "open", Links: Links{Precedes: []string{"0003"}}},
+		{ID: "0003", Status: "open", Links: Links{Precedes: []string{"0002", "0004"}}},
+		{ID: "0004", Status: "open"},
+	}
+
+	plan := PlanDecisions(decisions, DefaultLifecycle())
+
+	assert.Len(t, plan.Waves, 1)
+	assert.Equal(t, []string{"0001"}, decisionIDs(plan.Waves[0]))
+	assert.Equal(t, []string{"0002", "0003", "0004"}, decisionIDs(plan.Cyclic))
+}
@@ -0,0 +1,428 @@
+package decision
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var queryFieldPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// queryOperators are ordered so that two character operators are matched first.
+var queryOperators = []string{"!=", "<=", ">=", "=", "<", ">", "~"}
+
+// Query is a parsed filter expression such as
+//
+//	status = open and (tag = security or risk >= 3) and not links
+//
+// Comparisons take the form <field> <operator> <value>, a field on its own tests that it is set.
+// Fields holding lists match if any of their items matches, != matches if none does. Link types
+// containing spaces are quoted, e.g. links."superseded by".
+type Query struct {
+	expr      string
+	root      queryNode
+	statuses  []string
+	linkTypes []string
+}
+
+type queryNode interface {
+	matches(d Decision) bool
+}
+
+type andNode struct{ left, right queryNode }
+
+type orNode struct{ left, right queryNode }
+
+type notNode struct{ node queryNode }
+
+// existsNode matches decisions with a non-empty value for the field.
+type existsNode struct{ field string }
+
+type compareNode struct {
+	field string
+	op    string
+	value string
+	regex *regexp.Regexp
+}
+
+func (n andNode) matches(d Decision) bool { return n.left.matches(d) && n.right.matches(d) }
+
+func (n orNode) matches(d Decision) bool { return n.left.matches(d) || n.right.matches(d) }
+
+func (n notNode) matches(d Decision) bool { return !n.node.matches(d) }
+
+func (n existsNode) matches(d Decision) bool { return len(queryFieldValues(d, n.field)) > 0 }
+
+func (n compareNode) matches(d Decision) bool {
+	values := queryFieldValues(d, n.field)
+	if n.op == "!=" {
+		return !slices.ContainsFunc(values, func(v string) bool { return n.compare(v, "=") })
+	}
+	return slices.ContainsFunc(values, func(v string) bool { return n.compare(v, n.op) })
+}
+
+func (n compareNode) compare(actual, op string) bool {
+	want := n.value
+	if want == "today" && isDateField(n.field) {
+		want = today()
+	}
+
+	switch op {
+	case "=":
+		if isPeopleField(n.field) {
+			return strings.EqualFold(actual, want)
+		}
+		return actual == want
+	case "~":
+		return n.regex.MatchString(actual)
+	}
+
+	var cmp int
+	a, errA := strconv.ParseFloat(actual, 64)
+	b, errB := strconv.ParseFloat(want, 64)
+	if errA == nil && errB == nil {
+		cmp = compareFloats(a, b)
+	} else {
+		// dates in the format YYYY-MM-DD compare correctly as strings
+		cmp = strings.Compare(actual, want)
+	}
+
+	switch op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func isDateField(field string) bool {
+	switch field {
+	case "created", "decided", "decided_at", "review_by":
+		return true
+	default:
+		return false
+	}
+}
+
+func isPeopleField(field string) bool {
+	switch field {
+	case "decider", "deciders", "consulted", "informed":
+		return true
+	default:
+		return false
+	}
+}
+
+// queryFieldValues returns the values of a field as strings. Names that are not a built-in
+// metadata key refer to custom fields, links.<type> to the targets of one type of link.
+func queryFieldValues(d Decision, field string) []string {
+	var values []string
+	switch field {
+	case "id", "adr_id":
+		values = []string{d.ID}
+	case "title":
+		values = []string{d.Title}
+	case "status":
+		values = []string{d.Status}
+	case "tag", "tags":
+		values = d.Tags
+	case "created":
+		values = []string{d.Created}
+	case "decided", "decided_at":
+		values = []string{d.DecidedAt}
+	case "review_by":
+		values = []string{d.ReviewBy}
+	case "decider", "deciders":
+		values = d.Deciders
+	case "consulted":
+		values = d.Consulted
+	case "informed":
+		values = d.Informed
+	case "links":
+		values = append(append(values, d.Links.Precedes...), d.Links.Succeeds...)
+		for _, targets := range d.Links.Custom {
+			values = append(values, targets...)
+		}
+	default:
+		if linkType, ok := strings.CutPrefix(field, "links."); ok {
+			switch linkType {
+			case "precedes":
+				values = d.Links.Precedes
+			case "succeeds":
+				values = d.Links.Succeeds
+			default:
+				values = d.Links.Custom[linkType]
+			}
+			break
+		}
+		switch value := d.Fields[field].(type) {
+		case nil:
+		case []any:
+			for _, item := range value {
+				values = append(values, fmt.Sprint(item))
+			}
+		default:
+			values = []string{fmt.Sprint(value)}
+		}
+	}
+
+	return slices.DeleteFunc(slices.Clone(values), func(v string) bool { return v == "" })
+}
+
+// Matches reports whether the decision satisfies the expression.
+func (q *Query) Matches(d Decision) bool {
+	return q.root.matches(d)
+}
+
+// Statuses returns the statuses the expression compares against with =.
+func (q *Query) Statuses() []string {
+	return q.statuses
+}
+
+// LinkTypes returns the link types the expression refers to with links.<type>.
+func (q *Query) LinkTypes() []string {
+	return q.linkTypes
+}
+
+// ParseQuery parses a filter expression. Comparisons are combined with and, or and not
+// (in order of increasing precedence) and can be grouped with parentheses.
+func ParseQuery(expr string) (*Query, error) {
+	tokens, err := tokenizeQuery(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", expr, err)
+	}
+
+	p := &queryParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err == nil && p.peek().kind != tokenEnd {
+		err = p.unexpected()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", expr, err)
+	}
+
+	return &Query{expr: expr, root: root, statuses: p.statuses, linkTypes: p.linkTypes}, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenOpen
+	tokenClose
+)
+
+type queryToken struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func tokenizeQuery(expr string) ([]queryToken, error) {
+	var tokens []queryToken
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, queryToken{kind: tokenOpen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, queryToken{kind: tokenClose, text: ")", pos: i})
+			i++
+		case r == '"' || r == '\'':
+			end := slices.Index(runes[i+1:], r)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at position %d", i+1)
+			}
+			tokens = append(tokens, queryToken{kind: tokenString, text: string(runes[i+1 : i+1+end]), pos: i})
+			i += end + 2
+		case strings.ContainsRune("=!<>~", r):
+			op := ""
+			for _, candidate := range queryOperators {
+				if strings.HasPrefix(string(runes[i:]), candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unknown operator %q at position %d", string(r), i+1)
+			}
+			tokens = append(tokens, queryToken{kind: tokenOperator, text: op, pos: i})
+			i += len(op)
+		default:
+			start := i
+			var word strings.Builder
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("()=!<>~", runes[i]) {
+				if runes[i] != '"' && runes[i] != '\'' {
+					word.WriteRune(runes[i])
+					i++
+					continue
+				}
+				// a quoted part after a dot belongs to the field name, e.g. links."depends on"
+				if i == start || runes[i-1] != '.' {
+					break
+				}
+				end := slices.Index(runes[i+1:], runes[i])
+				if end < 0 {
+					return nil, fmt.Errorf("unterminated string at position %d", i+1)
+				}
+				word.WriteString(string(runes[i+1 : i+1+end]))
+				i += end + 2
+			}
+			tokens = append(tokens, queryToken{kind: tokenWord, text: word.String(), pos: start})
+		}
+	}
+	return append(tokens, queryToken{kind: tokenEnd, pos: len(runes)}), nil
+}
+
+type queryParser struct {
+	tokens    []queryToken
+	pos       int
+	statuses  []string
+	linkTypes []string
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) next() queryToken {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEnd {
+		p.pos++
+	}
+	return t
+}
+
+func (p *queryParser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func (p *queryParser) unexpected() error {
+	t := p.peek()
+	if t.kind == tokenEnd {
+		return fmt.Errorf("unexpected end of expression")
+	}
+	return fmt.Errorf("unexpected %q at position %d", t.text, t.pos+1)
+}
+
+func (p *queryParser) parseOr() (queryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() (queryNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("and") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseNot() (queryNode, error) {
+	if p.isKeyword("not") {
+		p.next()
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{node: node}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (queryNode, error) {
+	if p.peek().kind == tokenOpen {
+		p.next()
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().kind != tokenClose {
+			return nil, p.unexpected()
+		}
+		p.next()
+		return node, nil
+	}
+
+	field := p.peek()
+	if field.kind != tokenWord || p.isKeyword("and") || p.isKeyword("or") {
+		return nil, p.unexpected()
+	}
+	linkType, isLink := strings.CutPrefix(field.text, "links.")
+	if isLink && strings.TrimSpace(linkType) == "" || !isLink && !queryFieldPattern.MatchString(field.text) {
+		return nil, fmt.Errorf("invalid field name %q at position %d", field.text, field.pos+1)
+	}
+	if isLink && !slices.Contains(p.linkTypes, linkType) {
+		p.linkTypes = append(p.linkTypes, linkType)
+	}
+	p.next()
+
+	if p.peek().kind != tokenOperator {
+		return existsNode{field: field.text}, nil
+	}
+	op := p.next().text
+
+	value := p.peek()
+	if value.kind != tokenWord && value.kind != tokenString {
+		return nil, p.unexpected()
+	}
+	p.next()
+
+	node := compareNode{field: field.text, op: op, value: value.text}
+	if op == "~" {
+		regex, err := regexp.Compile(value.text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex %q: %w", value.text, err)
+		}
+		node.regex = regex
+	}
+	if field.text == "status" && op == "=" {
+		p.statuses = append(p.statuses, value.text)
+	}
+	return node, nil
+}
@@ -0,0 +1,104 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func queryIDs(t *testing.T, expr string, decisions []Decision) []string {
+	t.Helper()
+	query, err := ParseQuery(expr)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	var ids []string
+	for _, d := range decisions {
+		if query.Matches(d) {
+			ids = append(ids, d.ID)
+		}
+	}
+	return ids
+}
+
+func TestParseQuery_Matches(t *testing.T) {
+	decisions := []Decision{
+		{ID: "0001", Title: "Use Kafka", Status: "open", Tags: []string{"security", "backend"}, Created: "2025-01-10",
+			Fields: map[string]any{"risk": 4, "components": []any{"api", "frontend"}}},
+		{ID: "0002", Title: "Use gRPC", Status: "decided", Tags: []string{"security"}, Created: "2025-05-01", DecidedAt: "2025-06-01",
+			Deciders: []string{"Alice"}, Links: Links{Precedes: []string{"0003"}}, Fields: map[string]any{"risk": 2}},
+		{ID: "0003", Title: "Deprecate SOAP", Status: "open", Created: "2025-07-01",
+			Links: Links{Succeeds: []string{"0002"}, Custom: map[string][]string{"relates-to": {"0001"}, "depends on": {"0002"}}}},
+	}
+
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"status = open and tag = security", []string{"0001"}},
+		{"status = open or tag = security", []string{"0001", "0002", "0003"}},
+		{"not (status = open and tag = security)", []string{"0002", "0003"}},
+		{"tag != security", []string{"0003"}},
+		{"links", []string{"0002", "0003"}},
+		{"not links", []string{"0001"}},
+		{"links.relates-to = 0001", []string{"0003"}},
+		{"links.precedes", []string{"0002"}},
+		{`links."depends on" = 0002`, []string{"0003"}},
+		{"not links.'depends on'", []string{"0001", "0002"}},
+		{"created >= 2025-05-01 and created < 2025-07-01", []string{"0002"}},
+		{"decided_at", []string{"0002"}},
+		{"risk >= 3", []string{"0001"}},
+		{"risk > 10", nil},
+		{"components = frontend", []string{"0001"}},
+		{"deciders = alice", []string{"0002"}},
+		{`title ~ "^Use "`, []string{"0001", "0002"}},
+		{"id > 0001 AND NOT status = decided", []string{"0003"}},
+		{"status = open and tag = security or risk = 2", []string{"0001", "0002"}},
+		{"status = open and (tag = security or risk = 2)", []string{"0001"}},
+		{"review_by < today", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			assert.Equal(t, tt.want, queryIDs(t, tt.expr, decisions))
+		})
+	}
+}
+
+func TestParseQuery_Statuses(t *testing.T) {
+	query, err := ParseQuery("status = open or (status = superseded and status != decided)")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"open", "superseded"}, query.Statuses())
+}
+
+func TestParseQuery_LinkTypes(t *testing.T) {
+	query, err := ParseQuery(`links.precedes or links."superseded by" = 0002 or links.precedes = 0003`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"precedes", "superseded by"}, query.LinkTypes())
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	tests := []struct {
+		expr string
+		err  string
+	}{
+		{"", "unexpected end of expression"},
+		{"status = open and", "unexpected end of expression"},
+		{"(status = open", "unexpected end of expression"},
+		{"status = open)", `unexpected ")" at position 14`},
+		{"status open", `unexpected "open" at position 8`},
+		{"status ! open", `unknown operator "!" at position 8`},
+		{`title = "Kafka`, "unterminated string at position 9"},
+		{"title ~ *[", `invalid regex "*["`},
+		{"= open", `unexpected "=" at position 1`},
+		{`links."depends on = 0002`, "unterminated string at position 7"},
+		{"links. = 0002", `invalid field name "links." at position 1`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseQuery(tt.expr)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package decision
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	modelAliasPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+	referencePattern  = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_-]*):([^\s:]+)$`)
+)
+
+// ResolvedLink is the target of a link together with the decision it points to. Model is the
+// alias of the model of the target, empty for decisions of the same model. Missing is set if
+// the target could not be found.
+type ResolvedLink struct {
+	Type    string
+	Target  string
+	Model   string
+	Title   string
+	Status  string
+	Missing bool
+}
+
+// ParseReference splits a link target to a decision of another model, e.g. platform:0012, into
+// the alias of the model and the ID of the decision.
+func ParseReference(target string) (alias, id string, ok bool) {
+	match := referencePattern.FindStringSubmatch(target)
+	if match == nil {
+		return "", "", false
+	}
+	return match[1], match[2], true
+}
+
+// IsReference reports whether a link target points to a decision of another model.
+func IsReference(target string) bool {
+	_, _, ok := ParseReference(target)
+	return ok
+}
+
+func validateModelAliases(models map[string]string) error {
+	aliases := make([]string, 0, len(models))
+	for alias := range models {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	for _, alias := range aliases {
+		if !modelAliasPattern.MatchString(alias) {
+			return fmt.Errorf("invalid model alias %q, aliases start with a letter followed by letters, digits, - or _", alias)
+		}
+		if strings.TrimSpace(models[alias]) == "" {
+			return fmt.Errorf("model alias %q has no path", alias)
+		}
+	}
+	return nil
+}
+
+// ModelPath returns the path of the model with the given alias. Relative paths are resolved
+// against the directory of the model the settings belong to.
+func (s *ModelSettings) ModelPath(modelPath, alias string) (string, error) {
+	path, ok := s.Models[alias]
+	if !ok {
+		return "", fmt.Errorf("unknown model alias %q", alias)
+	}
+	if filepath.IsAbs(path) {
+		return path, nil
+	}
+	return filepath.Join(modelPath, path), nil
+}
@@ -0,0 +1,75 @@
+package decision
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReference(t *testing.T) {
+	alias, id, ok := ParseReference("platform:0012")
+	assert.True(t, ok)
+	assert.Equal(t, "platform", alias)
+	assert.Equal(t, "0012", id)
+
+	for _, target := range []string{"0012", "platform:", ":0012", "1st:0012", "a:b:c", "platform: 0012"} {
+		_, _, ok := ParseReference(target)
+		assert.False(t, ok, target)
+	}
+}
+
+func TestModelPath(t *testing.T) {
+	settings := DefaultModelSettings()
+	settings.Models = map[string]string{"platform": "../platform", "shared": "/models/shared"}
+
+	path, err := settings.ModelPath("teams/web", "platform")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join("teams", "platform"), path)
+
+	path, err = settings.ModelPath("teams/web", "shared")
+	assert.NoError(t, err)
+	assert.Equal(t, "/models/shared", path)
+
+	_, err = settings.ModelPath("teams/web", "unknown")
+	assert.EqualError(t, err, `unknown model alias "unknown"`)
+}
+
+func TestValidate_ModelAliases(t *testing.T) {
+	settings := DefaultModelSettings()
+	settings.Models = map[string]string{"platform": "../platform"}
+	assert.NoError(t, settings.Validate())
+
+	settings.Models = map[string]string{"1st": "../platform"}
+	assert.ErrorContains(t, settings.Validate(), `invalid model alias "1st"`)
+
+	settings.Models = map[string]string{"platform": " "}
+	assert.ErrorContains(t, settings.Validate(), `model alias "platform" has no path`)
+}
+
+func TestCheckReferences(t *testing.T) {
+	settings := DefaultModelSettings()
+	settings.Models = map[string]string{"platform": "../platform"}
+	decisions := []Decision{
+		{ID: "0002", Links: Links{Custom: map[string][]string{"relates to": {"billing:0001"}}}},
+		{ID: "0001", Links: Links{Custom: map[string][]string{"depends on": {"platform:0012", "platform:0099"}}}},
+	}
+	exists := func(modelPath, id string) bool {
+		return modelPath == filepath.Join("model", "..", "platform") && id == "0012"
+	}
+
+	problems := settings.CheckReferences("model", decisions, exists)
+
+	assert.Equal(t, []string{
+		`0001: a "depends on" link to platform:0099, which does not exist in platform`,
+		`0002: a "relates to" link to billing:0001 of the undeclared model alias "billing"`,
+	}, problemTexts(problems))
+}
+
+func TestCheckLinks_IgnoresReferences(t *testing.T) {
+	decisions := []Decision{
+		{ID: "0001", Links: Links{Custom: map[string][]string{"relates to": {"platform:0012"}}}},
+	}
+
+	assert.Empty(t, DefaultModelSettings().CheckLinks(decisions))
+}
@@ -0,0 +1,143 @@
+package decision
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Orders in which renumbering assigns the new IDs.
+const (
+	RenumberByID      = "id"
+	RenumberByCreated = "created"
+)
+
+// IDChange is the new ID of a decision.
+type IDChange struct {
+	From  string
+	To    string
+	Title string
+}
+
+// PlanRenumbering assigns new IDs to the decisions. Without a mapping, the decisions are numbered
+// contiguously with the ID scheme in the given order, skipping the reserved IDs of archived
+// decisions. A mapping assigns the given IDs and keeps all others. Only changed IDs are returned.
+func PlanRenumbering(decisions []Decision, reserved []string, scheme IDScheme, order string, mapping map[string]string) ([]IDChange, error) {
+	sorted := slices.Clone(decisions)
+	switch order {
+	case "", RenumberByID:
+		sort.SliceStable(sorted, func(i, j int) bool { return CompareIDs(sorted[i].ID, sorted[j].ID) < 0 })
+	case RenumberByCreated:
+		sort.SliceStable(sorted, func(i, j int) bool {
+			if sorted[i].Created != sorted[j].Created {
+				return sorted[i].Created < sorted[j].Created
+			}
+			return CompareIDs(sorted[i].ID, sorted[j].ID) < 0
+		})
+	default:
+		return nil, fmt.Errorf("unknown order %q, use %s or %s", order, RenumberByID, RenumberByCreated)
+	}
+
+	newIDs := make(map[string]string, len(sorted))
+	if len(mapping) > 0 {
+		for from, to := range mapping {
+			if !slices.ContainsFunc(sorted, func(d Decision) bool { return d.ID == from }) {
+				return nil, fmt.Errorf("decision %s does not exist", from)
+			}
+			if !IsDecisionID(to) {
+				return nil, fmt.Errorf("%q is not a valid decision ID", to)
+			}
+		}
+		for _, d := range sorted {
+			newIDs[d.ID] = d.ID
+			if to, ok := mapping[d.ID]; ok {
+				newIDs[d.ID] = to
+			}
+		}
+	} else {
+		if !scheme.Sequential() {
+			return nil, fmt.Errorf("only sequential IDs can be renumbered contiguously, map the IDs explicitly instead")
+		}
+		number := 1
+		for _, d := range sorted {
+			for slices.Contains(reserved, scheme.Format(number)) {
+				number++
+			}
+			newIDs[d.ID] = scheme.Format(number)
+			number++
+		}
+	}
+
+	owners := make(map[string]string, len(newIDs))
+	var changes []IDChange
+	for _, d := range sorted {
+		to := newIDs[d.ID]
+		if other, taken := owners[to]; taken {
+			return nil, fmt.Errorf("decisions %s and %s would both get the ID %s", other, d.ID, to)
+		}
+		if slices.Contains(reserved, to) {
+			return nil, fmt.Errorf("ID %s is used by an archived decision", to)
+		}
+		owners[to] = d.ID
+		if to != d.ID {
+			changes = append(changes, IDChange{From: d.ID, To: to, Title: d.Title})
+		}
+	}
+	return changes, nil
+}
+
+// ParseIDMapping parses a mapping such as 0007=0003,0009=0004.
+func ParseIDMapping(values []string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	for _, value := range values {
+		for _, pair := range strings.Split(value, ",") {
+			from, to, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+			if !ok || from == "" || to == "" {
+				return nil, fmt.Errorf("invalid ID mapping %q, use <old>=<new>", pair)
+			}
+			if _, duplicate := mapping[from]; duplicate {
+				return nil, fmt.Errorf("ID %s is mapped more than once", from)
+			}
+			mapping[from] = to
+		}
+	}
+	return mapping, nil
+}
+
+// renumberLinks replaces the IDs in the links of a decision.
+func renumberLinks(links Links, ids map[string]string) Links {
+	return mapLinkTargets(links, func(tag, id string) []string {
+		if to, ok := ids[id]; ok {
+			return []string{to}
+		}
+		return []string{id}
+	})
+}
+
+// mapLinkTargets returns a copy of the links with every target replaced by the IDs returned by
+// replace. Duplicate IDs within a link type are dropped.
+func mapLinkTargets(links Links, replace func(tag, id string) []string) Links {
+	mapTargets := func(tag string, targets []string) []string {
+		if targets == nil {
+			return nil
+		}
+		mapped := make([]string, 0, len(targets))
+		for _, id := range targets {
+			for _, to := range replace(tag, id) {
+				mapped = appendUnique(mapped, to)
+			}
+		}
+		return mapped
+	}
+
+	result := Links{Precedes: mapTargets("precedes", links.Precedes), Succeeds: mapTargets("succeeds", links.Succeeds)}
+	if links.Custom != nil {
+		result.Custom = make(map[string][]string, len(links.Custom))
+		for tag, targets := range links.Custom {
+			result.Custom[tag] = mapTargets(tag, targets)
+		}
+	}
+	return result
+}
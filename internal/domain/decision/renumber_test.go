@@ -0,0 +1,124 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanRenumbering_ClosesGaps(t *testing.T) {
+	decisions := []Decision{
+		{ID: "0007", Title: "C"},
+		{ID: "0002", Title: "A"},
+		{ID: "0004", Title: "B"},
+	}
+
+	changes, err := PlanRenumbering(decisions, nil, IDScheme{}, RenumberByID, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []IDChange{
+		{From: "0002", To: "0001", Title: "A"},
+		{From: "0004", To: "0002", Title: "B"},
+		{From: "0007", To: "0003", Title: "C"},
+	}, changes)
+}
+
+func TestPlanRenumbering_SkipsReservedIDs(t *testing.T) {
+	decisions := []Decision{{ID: "0003", Title: "A"}, {ID: "0005", Title: "B"}}
+
+	changes, err := PlanRenumbering(decisions, []string{"0001", "0004"}, IDScheme{}, RenumberByID, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []IDChange{{From: "0003", To: "0002", Title: "A"}, {From: "0005", To: "0003", Title: "B"}}, changes)
+}
+
+func TestPlanRenumbering_ByCreatedWithScheme(t *testing.T) {
+	decisions := []Decision{
+		{ID: "0001", Title: "Late", Created: "2026-03-01"},
+		{ID: "0002", Title: "Early", Created: "2026-01-01"},
+	}
+
+	changes, err := PlanRenumbering(decisions, nil, IDScheme{Prefix: "SEC-", Width: 3}, RenumberByCreated, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []IDChange{
+		{From: "0002", To: "SEC-001", Title: "Early"},
+		{From: "0001", To: "SEC-002", Title: "Late"},
+	}, changes)
+}
+
+func TestPlanRenumbering_AlreadyContiguous(t *testing.T) {
+	changes, err := PlanRenumbering([]Decision{{ID: "0001"}, {ID: "0002"}}, nil, IDScheme{}, "", nil)
+
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestPlanRenumbering_MappingSwapsIDs(t *testing.T) {
+	decisions := []Decision{{ID: "0001", Title: "A"}, {ID: "0002", Title: "B"}, {ID: "0003", Title: "C"}}
+
+	changes, err := PlanRenumbering(decisions, nil, IDScheme{}, RenumberByID, map[string]string{"0001": "0002", "0002": "0001"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []IDChange{{From: "0001", To: "0002", Title: "A"}, {From: "0002", To: "0001", Title: "B"}}, changes)
+}
+
+func TestPlanRenumbering_MappingWithTimestampIDs(t *testing.T) {
+	decisions := []Decision{{ID: "20261018080530kqfz", Title: "A"}}
+	scheme := IDScheme{Strategy: IDStrategyTimestamp}
+
+	_, err := PlanRenumbering(decisions, nil, scheme, RenumberByID, nil)
+	assert.ErrorContains(t, err, "only sequential IDs can be renumbered contiguously")
+
+	changes, err := PlanRenumbering(decisions, nil, scheme, RenumberByID, map[string]string{"20261018080530kqfz": "0001"})
+	assert.NoError(t, err)
+	assert.Equal(t, []IDChange{{From: "20261018080530kqfz", To: "0001", Title: "A"}}, changes)
+}
+
+func TestPlanRenumbering_Errors(t *testing.T) {
+	decisions := []Decision{{ID: "0001"}, {ID: "0002"}}
+
+	_, err := PlanRenumbering(decisions, nil, IDScheme{}, "title", nil)
+	assert.ErrorContains(t, err, `unknown order "title"`)
+
+	_, err = PlanRenumbering(decisions, nil, IDScheme{}, RenumberByID, map[string]string{"0009": "0003"})
+	assert.EqualError(t, err, "decision 0009 does not exist")
+
+	_, err = PlanRenumbering(decisions, nil, IDScheme{}, RenumberByID, map[string]string{"0001": "first"})
+	assert.EqualError(t, err, `"first" is not a valid decision ID`)
+
+	_, err = PlanRenumbering(decisions, nil, IDScheme{}, RenumberByID, map[string]string{"0001": "0002"})
+	assert.EqualError(t, err, "decisions 0001 and 0002 would both get the ID 0002")
+
+	_, err = PlanRenumbering(decisions, []string{"0005"}, IDScheme{}, RenumberByID, map[string]string{"0001": "0005"})
+	assert.EqualError(t, err, "ID 0005 is used by an archived decision")
+}
+
+func TestParseIDMapping(t *testing.T) {
+	mapping, err := ParseIDMapping([]string{"0007=0003, 0009=0004", "0011=0005"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"0007": "0003", "0009": "0004", "0011": "0005"}, mapping)
+
+	_, err = ParseIDMapping([]string{"0007"})
+	assert.EqualError(t, err, `invalid ID mapping "0007", use <old>=<new>`)
+
+	_, err = ParseIDMapping([]string{"0007=0003", "0007=0004"})
+	assert.EqualError(t, err, "ID 0007 is mapped more than once")
+}
+
+func TestRenumberLinks(t *testing.T) {
+	links := Links{
+		Precedes: []string{"0003"},
+		Succeeds: []string{"0001"},
+		Custom:   map[string][]string{"relates": {"0003", "platform:0003"}},
+	}
+
+	renumbered := renumberLinks(links, map[string]string{"0003": "0002"})
+
+	assert.Equal(t, Links{
+		Precedes: []string{"0002"},
+		Succeeds: []string{"0001"},
+		Custom:   map[string][]string{"relates": {"0002", "platform:0003"}},
+	}, renumbered)
+	assert.Equal(t, []string{"0003"}, links.Precedes)
+}
@@ -4,6 +4,11 @@ type DecisionRepository interface {
 	Create(modelPath, subFolderPath string, decision *Decision, decisionContent *DecisionContent) (*Decision, error)
 	Save(modelPath string, decision *Decision) error
 	Copy(srcPath, dstPath, decisionID string) error
+	Rename(modelPath string, decision *Decision) error
+	Renumber(modelPath string, ids map[string]string, decisions []Decision) error
+	MoveDecisionFile(modelPath, filePath string, decision *Decision) error
+	Delete(modelPath, decisionID string) error
+	Archive(modelPath, decisionID string) error
 	LoadById(modelPath, id string) (*Decision, error)
 	LoadByTitle(modelPath, title string) (*Decision, error)
 	LoadAllByIndex(modelPath string) ([]Decision, error)
@@ -11,9 +16,16 @@ type DecisionRepository interface {
 	LoadDecisionContentRaw(modelPath, decisionID string) (string, error)
 	LoadDecisionContent(modelPath, decisionID string) (*DecisionContent, error)
 	UpdateSection(modelPath, decisionID, anchorName string, lines []string) error
-	AppendCommentSection(modelPath, decisionID, commentText string, commentNumber int, author, date string) error
+	RemoveSection(modelPath, decisionID, anchorName string) error
 	AppendOutcomeSection(modelPath, decisionID, outcome string) error
+	SetNotice(modelPath, decisionID, anchorName string, lines []string) error
 	OptionExists(modelPath, decisionID, option string) (bool, error)
 	ResolveOptionNumber(modelPath, decisionID, option string) (int, error)
 	FindDecisionFile(modelPath, decisionID string) (string, error)
+	LoadDecisionFiles(modelPath, decisionID string) ([]DecisionFile, error)
+	ExistingIDs(modelPath string) ([]string, error)
+	LoadSettings(modelPath string) (*ModelSettings, error)
+	DecisionFileStamps(modelPath string) (map[string]string, error)
+	LoadSearchIndex(modelPath string) (*SearchIndex, error)
+	SaveSearchIndex(modelPath string, index *SearchIndex) error
 }
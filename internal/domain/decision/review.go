@@ -0,0 +1,68 @@
+package decision
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Results of a review of a decided decision.
+const (
+	ReviewStillValid    = "still-valid"
+	ReviewNeedsRevision = "needs-revision"
+)
+
+var reviewResults = []string{ReviewStillValid, ReviewNeedsRevision}
+
+var reviewIntervalPattern = regexp.MustCompile(`^(\d+)([dwmy])$`)
+
+// DueDecision is a decided decision whose review date has passed or is coming up.
+// DaysLeft is negative when the review is overdue.
+type DueDecision struct {
+	Decision Decision
+	DaysLeft int
+}
+
+func validateReviewResult(result string) error {
+	if !slices.Contains(reviewResults, result) {
+		return fmt.Errorf("invalid review result %q (allowed: %s)", result, strings.Join(reviewResults, ", "))
+	}
+	return nil
+}
+
+func validateReviewInterval(interval string) error {
+	if interval != "" && !reviewIntervalPattern.MatchString(interval) {
+		return fmt.Errorf("invalid review_interval %q, expected a number followed by d, w, m or y (e.g. 6m)", interval)
+	}
+	return nil
+}
+
+// addReviewInterval returns the date the interval, e.g. 90d, 12w, 6m or 1y, after the given date.
+func addReviewInterval(from time.Time, interval string) string {
+	m := reviewIntervalPattern.FindStringSubmatch(interval)
+	n, _ := strconv.Atoi(m[1])
+	switch m[2] {
+	case "d":
+		from = from.AddDate(0, 0, n)
+	case "w":
+		from = from.AddDate(0, 0, 7*n)
+	case "m":
+		from = from.AddDate(0, n, 0)
+	case "y":
+		from = from.AddDate(n, 0, 0)
+	}
+	return from.Format(DateLayout)
+}
+
+// daysUntil returns the number of days from today until the given date.
+func daysUntil(date string) (int, error) {
+	due, err := time.Parse(DateLayout, date)
+	if err != nil {
+		return 0, err
+	}
+	now, _ := time.Parse(DateLayout, today())
+	return int(due.Sub(now).Hours() / 24), nil
+}
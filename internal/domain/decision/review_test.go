@@ -0,0 +1,36 @@
+package decision
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddReviewInterval(t *testing.T) {
+	from := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "2025-05-01", addReviewInterval(from, "90d"))
+	assert.Equal(t, "2025-02-14", addReviewInterval(from, "2w"))
+	assert.Equal(t, "2025-07-31", addReviewInterval(from, "6m"))
+	assert.Equal(t, "2026-01-31", addReviewInterval(from, "1y"))
+}
+
+func TestValidateReviewInterval(t *testing.T) {
+	assert.NoError(t, validateReviewInterval(""))
+	assert.NoError(t, validateReviewInterval("12m"))
+	assert.EqualError(t, validateReviewInterval("6 months"), `invalid review_interval "6 months", expected a number followed by d, w, m or y (e.g. 6m)`)
+}
+
+func TestValidateReviewResult(t *testing.T) {
+	assert.NoError(t, validateReviewResult(ReviewStillValid))
+	assert.NoError(t, validateReviewResult(ReviewNeedsRevision))
+	assert.EqualError(t, validateReviewResult("ok"), `invalid review result "ok" (allowed: still-valid, needs-revision)`)
+}
+
+func TestModelSettingsValidate_ReviewInterval(t *testing.T) {
+	settings := DefaultModelSettings()
+	settings.ReviewInterval = "soon"
+
+	assert.Error(t, settings.Validate())
+}
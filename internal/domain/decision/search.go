@@ -0,0 +1,323 @@
+package decision
+
+import (
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// SearchIndexFile is the name of the search cache located next to the index. It is rebuilt
+// when missing and can be deleted at any time.
+const SearchIndexFile = ".search-index.yaml"
+
+// searchIndexVersion is increased whenever the way documents are indexed changes, so that
+// caches written by older versions are rebuilt.
+const searchIndexVersion = 1
+
+const snippetRadius = 60
+
+var (
+	searchAnchorPattern  = regexp.MustCompile(`<a name="[^"]*"></a>`)
+	searchCommentPattern = regexp.MustCompile(`(?s)<!--.*?-->`)
+	searchLinkPattern    = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+)
+
+// sectionWeights boosts matches in the sections that describe a decision best.
+var sectionWeights = map[string]float64{
+	"title":    3,
+	"question": 2,
+	"outcome":  2,
+}
+
+// SearchIndex caches the searchable text of the decisions of a model. Stamp identifies the
+// version of the decision file a document was built from.
+type SearchIndex struct {
+	Version   int                       `yaml:"version"`
+	Documents map[string]SearchDocument `yaml:"documents"`
+}
+
+type SearchDocument struct {
+	Stamp    string          `yaml:"stamp"`
+	Title    string          `yaml:"title"`
+	Status   string          `yaml:"status"`
+	Tags     []string        `yaml:"tags,omitempty"`
+	Sections []SearchSection `yaml:"sections"`
+}
+
+// SearchSection holds the plain text of a section and how often each term occurs in it.
+type SearchSection struct {
+	Anchor string         `yaml:"anchor"`
+	Title  string         `yaml:"title"`
+	Text   string         `yaml:"text"`
+	Terms  map[string]int `yaml:"terms"`
+}
+
+type SearchResult struct {
+	ID     string
+	Title  string
+	Status string
+	Score  float64
+	Hits   []SearchHit
+}
+
+// SearchHit is a snippet of a matching section. Highlights are the byte ranges of the matched
+// terms within the snippet.
+type SearchHit struct {
+	Anchor     string
+	Section    string
+	Snippet    string
+	Highlights [][2]int
+}
+
+// SearchQuery restricts a search to the given sections and to decisions with any of the tags.
+type SearchQuery struct {
+	Terms    []string
+	Sections []string
+	Tags     []string
+}
+
+// NewSearchDocument extracts the searchable text of a decision.
+func NewSearchDocument(d Decision, content *DecisionContent, stamp string) SearchDocument {
+	doc := SearchDocument{Stamp: stamp, Title: d.Title, Status: d.Status, Tags: d.Tags}
+	for _, s := range content.OrderedSections() {
+		text := plainText(s.Body)
+		if text == "" {
+			continue
+		}
+		title := s.Title
+		if title == "" {
+			title = s.Anchor
+		}
+		doc.Sections = append(doc.Sections, SearchSection{Anchor: s.Anchor, Title: title, Text: text, Terms: countTerms(text)})
+	}
+	return doc
+}
+
+// plainText strips anchors, markdown links and HTML comments and collapses whitespace.
+func plainText(body string) string {
+	text := searchCommentPattern.ReplaceAllString(body, "")
+	text = searchAnchorPattern.ReplaceAllString(text, "")
+	text = searchLinkPattern.ReplaceAllString(text, "$1")
+	return strings.Join(strings.Fields(text), " ")
+}
+
+type termPosition struct {
+	term       string
+	start, end int
+}
+
+func isTermRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// tokenize splits text into lowercase terms of letters and digits with their byte positions.
+func tokenize(text string) []termPosition {
+	var terms []termPosition
+	start := -1
+	for i, r := range text {
+		if isTermRune(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			terms = append(terms, termPosition{term: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		terms = append(terms, termPosition{term: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+	return terms
+}
+
+func countTerms(text string) map[string]int {
+	counts := make(map[string]int)
+	for _, t := range tokenize(text) {
+		counts[t.term]++
+	}
+	return counts
+}
+
+// searchTerm is a normalized query term. A trailing * in the query matches terms by prefix.
+type searchTerm struct {
+	text   string
+	prefix bool
+}
+
+func (t searchTerm) matches(term string) bool {
+	if t.prefix {
+		return strings.HasPrefix(term, t.text)
+	}
+	return term == t.text
+}
+
+func (t searchTerm) count(terms map[string]int) int {
+	if !t.prefix {
+		return terms[t.text]
+	}
+	n := 0
+	for term, c := range terms {
+		if t.matches(term) {
+			n += c
+		}
+	}
+	return n
+}
+
+func parseSearchTerms(raw []string) []searchTerm {
+	var terms []searchTerm
+	for _, r := range raw {
+		for _, word := range strings.Fields(r) {
+			tokens := tokenize(word)
+			for i, t := range tokens {
+				term := searchTerm{text: t.term, prefix: i == len(tokens)-1 && strings.HasSuffix(word, "*")}
+				if !slices.Contains(terms, term) {
+					terms = append(terms, term)
+				}
+			}
+		}
+	}
+	return terms
+}
+
+// Search ranks the documents containing every term, weighting the number of occurrences by the
+// rarity of a term across the documents and by the section it occurs in.
+func (idx *SearchIndex) Search(query SearchQuery) []SearchResult {
+	terms := parseSearchTerms(query.Terms)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	type candidate struct {
+		id     string
+		doc    SearchDocument
+		counts []map[string]int // per term: section anchor -> occurrences
+	}
+
+	var candidates []candidate
+	docFreq := make([]int, len(terms))
+	considered := 0
+	for id, doc := range idx.Documents {
+		if len(query.Tags) > 0 && !slices.ContainsFunc(doc.Tags, func(tag string) bool { return slices.Contains(query.Tags, tag) }) {
+			continue
+		}
+		considered++
+
+		c := candidate{id: id, doc: doc, counts: make([]map[string]int, len(terms))}
+		titleTerms := countTerms(doc.Title)
+		matchesAll := true
+		for i, term := range terms {
+			c.counts[i] = make(map[string]int)
+			if len(query.Sections) == 0 {
+				if n := term.count(titleTerms); n > 0 {
+					c.counts[i]["title"] = n
+				}
+			}
+			for _, s := range doc.Sections {
+				if len(query.Sections) > 0 && !slices.Contains(query.Sections, s.Anchor) {
+					continue
+				}
+				if n := term.count(s.Terms); n > 0 {
+					c.counts[i][s.Anchor] += n
+				}
+			}
+			if len(c.counts[i]) == 0 {
+				matchesAll = false
+			} else {
+				docFreq[i]++
+			}
+		}
+		if matchesAll {
+			candidates = append(candidates, c)
+		}
+	}
+
+	var results []SearchResult
+	for _, c := range candidates {
+		result := SearchResult{ID: c.id, Title: c.doc.Title, Status: c.doc.Status}
+		for i, counts := range c.counts {
+			idf := math.Log(1 + float64(considered)/float64(docFreq[i]))
+			for anchor, n := range counts {
+				weight, ok := sectionWeights[anchor]
+				if !ok {
+					weight = 1
+				}
+				result.Score += weight * (1 + math.Log(float64(n))) * idf
+			}
+		}
+		for _, s := range c.doc.Sections {
+			if len(query.Sections) > 0 && !slices.Contains(query.Sections, s.Anchor) {
+				continue
+			}
+			if hit, ok := snippet(s, terms); ok {
+				result.Hits = append(result.Hits, hit)
+			}
+		}
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].ID < results[j].ID
+	})
+	return results
+}
+
+// snippet cuts the text around the first matching term of a section and marks all matches in it.
+func snippet(s SearchSection, terms []searchTerm) (SearchHit, bool) {
+	var matches []termPosition
+	for _, t := range tokenize(s.Text) {
+		if slices.ContainsFunc(terms, func(term searchTerm) bool { return term.matches(t.term) }) {
+			matches = append(matches, t)
+		}
+	}
+	if len(matches) == 0 {
+		return SearchHit{}, false
+	}
+
+	start := wordBoundary(s.Text, matches[0].start-snippetRadius, false)
+	end := wordBoundary(s.Text, matches[0].end+snippetRadius, true)
+
+	prefix, suffix := "", ""
+	if start > 0 {
+		prefix = "..."
+	}
+	if end < len(s.Text) {
+		suffix = "..."
+	}
+
+	hit := SearchHit{Anchor: s.Anchor, Section: s.Title, Snippet: prefix + s.Text[start:end] + suffix}
+	for _, m := range matches {
+		if m.start >= start && m.end <= end {
+			hit.Highlights = append(hit.Highlights, [2]int{m.start - start + len(prefix), m.end - start + len(prefix)})
+		}
+	}
+	return hit, true
+}
+
+// wordBoundary moves pos to the closest space so that snippets do not cut words.
+func wordBoundary(text string, pos int, forward bool) int {
+	if pos <= 0 {
+		return 0
+	}
+	if pos >= len(text) {
+		return len(text)
+	}
+	if forward {
+		if i := strings.IndexByte(text[pos:], ' '); i >= 0 {
+			return pos + i
+		}
+		return len(text)
+	}
+	if i := strings.LastIndexByte(text[:pos], ' '); i >= 0 {
+		return i + 1
+	}
+	return 0
+}
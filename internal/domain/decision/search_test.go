@@ -0,0 +1,84 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func searchTestIndex() *SearchIndex {
+	broker := NewSearchDocument(Decision{Title: "Message broker", Status: "decided", Tags: []string{"backend"}}, &DecisionContent{
+		Question: "Which message broker carries our domain events?",
+		Options:  "1. <a name=\"option-1\"></a> Kafka\n2. <a name=\"option-2\"></a> RabbitMQ",
+		Outcome:  "We decided for [Option 1](#option-1) because: Kafka scales with the number of events.",
+	}, "1")
+	auth := NewSearchDocument(Decision{Title: "Authentication", Status: "open", Tags: []string{"security"}}, &DecisionContent{
+		Question: "How do services authenticate? Tokens are sent along with Kafka events.",
+		Comments: "<!-- generated -->\n1. (2025-01-01) Jane: authorization is out of scope",
+	}, "2")
+	return &SearchIndex{Version: searchIndexVersion, Documents: map[string]SearchDocument{"0001": broker, "0002": auth}}
+}
+
+func TestNewSearchDocument(t *testing.T) {
+	doc := searchTestIndex().Documents["0001"]
+
+	assert.Equal(t, "1", doc.Stamp)
+	assert.Len(t, doc.Sections, 3)
+	assert.Equal(t, "options", doc.Sections[1].Anchor)
+	assert.Equal(t, "1. Kafka 2. RabbitMQ", doc.Sections[1].Text)
+	assert.Equal(t, "We decided for Option 1 because: Kafka scales with the number of events.", doc.Sections[2].Text)
+	assert.Equal(t, 1, doc.Sections[2].Terms["kafka"])
+}
+
+func TestSearch_RanksAndRequiresAllTerms(t *testing.T) {
+	index := searchTestIndex()
+
+	results := index.Search(SearchQuery{Terms: []string{"Kafka"}})
+	assert.Len(t, results, 2)
+	assert.Equal(t, "0001", results[0].ID)
+	assert.Greater(t, results[0].Score, results[1].Score)
+	assert.Len(t, results[0].Hits, 2)
+	assert.Equal(t, "options", results[0].Hits[0].Section)
+
+	results = index.Search(SearchQuery{Terms: []string{"kafka tokens"}})
+	assert.Len(t, results, 1)
+	assert.Equal(t, "0002", results[0].ID)
+
+	assert.Empty(t, index.Search(SearchQuery{Terms: []string{"kaf"}}))
+	assert.Empty(t, index.Search(SearchQuery{Terms: []string{" "}}))
+}
+
+func TestSearch_PrefixSectionsAndTags(t *testing.T) {
+	index := searchTestIndex()
+
+	results := index.Search(SearchQuery{Terms: []string{"auth*"}})
+	assert.Len(t, results, 1)
+	assert.Equal(t, "0002", results[0].ID)
+	assert.Len(t, results[0].Hits, 2)
+
+	results = index.Search(SearchQuery{Terms: []string{"kafka"}, Sections: []string{"outcome"}})
+	assert.Len(t, results, 1)
+	assert.Equal(t, "0001", results[0].ID)
+	assert.Len(t, results[0].Hits, 1)
+
+	results = index.Search(SearchQuery{Terms: []string{"kafka"}, Tags: []string{"security"}})
+	assert.Len(t, results, 1)
+	assert.Equal(t, "0002", results[0].ID)
+}
+
+func TestSnippet(t *testing.T) {
+	section := SearchSection{
+		Anchor: "question",
+		Title:  "Question",
+		Text:   "We need a way to exchange events between many services and the broker must survive the loss of a whole data center without losing events.",
+	}
+
+	hit, ok := snippet(section, []searchTerm{{text: "loss"}})
+	assert.True(t, ok)
+	assert.Equal(t, "...events between many services and the broker must survive the loss of a whole data center without losing events.", hit.Snippet)
+	assert.Equal(t, [][2]int{{64, 68}}, hit.Highlights)
+	assert.Equal(t, "loss", hit.Snippet[64:68])
+
+	_, ok = snippet(section, []searchTerm{{text: "kafka"}})
+	assert.False(t, ok)
+}
@@ -0,0 +1,153 @@
+package decision
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/adr/ad-guidance-tool/internal/domain"
+)
+
+var sectionAnchorPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// knownSections are the sections with a dedicated field in DecisionContent, in their default order.
+var knownSections = []string{
+	domain.AnchorSectionQuestion,
+	domain.AnchorSectionOptions,
+	domain.AnchorSectionCriteria,
+	domain.AnchorSectionDecisionMatrix,
+	domain.AnchorSectionOutcome,
+	domain.AnchorSectionPreviousOutcomes,
+	domain.AnchorSectionComments,
+}
+
+// requiredSections are always written, the other known sections only when they have content.
+var requiredSections = []string{
+	domain.AnchorSectionQuestion,
+	domain.AnchorSectionOptions,
+	domain.AnchorSectionCriteria,
+}
+
+// managedSections are maintained by dedicated commands and cannot be edited with --section.
+var managedSections = []string{
+	domain.AnchorSectionOptions,
+	domain.AnchorSectionDecisionMatrix,
+	domain.AnchorSectionOutcome,
+	domain.AnchorSectionPreviousOutcomes,
+	domain.AnchorSectionComments,
+}
+
+// Section is a level two section of a decision file, identified by its anchor name.
+// Title is the header text without the anchor.
+type Section struct {
+	Anchor string
+	Title  string
+	Body   string
+}
+
+// SectionEdit appends text to a section or replaces its content. Missing sections are created.
+type SectionEdit struct {
+	Anchor  string
+	Text    string
+	Replace bool
+}
+
+// IsKnownSection reports whether the section has a dedicated field in DecisionContent.
+func IsKnownSection(anchor string) bool {
+	return slices.Contains(knownSections, anchor)
+}
+
+// Section returns the content of the section with the given anchor.
+func (c *DecisionContent) Section(anchor string) string {
+	if field := c.knownField(anchor); field != nil {
+		return *field
+	}
+	for _, s := range c.Sections {
+		if s.Anchor == anchor {
+			return s.Body
+		}
+	}
+	return ""
+}
+
+func (c *DecisionContent) knownField(anchor string) *string {
+	switch anchor {
+	case domain.AnchorSectionQuestion:
+		return &c.Question
+	case domain.AnchorSectionOptions:
+		return &c.Options
+	case domain.AnchorSectionCriteria:
+		return &c.Criteria
+	case domain.AnchorSectionDecisionMatrix:
+		return &c.DecisionMatrix
+	case domain.AnchorSectionOutcome:
+		return &c.Outcome
+	case domain.AnchorSectionPreviousOutcomes:
+		return &c.PreviousOutcomes
+	case domain.AnchorSectionComments:
+		return &c.Comments
+	default:
+		return nil
+	}
+}
+
+// OrderedSections returns all sections in the order they are written to a file. The content of
+// known sections is taken from their fields, known sections missing from Sections are inserted
+// at their default position and empty optional sections are left out.
+func (c *DecisionContent) OrderedSections() []Section {
+	var result []Section
+	for _, s := range c.Sections {
+		if field := c.knownField(s.Anchor); field != nil {
+			s.Body = *field
+		}
+		result = append(result, s)
+	}
+
+	for i, anchor := range knownSections {
+		if slices.ContainsFunc(result, func(s Section) bool { return s.Anchor == anchor }) {
+			continue
+		}
+		// insert before the first known section that follows in the default order
+		at := len(result)
+		for j, s := range result {
+			if slices.Contains(knownSections[i+1:], s.Anchor) {
+				at = j
+				break
+			}
+		}
+		result = slices.Insert(result, at, Section{Anchor: anchor, Body: *c.knownField(anchor)})
+	}
+
+	return slices.DeleteFunc(result, func(s Section) bool {
+		return IsKnownSection(s.Anchor) && !slices.Contains(requiredSections, s.Anchor) && strings.TrimSpace(s.Body) == ""
+	})
+}
+
+func validateSectionEdit(anchor string) error {
+	if !sectionAnchorPattern.MatchString(anchor) {
+		return fmt.Errorf("invalid section anchor %q, use lowercase letters, digits and dashes", anchor)
+	}
+	if slices.Contains(managedSections, anchor) {
+		return fmt.Errorf("section %q is maintained by adg and cannot be edited with --section", anchor)
+	}
+	return nil
+}
+
+// SectionAnchorFromTitle derives the anchor of a section header without an anchor, e.g. "Pros and Cons" becomes "pros-and-cons".
+func SectionAnchorFromTitle(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash && b.Len() > 0 {
+				b.WriteRune('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+	return b.String()
+}
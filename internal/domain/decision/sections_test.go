@@ -0,0 +1,64 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedSections_KeepsCustomSectionsInPlace(t *testing.T) {
+	content := &DecisionContent{
+		Question: "Which database?",
+		Options:  "1. A",
+		Outcome:  "",
+		Sections: []Section{
+			{Anchor: "question", Title: "Context", Body: "old"},
+			{Anchor: "assumptions", Title: "Assumptions", Body: "Low load"},
+			{Anchor: "options", Title: "Considered Options", Body: "old"},
+			{Anchor: "outcome", Title: "Outcome", Body: "old outcome"},
+			{Anchor: "consequences", Title: "Consequences", Body: "Backups needed"},
+		},
+	}
+	content.Comments = "1. first"
+
+	sections := content.OrderedSections()
+
+	var anchors []string
+	for _, s := range sections {
+		anchors = append(anchors, s.Anchor)
+	}
+	assert.Equal(t, []string{"question", "assumptions", "options", "criteria", "consequences", "comments"}, anchors)
+	assert.Equal(t, Section{Anchor: "question", Title: "Context", Body: "Which database?"}, sections[0])
+	assert.Equal(t, "Backups needed", sections[4].Body)
+}
+
+func TestOrderedSections_WithoutSections(t *testing.T) {
+	content := &DecisionContent{Question: "Q", Outcome: "O"}
+
+	sections := content.OrderedSections()
+
+	assert.Len(t, sections, 4)
+	assert.Equal(t, "outcome", sections[3].Anchor)
+	assert.Equal(t, "O", sections[3].Body)
+}
+
+func TestDecisionContent_Section(t *testing.T) {
+	content := &DecisionContent{Criteria: "fast", Sections: []Section{{Anchor: "consequences", Body: "more work"}}}
+
+	assert.Equal(t, "fast", content.Section("criteria"))
+	assert.Equal(t, "more work", content.Section("consequences"))
+	assert.Equal(t, "", content.Section("assumptions"))
+}
+
+func TestSectionAnchorFromTitle(t *testing.T) {
+	assert.Equal(t, "pros-and-cons-of-the-options", SectionAnchorFromTitle("Pros and Cons of the Options"))
+	assert.Equal(t, "question", SectionAnchorFromTitle("Question"))
+	assert.Equal(t, "more-information", SectionAnchorFromTitle(" More Information! "))
+}
+
+func TestValidateSectionEdit(t *testing.T) {
+	assert.NoError(t, validateSectionEdit("consequences"))
+	assert.NoError(t, validateSectionEdit("question"))
+	assert.EqualError(t, validateSectionEdit("Consequences"), `invalid section anchor "Consequences", use lowercase letters, digits and dashes`)
+	assert.EqualError(t, validateSectionEdit("outcome"), `section "outcome" is maintained by adg and cannot be edited with --section`)
+}
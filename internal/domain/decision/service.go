@@ -4,9 +4,11 @@ import (
 	"github.com/adr/ad-guidance-tool/internal/domain"
 	"errors"
 	"fmt"
+	"maps"
 	"path/filepath"
 	"regexp"
 	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -20,14 +22,42 @@ type DecisionService interface {
 	GetDecisionByTitle(modelPath, title string) (*Decision, error)
 	GetDecisionContent(modelPath, decisionID string) (*DecisionContent, error)
 	GetDecisionFilePath(modelPath, decisionID string) (string, error)
-	Edit(modelPath string, decision *Decision, question *string, options *[]string, criteria *string) error
+	Edit(modelPath string, decision *Decision, edit ContentEdit) error
+	EditMetadata(modelPath string, decision *Decision, edit MetadataEdit) error
+	SetFields(modelPath string, decision *Decision, values map[string]string) error
 	Link(modelPath string, source, target *Decision, forwardTag, reverseTag string) error
+	LinkExternal(modelPath string, source *Decision, reference, tag string) error
+	Unlink(modelPath string, source, target *Decision, forwardTag, reverseTag string) error
+	ResolveReference(modelPath, target string) (*Decision, error)
+	ResolveLinks(modelPath string, decision *Decision) []ResolvedLink
 	Tag(modelPath string, decision *Decision, tag string) error
-	FilterDecisions(decisions []Decision, filters map[string][]string) ([]Decision, error)
-	Decide(modelPath string, decision *Decision, option, rationale string, enforceOption bool) error
+	Untag(modelPath string, decision *Decision, tag string) error
+	ReplaceTags(modelPath string, sourceTags []string, targetTag string) ([]string, error)
+	FilterDecisions(modelPath string, decisions []Decision, filters map[string][]string) ([]Decision, error)
+	Search(modelPath string, query SearchQuery) ([]SearchResult, error)
+	Decide(modelPath string, decision *Decision, options []string, rationale, decider, reviewBy string, enforceOption bool) error
+	Review(modelPath string, decision *Decision, result, next string) error
+	DueForReview(modelPath string, within int) ([]DueDecision, error)
+	Next(modelPath string) (Readiness, error)
+	Plan(modelPath string) (Plan, error)
+	Score(modelPath string, decision *Decision, option, criterion string, value int) error
+	RankOptions(modelPath string, decision *Decision) ([]OptionScore, error)
+	RecordRecommendation(modelPath string, decision *Decision, recommended OptionScore) error
 	Revise(modelPath string, original *Decision) (*Decision, error)
+	Reopen(modelPath string, decision *Decision, reason string) error
 	Copy(sourceModelPath, targetPath, decisionID string) error
 	Comment(modelPath string, decision *Decision, author, comment string) error
+	Reply(modelPath string, decision *Decision, parentID int, author, comment string) error
+	EditComment(modelPath string, decision *Decision, commentID int, comment string) error
+	DeleteComment(modelPath string, decision *Decision, commentID int) error
+	GetSettings(modelPath string) (*ModelSettings, error)
+	Transition(modelPath string, decision *Decision, status string) error
+	Supersede(modelPath string, original, replacement *Decision) error
+	Rename(modelPath string, decision *Decision, newTitle string) error
+	Renumber(modelPath, order string, mapping map[string]string, dryRun bool) ([]IDChange, error)
+	ResolveCollisions(modelPath string, dryRun bool) ([]IDChange, []AmbiguousLink, error)
+	Remove(modelPath string, decision *Decision, force bool) ([]string, error)
+	Archive(modelPath string, decision *Decision, force bool) ([]string, error)
 }
 
 type DecisionServiceImplementation struct {
@@ -43,12 +73,18 @@ func (s *DecisionServiceImplementation) AddNew(modelPath, title string) (*Decisi
 		return nil, errors.New("title must contain at least one letter")
 	}
 
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return nil, err
+	}
+
 	decision := &Decision{
 		Title:    title,
-		Status:   "open",
+		Status:   settings.Lifecycle.Initial,
 		Tags:     []string{},
 		Links:    Links{Precedes: []string{}, Succeeds: []string{}},
 		Comments: []Comment{},
+		Created:  today(),
 	}
 
 	content := &DecisionContent{}
@@ -56,17 +92,27 @@ func (s *DecisionServiceImplementation) AddNew(modelPath, title string) (*Decisi
 	return s.repo.Create(modelPath, "", decision, content)
 }
 
+// AddExisting copies a decision into another model. If the target model numbers its IDs
+// sequentially, the numbers of the decision and its links are shifted by increment and formatted
+// with the ID scheme of the target; other IDs are kept.
 func (s *DecisionServiceImplementation) AddExisting(sourceModelPath, targetModelPath string, decision *Decision, content *DecisionContent, increment int) (*Decision, error) {
 	subFolderPath, err := s.getSubFolderPath(sourceModelPath, decision.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	decision.Links.Precedes = adjustIDsBy(decision.Links.Precedes, increment)
-	decision.Links.Succeeds = adjustIDsBy(decision.Links.Succeeds, increment)
+	settings, err := s.repo.LoadSettings(targetModelPath)
+	if err != nil {
+		return nil, err
+	}
+	if scheme := settings.IDs; scheme.Sequential() {
+		decision.ID = scheme.shiftID(decision.ID, increment)
+		decision.Links.Precedes = adjustIDsBy(decision.Links.Precedes, increment, scheme)
+		decision.Links.Succeeds = adjustIDsBy(decision.Links.Succeeds, increment, scheme)
 
-	for tag, ids := range decision.Links.Custom {
-		decision.Links.Custom[tag] = adjustIDsBy(ids, increment)
+		for tag, ids := range decision.Links.Custom {
+			decision.Links.Custom[tag] = adjustIDsBy(ids, increment, scheme)
+		}
 	}
 
 	return s.repo.Create(targetModelPath, subFolderPath, decision, content)
@@ -102,34 +148,111 @@ func (s *DecisionServiceImplementation) GetDecisionFilePath(modelPath, decisionI
 	return s.repo.FindDecisionFile(modelPath, decisionID)
 }
 
-func (s *DecisionServiceImplementation) Edit(modelPath string, decision *Decision, question *string, options *[]string, criteria *string) error {
-	appendOps := []func() error{}
+func (s *DecisionServiceImplementation) Edit(modelPath string, decision *Decision, edit ContentEdit) error {
+	for _, section := range edit.Sections {
+		if err := validateSectionEdit(section.Anchor); err != nil {
+			return err
+		}
+	}
+	for _, anchor := range edit.RemoveSections {
+		if err := validateSectionEdit(anchor); err != nil {
+			return err
+		}
+		if slices.Contains(requiredSections, anchor) {
+			return fmt.Errorf("section %q is required and cannot be removed", anchor)
+		}
+	}
+	if err := s.validateEdit(modelPath, decision.ID, edit); err != nil {
+		return err
+	}
+
+	if edit.Question != nil {
+		if err := s.editSection(modelPath, decision.ID, domain.AnchorSectionQuestion, *edit.Question, edit.ReplaceQuestion); err != nil {
+			return err
+		}
+	}
+	if edit.Criteria != nil {
+		if err := s.editSection(modelPath, decision.ID, domain.AnchorSectionCriteria, *edit.Criteria, edit.ReplaceCriteria); err != nil {
+			return err
+		}
+	}
 
-	if question != nil {
-		q := *question
-		appendOps = append(appendOps, func() error {
-			return s.appendQuestion(modelPath, decision.ID, q)
-		})
+	for _, section := range edit.Sections {
+		if err := s.editNamedSection(modelPath, decision.ID, section); err != nil {
+			return err
+		}
 	}
-	if criteria != nil {
-		c := *criteria
-		appendOps = append(appendOps, func() error {
-			return s.appendCriteria(modelPath, decision.ID, c)
-		})
+	for _, anchor := range edit.RemoveSections {
+		if err := s.repo.RemoveSection(modelPath, decision.ID, anchor); err != nil {
+			return err
+		}
 	}
-	if options != nil {
-		opts := *options
-		appendOps = append(appendOps, func() error {
-			return s.appendOptions(modelPath, decision.ID, opts)
-		})
+
+	if edit.editsCriteria() {
+		if err := s.editCriteria(modelPath, decision, edit); err != nil {
+			return err
+		}
 	}
 
-	for _, op := range appendOps {
-		if err := op(); err != nil {
+	if edit.restructuresOptions() {
+		return s.editOptions(modelPath, decision, edit)
+	}
+	if len(edit.AddOptions) > 0 {
+		if err := s.appendOptions(modelPath, decision.ID, edit.AddOptions); err != nil {
 			return err
 		}
+		return s.refreshDecisionMatrix(modelPath, decision)
+	}
+	return nil
+}
+
+// EditMetadata changes the dates and RACI roles of a decision.
+func (s *DecisionServiceImplementation) EditMetadata(modelPath string, decision *Decision, edit MetadataEdit) error {
+	if err := applyMetadataEdit(decision, edit); err != nil {
+		return err
+	}
+	if err := s.repo.Save(modelPath, decision); err != nil {
+		return fmt.Errorf("failed to save metadata: %w", err)
+	}
+	return nil
+}
+
+// SetFields sets custom fields declared in the model settings, an empty value removes a field.
+func (s *DecisionServiceImplementation) SetFields(modelPath string, decision *Decision, values map[string]string) error {
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return err
+	}
+
+	parsed := make(map[string]any, len(values))
+	for name, raw := range values {
+		def, ok := settings.Fields[name]
+		if !ok {
+			return fmt.Errorf("field %q is not declared in the fields of %s", name, ModelSettingsFile)
+		}
+		if strings.TrimSpace(raw) == "" {
+			if def.Required {
+				return fmt.Errorf("field %q is required and cannot be removed", name)
+			}
+			parsed[name] = nil
+			continue
+		}
+		value, err := def.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("invalid value for field %q: %w", name, err)
+		}
+		parsed[name] = value
 	}
 
+	if decision.Fields == nil {
+		decision.Fields = make(map[string]any)
+	}
+	// removed fields are kept as nil so that saving drops them from the existing frontmatter
+	maps.Copy(decision.Fields, parsed)
+
+	if err := s.repo.Save(modelPath, decision); err != nil {
+		return fmt.Errorf("failed to save fields: %w", err)
+	}
 	return nil
 }
 
@@ -140,6 +263,17 @@ func (s *DecisionServiceImplementation) Link(
 	tag string,
 	reverseTag string,
 ) error {
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return err
+	}
+	reaches := func(fromID, toID, tag string) bool {
+		return s.reaches(modelPath, fromID, toID, tag, make(map[string]bool))
+	}
+	if err := settings.checkLinkType(source, target, tag, reverseTag, reaches); err != nil {
+		return err
+	}
+
 	if source.Links.Custom == nil {
 		source.Links.Custom = make(map[string][]string)
 	}
@@ -148,9 +282,6 @@ func (s *DecisionServiceImplementation) Link(
 	}
 
 	if tag == "precedes" && reverseTag == "succeeds" {
-		if s.wouldCreateCycle(modelPath, source.ID, target.ID) {
-			return fmt.Errorf("linking %s -> %s would create a cycle", source.ID, target.ID)
-		}
 		source.Links.Precedes = append(source.Links.Precedes, target.ID)
 		target.Links.Succeeds = append(target.Links.Succeeds, source.ID)
 	} else {
@@ -165,144 +296,1151 @@ func (s *DecisionServiceImplementation) Link(
 		}
 	}
 
-	if err := s.repo.Save(modelPath, source); err != nil {
-		return fmt.Errorf("failed to save source decision: %w", err)
-	}
-	if err := s.repo.Save(modelPath, target); err != nil {
-		return fmt.Errorf("failed to save target decision: %w", err)
+	if err := s.repo.Save(modelPath, source); err != nil {
+		return fmt.Errorf("failed to save source decision: %w", err)
+	}
+	if err := s.repo.Save(modelPath, target); err != nil {
+		return fmt.Errorf("failed to save target decision: %w", err)
+	}
+
+	return nil
+}
+
+// LinkExternal links a decision to a decision of another model, e.g. platform:0012. The link is
+// only stored on the source, the other model is not changed.
+func (s *DecisionServiceImplementation) LinkExternal(modelPath string, source *Decision, reference, tag string) error {
+	if tag == "" || tag == "precedes" || tag == "succeeds" {
+		return fmt.Errorf("links to decisions of other models need a custom tag")
+	}
+	if _, err := s.ResolveReference(modelPath, reference); err != nil {
+		return err
+	}
+
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return err
+	}
+	if err := settings.checkExternalLinkType(source, tag, reference); err != nil {
+		return err
+	}
+
+	if source.Links.Custom == nil {
+		source.Links.Custom = make(map[string][]string)
+	}
+	if slices.Contains(source.Links.Custom[tag], reference) {
+		return nil
+	}
+	source.Links.Custom[tag] = append(source.Links.Custom[tag], reference)
+
+	if err := s.repo.Save(modelPath, source); err != nil {
+		return fmt.Errorf("failed to save source decision: %w", err)
+	}
+	return nil
+}
+
+// ResolveReference loads the decision a link target points to. Targets of the form <alias>:<id>
+// are looked up in the model the alias is declared for in the model settings.
+func (s *DecisionServiceImplementation) ResolveReference(modelPath, target string) (*Decision, error) {
+	alias, id, ok := ParseReference(target)
+	if !ok {
+		return s.repo.LoadById(modelPath, target)
+	}
+
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return nil, err
+	}
+	path, err := settings.ModelPath(modelPath, alias)
+	if err != nil {
+		return nil, err
+	}
+	decision, err := s.repo.LoadById(path, id)
+	if err != nil {
+		return nil, fmt.Errorf("decision %s not found in model %q: %w", id, alias, err)
+	}
+	return decision, nil
+}
+
+// ResolveLinks returns the links of a decision with the title and status of their targets.
+func (s *DecisionServiceImplementation) ResolveLinks(modelPath string, decision *Decision) []ResolvedLink {
+	var links []ResolvedLink
+	for _, link := range sortedLinks(decision.Links) {
+		resolved := ResolvedLink{Type: link.Type, Target: link.To}
+		resolved.Model, _, _ = ParseReference(link.To)
+		if target, err := s.ResolveReference(modelPath, link.To); err == nil {
+			resolved.Title, resolved.Status = target.Title, target.Status
+		} else {
+			resolved.Missing = true
+		}
+		links = append(links, resolved)
+	}
+	return links
+}
+
+// Unlink removes the link from source to target and its reverse entry. Without tags,
+// every link between the two decisions is removed in both directions.
+func (s *DecisionServiceImplementation) Unlink(
+	modelPath string,
+	source *Decision,
+	target *Decision,
+	tag string,
+	reverseTag string,
+) error {
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return err
+	}
+
+	var removed bool
+	if tag == "" && reverseTag == "" {
+		forward := removeLinksTo(&source.Links, target.ID)
+		reverse := removeLinksTo(&target.Links, source.ID)
+		removed = forward || reverse
+	} else {
+		if tag == "" {
+			tag = settings.InverseLinkTag(reverseTag)
+		}
+		if reverseTag == "" {
+			reverseTag = settings.InverseLinkTag(tag)
+		}
+		forward := removeTaggedLinkTo(&source.Links, tag, target.ID)
+		reverse := removeTaggedLinkTo(&target.Links, reverseTag, source.ID)
+		removed = forward || reverse
+	}
+
+	if !removed {
+		return fmt.Errorf("no matching link found between %s and %s", source.ID, target.ID)
+	}
+
+	if err := s.repo.Save(modelPath, source); err != nil {
+		return fmt.Errorf("failed to save source decision: %w", err)
+	}
+	if err := s.repo.Save(modelPath, target); err != nil {
+		return fmt.Errorf("failed to save target decision: %w", err)
+	}
+
+	return nil
+}
+
+func (s *DecisionServiceImplementation) Tag(modelPath string, decision *Decision, tag string) error {
+	if slices.Contains(decision.Tags, tag) {
+		return fmt.Errorf("tag %q already exists in this decision", tag)
+	}
+
+	decision.Tags = append(decision.Tags, tag)
+
+	if err := s.repo.Save(modelPath, decision); err != nil {
+		return fmt.Errorf("failed to save decision with new tag: %w", err)
+	}
+	return nil
+}
+
+func (s *DecisionServiceImplementation) Untag(modelPath string, decision *Decision, tag string) error {
+	if !slices.Contains(decision.Tags, tag) {
+		return fmt.Errorf("tag %q does not exist in this decision", tag)
+	}
+
+	decision.Tags = slices.DeleteFunc(decision.Tags, func(t string) bool { return t == tag })
+
+	if err := s.repo.Save(modelPath, decision); err != nil {
+		return fmt.Errorf("failed to save decision without tag: %w", err)
+	}
+	return nil
+}
+
+// ReplaceTags replaces the source tags with the target tag in every decision of the model
+// and returns the IDs of the updated decisions. It is used to rename and to merge tags.
+func (s *DecisionServiceImplementation) ReplaceTags(modelPath string, sourceTags []string, targetTag string) ([]string, error) {
+	targetTag = strings.TrimSpace(targetTag)
+	if targetTag == "" {
+		return nil, errors.New("the new tag must not be empty")
+	}
+
+	sources := slices.DeleteFunc(slices.Clone(sourceTags), func(t string) bool { return t == targetTag })
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no tags to replace with %q", targetTag)
+	}
+
+	decisions, err := s.GetAllDecisions(modelPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated []string
+	for i := range decisions {
+		d := &decisions[i]
+		tags, changed := replaceTags(d.Tags, sources, targetTag)
+		if !changed {
+			continue
+		}
+		d.Tags = tags
+		if err := s.repo.Save(modelPath, d); err != nil {
+			return nil, fmt.Errorf("failed to update tags of decision %s: %w", d.ID, err)
+		}
+		updated = append(updated, d.ID)
+	}
+
+	if len(updated) == 0 {
+		return nil, fmt.Errorf("no decision is tagged with %s", quoteAll(sources))
+	}
+
+	sort.Strings(updated)
+	return updated, nil
+}
+
+// FilterDecisions returns the decisions matching all given filters. The values of a single
+// filter are alternatives, e.g. two tags match decisions with either tag. "where" holds query
+// expressions as accepted by ParseQuery, the settings of the model are only loaded to check the
+// link types they refer to.
+func (s *DecisionServiceImplementation) FilterDecisions(modelPath string, decisions []Decision, filters map[string][]string) ([]Decision, error) {
+	var results []Decision
+	var clauses []func(Decision) bool
+
+	// ID filtering
+	if idFilters, ok := filters["id"]; ok {
+		ids, ranges, err := parseIDFilters(idFilters)
+		if err != nil {
+			return nil, err
+		}
+		idSet := make(map[string]bool)
+		for _, id := range ids {
+			idSet[id] = true
+		}
+		clauses = append(clauses, func(d Decision) bool { return matchesID(d, idSet, ranges) })
+	}
+
+	// Title regex filtering
+	if titles, ok := filters["title"]; ok && len(titles) > 0 {
+		titleRegex, err := regexp.Compile(titles[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid title regex: %w", err)
+		}
+		clauses = append(clauses, func(d Decision) bool { return matchesTitle(d, titleRegex) })
+	}
+
+	// Date range filtering
+	for _, key := range []string{"created", "decided"} {
+		var ranges [][2]string
+		for _, value := range filters[key] {
+			from, to, err := parseDateRange(value)
+			if err != nil {
+				return nil, err
+			}
+			ranges = append(ranges, [2]string{from, to})
+		}
+		if len(ranges) == 0 {
+			continue
+		}
+		if key == "created" {
+			clauses = append(clauses, func(d Decision) bool { return inDateRange(d.Created, ranges) })
+		} else {
+			clauses = append(clauses, func(d Decision) bool { return inDateRange(d.DecidedAt, ranges) })
+		}
+	}
+
+	if tags := filters["tag"]; len(tags) > 0 {
+		clauses = append(clauses, func(d Decision) bool { return matchesTag(d, tags) })
+	}
+	if statuses := filters["status"]; len(statuses) > 0 {
+		clauses = append(clauses, func(d Decision) bool { return matchesStatus(d, statuses) })
+	}
+	if deciders := filters["decider"]; len(deciders) > 0 {
+		clauses = append(clauses, func(d Decision) bool { return matchesPerson(d.Deciders, deciders) })
+	}
+	if consulted := filters["consulted"]; len(consulted) > 0 {
+		clauses = append(clauses, func(d Decision) bool { return matchesPerson(d.Consulted, consulted) })
+	}
+	if informed := filters["informed"]; len(informed) > 0 {
+		clauses = append(clauses, func(d Decision) bool { return matchesPerson(d.Informed, informed) })
+	}
+	if fields := filters["field"]; len(fields) > 0 {
+		clauses = append(clauses, func(d Decision) bool { return matchesField(d, fields) })
+	}
+
+	var settings *ModelSettings
+	for _, expr := range filters["where"] {
+		query, err := ParseQuery(expr)
+		if err != nil {
+			return nil, err
+		}
+		if len(query.LinkTypes()) > 0 {
+			if settings == nil {
+				if settings, err = s.repo.LoadSettings(modelPath); err != nil {
+					return nil, err
+				}
+			}
+			if err := settings.checkQueryLinkTypes(query, decisions); err != nil {
+				return nil, err
+			}
+		}
+		clauses = append(clauses, query.Matches)
+	}
+
+	for _, d := range decisions {
+		if !slices.ContainsFunc(clauses, func(matches func(Decision) bool) bool { return !matches(d) }) {
+			results = append(results, d)
+		}
+	}
+
+	return results, nil
+}
+
+// Search ranks the decisions containing every search term. The cached search index of the model
+// is refreshed first for decision files that were added, changed or removed since the last search.
+func (s *DecisionServiceImplementation) Search(modelPath string, query SearchQuery) ([]SearchResult, error) {
+	index, err := s.refreshSearchIndex(modelPath)
+	if err != nil {
+		return nil, err
+	}
+	return index.Search(query), nil
+}
+
+func (s *DecisionServiceImplementation) refreshSearchIndex(modelPath string) (*SearchIndex, error) {
+	stamps, err := s.repo.DecisionFileStamps(modelPath)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := s.repo.LoadSearchIndex(modelPath)
+	if err != nil {
+		return nil, err
+	}
+	if index == nil || index.Version != searchIndexVersion || index.Documents == nil {
+		index = &SearchIndex{Version: searchIndexVersion, Documents: make(map[string]SearchDocument)}
+	}
+
+	changed := false
+	for id := range index.Documents {
+		if _, ok := stamps[id]; !ok {
+			delete(index.Documents, id)
+			changed = true
+		}
+	}
+
+	var decisions map[string]Decision
+	for id, stamp := range stamps {
+		if doc, ok := index.Documents[id]; ok && doc.Stamp == stamp {
+			continue
+		}
+		if decisions == nil {
+			all, err := s.GetAllDecisions(modelPath)
+			if err != nil {
+				return nil, err
+			}
+			decisions = make(map[string]Decision, len(all))
+			for _, d := range all {
+				decisions[d.ID] = d
+			}
+		}
+		d, ok := decisions[id]
+		if !ok {
+			continue
+		}
+		content, err := s.repo.LoadDecisionContent(modelPath, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to index decision %s: %w", id, err)
+		}
+		index.Documents[id] = NewSearchDocument(d, content, stamp)
+		changed = true
+	}
+
+	if changed {
+		if err := s.repo.SaveSearchIndex(modelPath, index); err != nil {
+			return nil, err
+		}
+	}
+	return index, nil
+}
+
+// Decide records the chosen options in the outcome and marks the decision as decided. Several
+// options can be chosen when a decision adopts a combination of them. Without a review date the
+// review interval of the model, if any, determines when the decision is due for review.
+func (s *DecisionServiceImplementation) Decide(modelPath string, decision *Decision, options []string, rationale, decider, reviewBy string, enforceOption bool) error {
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return err
+	}
+
+	lifecycle := settings.Lifecycle
+	if !lifecycle.CanTransition(decision.Status, lifecycle.Decided) {
+		return fmt.Errorf("decision with status %q cannot be decided", decision.Status)
+	}
+
+	if len(options) == 0 {
+		return fmt.Errorf("at least one option must be chosen")
+	}
+	if err := validateDate("review_by", reviewBy); err != nil {
+		return err
+	}
+
+	chosen, err := s.resolveChosenOptions(modelPath, decision.ID, options, enforceOption)
+	if err != nil {
+		return err
+	}
+
+	outcome := formatOutcome(chosen, rationale)
+
+	// TODO: use generic UpdateSection function
+	if err := s.repo.AppendOutcomeSection(modelPath, decision.ID, outcome); err != nil {
+		return err
+	}
+
+	decision.Status = lifecycle.Decided
+	decision.DecidedAt = today()
+	decision.ChosenOptions = chosen
+	if reviewBy == "" && settings.ReviewInterval != "" {
+		reviewBy = addReviewInterval(time.Now(), settings.ReviewInterval)
+	}
+	decision.ReviewBy = reviewBy
+	if decider != "" {
+		decision.Deciders = normalizePeople(append(decision.Deciders, decider))
+	}
+	return s.repo.Save(modelPath, decision)
+}
+
+// resolveChosenOptions returns the numbers of options given by number or title. With enforceOption
+// options that do not exist yet are added to the decision, but only once all options were checked.
+func (s *DecisionServiceImplementation) resolveChosenOptions(modelPath, decisionID string, options []string, enforceOption bool) ([]int, error) {
+	var missing []string
+	for _, option := range options {
+		exists, err := s.repo.OptionExists(modelPath, decisionID, option)
+		if err != nil {
+			return nil, err
+		}
+		if exists || slices.ContainsFunc(missing, func(m string) bool { return strings.EqualFold(m, option) }) {
+			continue
+		}
+		if !enforceOption {
+			return nil, fmt.Errorf(
+				"option does not exist in the decision: %q (provide either a number or name of an existing option or use -f or --force to automatically create new option for the decision)", option,
+			)
+		}
+		if isNumeric(option) {
+			return nil, fmt.Errorf("cannot auto-create numeric option: %q, use a descriptive name when using --force", option)
+		}
+		missing = append(missing, option)
+	}
+
+	if len(missing) > 0 {
+		if err := s.appendOptions(modelPath, decisionID, missing); err != nil {
+			return nil, fmt.Errorf("failed to append new option: %w", err)
+		}
+	}
+
+	var chosen []int
+	for _, option := range options {
+		optionNum, err := s.repo.ResolveOptionNumber(modelPath, decisionID, option)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(chosen, optionNum) {
+			chosen = append(chosen, optionNum)
+		}
+	}
+	return chosen, nil
+}
+
+// Review records that a decided decision was reviewed and moves its review date to the given date
+// or, without one, by the review interval of the model.
+func (s *DecisionServiceImplementation) Review(modelPath string, decision *Decision, result, next string) error {
+	if err := validateReviewResult(result); err != nil {
+		return err
+	}
+	if err := validateDate("next review", next); err != nil {
+		return err
+	}
+
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return err
+	}
+	if decision.Status != settings.Lifecycle.Decided {
+		return fmt.Errorf("only decisions with status %q can be reviewed, decision %s has status %q", settings.Lifecycle.Decided, decision.ID, decision.Status)
+	}
+
+	if next == "" {
+		if settings.ReviewInterval == "" {
+			return fmt.Errorf("no review_interval is set in %s, provide the next review date with --next", ModelSettingsFile)
+		}
+		next = addReviewInterval(time.Now(), settings.ReviewInterval)
+	}
+
+	decision.ReviewBy = next
+	if err := s.repo.Save(modelPath, decision); err != nil {
+		return fmt.Errorf("failed to save review date: %w", err)
+	}
+	return nil
+}
+
+// DueForReview returns the decided decisions whose review date has passed or is within the given
+// number of days, ordered by their review date.
+func (s *DecisionServiceImplementation) DueForReview(modelPath string, within int) ([]DueDecision, error) {
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions, err := s.repo.LoadAllByIndex(modelPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var due []DueDecision
+	for _, d := range decisions {
+		if d.Status != settings.Lifecycle.Decided || d.ReviewBy == "" {
+			continue
+		}
+		days, err := daysUntil(d.ReviewBy)
+		if err != nil {
+			return nil, fmt.Errorf("decision %s has an invalid review_by date %q", d.ID, d.ReviewBy)
+		}
+		if days <= within {
+			due = append(due, DueDecision{Decision: d, DaysLeft: days})
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool {
+		if due[i].DaysLeft != due[j].DaysLeft {
+			return due[i].DaysLeft < due[j].DaysLeft
+		}
+		return due[i].Decision.ID < due[j].Decision.ID
+	})
+	return due, nil
+}
+
+// Next returns the pending decisions whose predecessors are all settled and the ones still blocked.
+func (s *DecisionServiceImplementation) Next(modelPath string) (Readiness, error) {
+	decisions, settings, err := s.loadForPlanning(modelPath)
+	if err != nil {
+		return Readiness{}, err
+	}
+	return AssessReadiness(decisions, settings.Lifecycle), nil
+}
+
+// Plan orders the pending decisions of the model into waves following their precedes links.
+func (s *DecisionServiceImplementation) Plan(modelPath string) (Plan, error) {
+	decisions, settings, err := s.loadForPlanning(modelPath)
+	if err != nil {
+		return Plan{}, err
+	}
+	return PlanDecisions(decisions, settings.Lifecycle), nil
+}
+
+func (s *DecisionServiceImplementation) loadForPlanning(modelPath string) ([]Decision, *ModelSettings, error) {
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	decisions, err := s.GetAllDecisions(modelPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return decisions, settings, nil
+}
+
+func (s *DecisionServiceImplementation) Score(modelPath string, decision *Decision, option, criterion string, value int) error {
+	if value < minScore || value > maxScore {
+		return fmt.Errorf("score must be between %d and %d, got %d", minScore, maxScore, value)
+	}
+
+	content, err := s.GetDecisionContent(modelPath, decision.ID)
+	if err != nil {
+		return err
+	}
+
+	options := ParseOptions(content.Options)
+	optionIdx, err := resolveOption(options, option)
+	if err != nil {
+		return err
+	}
+
+	criteria := ParseCriteria(content.Criteria)
+	criterionIdx, err := resolveCriterion(criteria, criterion)
+	if err != nil {
+		return err
+	}
+
+	if decision.Scores == nil {
+		decision.Scores = make(map[string]map[string]int)
+	}
+	optionKey := strconv.Itoa(options[optionIdx].Number)
+	if decision.Scores[optionKey] == nil {
+		decision.Scores[optionKey] = make(map[string]int)
+	}
+	decision.Scores[optionKey][strconv.Itoa(criteria[criterionIdx].Number)] = value
+
+	if err := s.repo.Save(modelPath, decision); err != nil {
+		return fmt.Errorf("failed to save score: %w", err)
+	}
+
+	matrix := renderDecisionMatrix(options, criteria, decision.Scores)
+	return s.repo.UpdateSection(modelPath, decision.ID, domain.AnchorSectionDecisionMatrix, matrix)
+}
+
+// RankOptions orders the options of a decision by their weighted score, best first.
+func (s *DecisionServiceImplementation) RankOptions(modelPath string, decision *Decision) ([]OptionScore, error) {
+	if len(decision.Scores) == 0 {
+		return nil, fmt.Errorf("decision %s has no scores, score its options against the criteria first", decision.ID)
+	}
+
+	content, err := s.GetDecisionContent(modelPath, decision.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	options := ParseOptions(content.Options)
+	if len(options) == 0 {
+		return nil, fmt.Errorf("decision %s has no options", decision.ID)
+	}
+
+	return rankOptions(options, ParseCriteria(content.Criteria), decision.Scores), nil
+}
+
+// RecordRecommendation notes the option recommended by the decision matrix in the outcome.
+func (s *DecisionServiceImplementation) RecordRecommendation(modelPath string, decision *Decision, recommended OptionScore) error {
+	content, err := s.GetDecisionContent(modelPath, decision.ID)
+	if err != nil {
+		return err
+	}
+
+	note := fmt.Sprintf("The decision matrix recommended %s (%s) with a weighted score of %d.",
+		domain.AnchorLinkToOption(recommended.Option.Number), recommended.Option.Title, recommended.Total)
+
+	lines := append(strings.Split(strings.TrimRight(content.Outcome, "\n"), "\n"), "", note)
+	return s.repo.UpdateSection(modelPath, decision.ID, domain.AnchorSectionOutcome, lines)
+}
+
+func (s *DecisionServiceImplementation) Revise(modelPath string, original *Decision) (*Decision, error) {
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return nil, err
+	}
+
+	revised := s.buildRevisedDecision(original, settings.Lifecycle.Initial)
+
+	content, err := s.repo.LoadDecisionContent(modelPath, original.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load original content: %w", err)
+	}
+
+	s.resetContentForRevision(content)
+
+	subFolderPath, err := s.getSubFolderPath(modelPath, original.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relative path: %w", err)
+	}
+
+	return s.repo.Create(modelPath, subFolderPath, revised, content)
+}
+
+// Reopen sets a decided decision back to the initial status. Its outcome is moved to the previous outcomes section.
+// Like archiving, reopening does not depend on the transitions of the lifecycle.
+func (s *DecisionServiceImplementation) Reopen(modelPath string, decision *Decision, reason string) error {
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return err
+	}
+
+	lifecycle := settings.Lifecycle
+	if decision.Status != lifecycle.Decided {
+		return fmt.Errorf("only decisions with status %q can be reopened, decision %s has status %q", lifecycle.Decided, decision.ID, decision.Status)
+	}
+
+	content, err := s.repo.LoadDecisionContent(modelPath, decision.ID)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(content.Outcome) != "" {
+		var history []string
+		if content.PreviousOutcomes != "" {
+			history = append(strings.Split(content.PreviousOutcomes, "\n"), "")
+		}
+		history = append(history, formatPreviousOutcome(time.Now().Format("2006-01-02 15:04:05"), content.Outcome, reason)...)
+
+		if err := s.repo.UpdateSection(modelPath, decision.ID, domain.AnchorSectionPreviousOutcomes, history); err != nil {
+			return fmt.Errorf("failed to record previous outcome: %w", err)
+		}
+		if err := s.repo.RemoveSection(modelPath, decision.ID, domain.AnchorSectionOutcome); err != nil {
+			return fmt.Errorf("failed to remove outcome: %w", err)
+		}
+	}
+
+	decision.Status = lifecycle.Initial
+	decision.DecidedAt = ""
+	decision.ChosenOptions = nil
+	decision.ReviewBy = ""
+	if err := s.repo.Save(modelPath, decision); err != nil {
+		return fmt.Errorf("failed to save reopened decision: %w", err)
+	}
+	return nil
+}
+
+func (s *DecisionServiceImplementation) Copy(modelPath, targetPath, decisionId string) error {
+	return s.repo.Copy(modelPath, targetPath, decisionId)
+}
+
+func (s *DecisionServiceImplementation) Comment(modelPath string, decision *Decision, author, commentText string) error {
+	return s.Reply(modelPath, decision, 0, author, commentText)
+}
+
+// Reply adds a comment to a decision, as a reply to the comment with the given ID unless it is 0.
+func (s *DecisionServiceImplementation) Reply(modelPath string, decision *Decision, parentID int, author, commentText string) error {
+	if strings.TrimSpace(commentText) == "" {
+		return fmt.Errorf("comment text must not be empty")
+	}
+	if err := s.loadComments(modelPath, decision); err != nil {
+		return err
+	}
+	if parentID != 0 {
+		if _, err := findComment(decision.Comments, parentID); err != nil {
+			return fmt.Errorf("cannot reply, %w", err)
+		}
+	}
+
+	decision.Comments = append(decision.Comments, Comment{
+		ID:      nextCommentID(decision.Comments),
+		Author:  author,
+		Date:    time.Now().Format("2006-01-02 15:04:05"),
+		Comment: commentText,
+		ReplyTo: parentID,
+	})
+	return s.saveComments(modelPath, decision)
+}
+
+// EditComment replaces the text of a comment and marks it as edited.
+func (s *DecisionServiceImplementation) EditComment(modelPath string, decision *Decision, commentID int, commentText string) error {
+	if strings.TrimSpace(commentText) == "" {
+		return fmt.Errorf("comment text must not be empty")
+	}
+	if err := s.loadComments(modelPath, decision); err != nil {
+		return err
+	}
+	idx, err := findComment(decision.Comments, commentID)
+	if err != nil {
+		return err
+	}
+
+	decision.Comments[idx].Comment = commentText
+	decision.Comments[idx].Edited = time.Now().Format("2006-01-02 15:04:05")
+	return s.saveComments(modelPath, decision)
+}
+
+// DeleteComment removes a comment. Its replies are kept and attached to the parent of the deleted comment.
+func (s *DecisionServiceImplementation) DeleteComment(modelPath string, decision *Decision, commentID int) error {
+	if err := s.loadComments(modelPath, decision); err != nil {
+		return err
+	}
+	if _, err := findComment(decision.Comments, commentID); err != nil {
+		return err
+	}
+
+	decision.Comments = removeComment(decision.Comments, commentID)
+	return s.saveComments(modelPath, decision)
+}
+
+// loadComments upgrades comments written by older versions, whose text only exists in the comments section.
+func (s *DecisionServiceImplementation) loadComments(modelPath string, decision *Decision) error {
+	if !slices.ContainsFunc(decision.Comments, func(c Comment) bool { return c.ID == 0 }) {
+		return nil
+	}
+	content, err := s.repo.LoadDecisionContent(modelPath, decision.ID)
+	if err != nil {
+		return err
+	}
+	decision.Comments = upgradeComments(decision.Comments, content.Comments)
+	return nil
+}
+
+// saveComments stores the comments in the metadata and renders the comments section from them.
+func (s *DecisionServiceImplementation) saveComments(modelPath string, decision *Decision) error {
+	if err := s.repo.Save(modelPath, decision); err != nil {
+		return fmt.Errorf("failed to save updated decision: %w", err)
+	}
+
+	if len(decision.Comments) == 0 {
+		if err := s.repo.RemoveSection(modelPath, decision.ID, domain.AnchorSectionComments); err != nil {
+			return fmt.Errorf("failed to remove comment section: %w", err)
+		}
+		return nil
+	}
+	if err := s.repo.UpdateSection(modelPath, decision.ID, domain.AnchorSectionComments, RenderComments(decision.Comments)); err != nil {
+		return fmt.Errorf("failed to update comment section: %w", err)
+	}
+	return nil
+}
+
+func (s *DecisionServiceImplementation) GetSettings(modelPath string) (*ModelSettings, error) {
+	return s.repo.LoadSettings(modelPath)
+}
+
+func (s *DecisionServiceImplementation) Transition(modelPath string, decision *Decision, status string) error {
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return err
+	}
+
+	if err := settings.Lifecycle.checkTransition(decision.Status, status); err != nil {
+		return err
+	}
+
+	decision.Status = status
+	if err := s.repo.Save(modelPath, decision); err != nil {
+		return fmt.Errorf("failed to save decision with new status: %w", err)
+	}
+	return nil
+}
+
+func (s *DecisionServiceImplementation) Supersede(modelPath string, original, replacement *Decision) error {
+	if original.ID == replacement.ID {
+		return fmt.Errorf("decision %s cannot supersede itself", original.ID)
+	}
+
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return err
+	}
+
+	// everything that can fail is checked before the first write and the status changes last,
+	// so a failed supersede never leaves a superseded decision without its replacement
+	superseded := settings.Lifecycle.Superseded
+	if err := settings.Lifecycle.checkTransition(original.Status, superseded); err != nil {
+		return err
+	}
+	reaches := func(fromID, toID, tag string) bool {
+		return s.reaches(modelPath, fromID, toID, tag, make(map[string]bool))
+	}
+	if err := settings.checkLinkType(original, replacement, "superseded by", "supersedes", reaches); err != nil {
+		return fmt.Errorf("failed to link replacement decision: %w", err)
+	}
+	notice, err := s.buildSupersededNotice(modelPath, original, replacement)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Link(modelPath, original, replacement, "superseded by", "supersedes"); err != nil {
+		return fmt.Errorf("failed to link replacement decision: %w", err)
+	}
+	if err := s.repo.SetNotice(modelPath, original.ID, domain.AnchorNoticeSuperseded, notice); err != nil {
+		return fmt.Errorf("failed to add superseded notice: %w", err)
+	}
+
+	original.Status = superseded
+	if err := s.repo.Save(modelPath, original); err != nil {
+		return fmt.Errorf("failed to save decision with new status: %w", err)
+	}
+	return nil
+}
+
+func (s *DecisionServiceImplementation) Rename(modelPath string, decision *Decision, newTitle string) error {
+	newTitle = strings.TrimSpace(newTitle)
+	if !containsLetter(newTitle) {
+		return errors.New("title must contain at least one letter")
+	}
+	if newTitle == decision.Title {
+		return fmt.Errorf("decision %s already has the title %q", decision.ID, newTitle)
+	}
+
+	decisions, err := s.GetAllDecisions(modelPath)
+	if err != nil {
+		return err
+	}
+	for _, d := range decisions {
+		if d.ID != decision.ID && strings.EqualFold(d.Title, newTitle) {
+			return fmt.Errorf("decision %s already has the title %q", d.ID, d.Title)
+		}
+	}
+
+	decision.Title = newTitle
+	if err := s.repo.Rename(modelPath, decision); err != nil {
+		return fmt.Errorf("failed to rename decision: %w", err)
 	}
 
+	// notices of superseded decisions show the title of their replacement
+	for _, supersededID := range decision.Links.Custom["supersedes"] {
+		superseded, err := s.repo.LoadById(modelPath, supersededID)
+		if err != nil {
+			continue
+		}
+		notice, err := s.buildSupersededNotice(modelPath, superseded, decision)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.SetNotice(modelPath, supersededID, domain.AnchorNoticeSuperseded, notice); err != nil {
+			return fmt.Errorf("failed to update superseded notice of decision %s: %w", supersededID, err)
+		}
+	}
 	return nil
 }
 
-func (s *DecisionServiceImplementation) Tag(modelPath string, decision *Decision, tag string) error {
-	if slices.Contains(decision.Tags, tag) {
-		return fmt.Errorf("tag %q already exists in this decision", tag)
+// Renumber assigns new IDs to the decisions of a model as planned by PlanRenumbering and updates
+// the links of all decisions. With dryRun, the changes are only returned.
+func (s *DecisionServiceImplementation) Renumber(modelPath, order string, mapping map[string]string, dryRun bool) ([]IDChange, error) {
+	decisions, err := s.repo.LoadAllByData(modelPath)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.repo.ExistingIDs(modelPath)
+	if err != nil {
+		return nil, err
 	}
 
-	decision.Tags = append(decision.Tags, tag)
+	// IDs of archived decisions are never reused
+	var reserved []string
+	for _, id := range existing {
+		if !slices.ContainsFunc(decisions, func(d Decision) bool { return d.ID == id }) {
+			reserved = append(reserved, id)
+		}
+	}
 
-	if err := s.repo.Save(modelPath, decision); err != nil {
-		return fmt.Errorf("failed to save decision with new tag: %w", err)
+	changes, err := PlanRenumbering(decisions, reserved, settings.IDs, order, mapping)
+	if err != nil || dryRun || len(changes) == 0 {
+		return changes, err
 	}
-	return nil
+
+	ids := make(map[string]string, len(changes))
+	for _, change := range changes {
+		ids[change.From] = change.To
+	}
+	for i := range decisions {
+		if to, ok := ids[decisions[i].ID]; ok {
+			decisions[i].ID = to
+		}
+		decisions[i].Links = renumberLinks(decisions[i].Links, ids)
+	}
+
+	if err := s.repo.Renumber(modelPath, ids, decisions); err != nil {
+		return nil, fmt.Errorf("failed to renumber decisions: %w", err)
+	}
+	return changes, nil
 }
 
-func (s *DecisionServiceImplementation) FilterDecisions(decisions []Decision, filters map[string][]string) ([]Decision, error) {
-	var results []Decision
+// ResolveCollisions gives every decision whose ID is also used by an older decision a new ID, as
+// planned by ModelSettings.ResolveCollisions, and updates the links of all decisions. It returns the
+// new IDs and the links that could not be assigned to one decision. With dryRun, they are only returned.
+func (s *DecisionServiceImplementation) ResolveCollisions(modelPath string, dryRun bool) ([]IDChange, []AmbiguousLink, error) {
+	decisions, err := s.repo.LoadAllByData(modelPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	duplicates := DuplicateIDs(decisions)
+	if len(duplicates) == 0 {
+		return nil, nil, nil
+	}
 
-	// ID filtering
-	idSet := make(map[string]bool)
-	if idFilters, ok := filters["id"]; ok {
-		expandedIDs, err := expandIDFilters(idFilters)
-		if err != nil {
-			return nil, err
-		}
-		for _, id := range expandedIDs {
-			idSet[id] = true
-		}
+	settings, err := s.repo.LoadSettings(modelPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	existing, err := s.repo.ExistingIDs(modelPath)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Title regex filtering
-	var titleRegex *regexp.Regexp
-	if titles, ok := filters["title"]; ok && len(titles) > 0 {
-		var err error
-		titleRegex, err = regexp.Compile(titles[0])
+	var collisions []IDCollision
+	for _, id := range duplicates {
+		files, err := s.repo.LoadDecisionFiles(modelPath, id)
 		if err != nil {
-			return nil, fmt.Errorf("invalid title regex: %w", err)
+			return nil, nil, err
 		}
+		collisions = append(collisions, IDCollision{ID: id, Files: files})
 	}
+	others := slices.DeleteFunc(slices.Clone(decisions), func(d Decision) bool { return slices.Contains(duplicates, d.ID) })
 
-	for _, d := range decisions {
-		if matchesID(d, idSet) || matchesTitle(d, titleRegex) || matchesTag(d, filters["tag"]) || matchesStatus(d, filters["status"]) {
-			results = append(results, d)
-		}
+	resolution := settings.ResolveCollisions(others, collisions, existing, time.Now())
+	if dryRun {
+		return resolution.Changes, resolution.Ambiguous, nil
 	}
 
-	return results, nil
+	for _, moved := range resolution.Moved {
+		if err := s.repo.MoveDecisionFile(modelPath, moved.Path, &moved.Decision); err != nil {
+			return nil, nil, fmt.Errorf("failed to move %s: %w", moved.Path, err)
+		}
+	}
+	for i := range resolution.Updated {
+		if err := s.repo.Save(modelPath, &resolution.Updated[i]); err != nil {
+			return nil, nil, fmt.Errorf("failed to update links of decision %s: %w", resolution.Updated[i].ID, err)
+		}
+	}
+	return resolution.Changes, resolution.Ambiguous, nil
 }
 
-func (s *DecisionServiceImplementation) Decide(modelPath string, decision *Decision, option, rationale string, enforceOption bool) error {
-	exists, err := s.repo.OptionExists(modelPath, decision.ID, option)
+// Remove deletes a decision and strips all links pointing to it. It returns the IDs of the decisions whose links were updated.
+// A decision that supersedes others is only removed with force, the notices of the superseded decisions then no longer link to it.
+func (s *DecisionServiceImplementation) Remove(modelPath string, decision *Decision, force bool) ([]string, error) {
+	superseded := decision.Links.Custom["supersedes"]
+	if len(superseded) > 0 && !force {
+		return nil, fmt.Errorf("decision %s supersedes %s, use --force to remove it anyway", decision.ID, strings.Join(superseded, ", "))
+	}
+
+	updated, err := s.unlinkFromAll(modelPath, decision.ID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if err := s.detachSuperseded(modelPath, decision, superseded, "removed"); err != nil {
+		return nil, err
 	}
 
-	if !exists {
-		if !enforceOption {
-			return fmt.Errorf(
-				"option does not exist in the decision (provide either a number or name of an existing option or use -f or --force to automatically create new option for the decision)",
-			)
-		}
-		if isNumeric(option) {
-			return fmt.Errorf("cannot auto-create numeric option: %q, use a descriptive name when using --force", option)
-		}
-		if err := s.appendOptions(modelPath, decision.ID, []string{option}); err != nil {
-			return fmt.Errorf("failed to append new option: %w", err)
-		}
+	if err := s.repo.Delete(modelPath, decision.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete decision: %w", err)
+	}
+	return updated, nil
+}
+
+// Archive sets the archived status on a decision, moves it out of the model and strips all links pointing to it.
+// It returns the IDs of the decisions whose links were updated. Like Remove, it needs force for a decision that supersedes others.
+func (s *DecisionServiceImplementation) Archive(modelPath string, decision *Decision, force bool) ([]string, error) {
+	superseded := decision.Links.Custom["supersedes"]
+	if len(superseded) > 0 && !force {
+		return nil, fmt.Errorf("decision %s supersedes %s, use --force to archive it anyway", decision.ID, strings.Join(superseded, ", "))
 	}
 
-	optionNum, err := s.repo.ResolveOptionNumber(modelPath, decision.ID, option)
+	settings, err := s.repo.LoadSettings(modelPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	outcome := formatOutcome(optionNum, rationale)
+	updated, err := s.unlinkFromAll(modelPath, decision.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.detachSuperseded(modelPath, decision, superseded, "archived"); err != nil {
+		return nil, err
+	}
 
-	// TODO: use generic UpdateSection function
-	if err := s.repo.AppendOutcomeSection(modelPath, decision.ID, outcome); err != nil {
-		return err
+	decision.Status = settings.Lifecycle.Archived
+	if err := s.repo.Save(modelPath, decision); err != nil {
+		return nil, fmt.Errorf("failed to save archived decision: %w", err)
 	}
 
-	decision.Status = "decided"
-	return s.repo.Save(modelPath, decision)
+	if err := s.repo.Archive(modelPath, decision.ID); err != nil {
+		return nil, fmt.Errorf("failed to archive decision: %w", err)
+	}
+	return updated, nil
 }
 
-func (s *DecisionServiceImplementation) Revise(modelPath string, original *Decision) (*Decision, error) {
-	revised := s.buildRevisedDecision(original)
+// Helpers
 
-	content, err := s.repo.LoadDecisionContent(modelPath, original.ID)
+// unlinkFromAll removes every link to the given decision from the other decisions of the model.
+func (s *DecisionServiceImplementation) unlinkFromAll(modelPath, decisionID string) ([]string, error) {
+	decisions, err := s.GetAllDecisions(modelPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load original content: %w", err)
+		return nil, err
 	}
 
-	s.resetContentForRevision(content)
-
-	subFolderPath, err := s.getSubFolderPath(modelPath, original.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get relative path: %w", err)
+	var updated []string
+	for i := range decisions {
+		d := &decisions[i]
+		if d.ID == decisionID || !removeLinksTo(&d.Links, decisionID) {
+			continue
+		}
+		if err := s.repo.Save(modelPath, d); err != nil {
+			return nil, fmt.Errorf("failed to remove links from decision %s: %w", d.ID, err)
+		}
+		updated = append(updated, d.ID)
 	}
 
-	return s.repo.Create(modelPath, subFolderPath, revised, content)
+	sort.Strings(updated)
+	return updated, nil
 }
 
-func (s *DecisionServiceImplementation) Copy(modelPath, targetPath, decisionId string) error {
-	return s.repo.Copy(modelPath, targetPath, decisionId)
+// detachSuperseded rewrites the notices of the decisions superseded by a removed or archived decision, so that they
+// name their replacement without linking to its file or ID, which may be reused later. They stay superseded.
+func (s *DecisionServiceImplementation) detachSuperseded(modelPath string, replacement *Decision, superseded []string, reason string) error {
+	for _, id := range superseded {
+		notice := []string{
+			fmt.Sprintf("> %s **Superseded** by the decision %q, which was %s. This decision is no longer in force.",
+				domain.AnchorForSection(domain.AnchorNoticeSuperseded), replacement.Title, reason),
+		}
+		if err := s.repo.SetNotice(modelPath, id, domain.AnchorNoticeSuperseded, notice); err != nil {
+			return fmt.Errorf("failed to update superseded notice of decision %s: %w", id, err)
+		}
+	}
+	return nil
 }
 
-func (s *DecisionServiceImplementation) Comment(modelPath string, decision *Decision, author, commentText string) error {
-	commentCount := len(decision.Comments)
-	timestamp := time.Now().Format("2006-01-02 15:04:05")
+func removeLinksTo(links *Links, id string) bool {
+	changed := false
+	without := func(ids []string) []string {
+		kept := slices.DeleteFunc(slices.Clone(ids), func(linked string) bool { return linked == id })
+		if len(kept) != len(ids) {
+			changed = true
+		}
+		return kept
+	}
 
-	newComment := Comment{
-		Author:  author,
-		Date:    timestamp,
-		Comment: fmt.Sprintf("%d", commentCount+1),
+	links.Precedes = without(links.Precedes)
+	links.Succeeds = without(links.Succeeds)
+	for tag, ids := range links.Custom {
+		if kept := without(ids); len(kept) > 0 {
+			links.Custom[tag] = kept
+		} else {
+			delete(links.Custom, tag)
+		}
 	}
-	decision.Comments = append(decision.Comments, newComment)
+	return changed
+}
 
-	if err := s.repo.Save(modelPath, decision); err != nil {
-		return fmt.Errorf("failed to save updated decision: %w", err)
+// removes a single tagged link to id, dropping custom tags that end up empty
+func removeTaggedLinkTo(links *Links, tag, id string) bool {
+	var ids *[]string
+	switch tag {
+	case "precedes":
+		ids = &links.Precedes
+	case "succeeds":
+		ids = &links.Succeeds
+	default:
+		custom, ok := links.Custom[tag]
+		if !ok {
+			return false
+		}
+		ids = &custom
 	}
 
-	if err := s.repo.AppendCommentSection(modelPath, decision.ID, commentText, commentCount+1, author, timestamp); err != nil {
-		return fmt.Errorf("failed to append comment section: %w", err)
+	kept := slices.DeleteFunc(slices.Clone(*ids), func(linked string) bool { return linked == id })
+	if len(kept) == len(*ids) {
+		return false
 	}
 
-	return nil
+	switch tag {
+	case "precedes", "succeeds":
+		*ids = kept
+	default:
+		if len(kept) == 0 {
+			delete(links.Custom, tag)
+		} else {
+			links.Custom[tag] = kept
+		}
+	}
+	return true
 }
 
-// Helpers
+func replaceTags(tags, sources []string, target string) ([]string, bool) {
+	changed := false
+	var result []string
+	for _, tag := range tags {
+		if slices.Contains(sources, tag) {
+			changed = true
+			tag = target
+		}
+		if !slices.Contains(result, tag) {
+			result = append(result, tag)
+		}
+	}
+	return result, changed
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return strings.Join(quoted, ", ")
+}
 
 func (s *DecisionServiceImplementation) getSubFolderPath(modelPath, decisionID string) (string, error) {
 	filePath, err := s.repo.FindDecisionFile(modelPath, decisionID)
@@ -318,6 +1456,27 @@ func (s *DecisionServiceImplementation) getSubFolderPath(modelPath, decisionID s
 	return filepath.Dir(relPath), nil
 }
 
+func (s *DecisionServiceImplementation) buildSupersededNotice(modelPath string, original, replacement *Decision) ([]string, error) {
+	originalPath, err := s.repo.FindDecisionFile(modelPath, original.ID)
+	if err != nil {
+		return nil, err
+	}
+	replacementPath, err := s.repo.FindDecisionFile(modelPath, replacement.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	relPath, err := filepath.Rel(filepath.Dir(originalPath), replacementPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relative path: %w", err)
+	}
+
+	return []string{
+		fmt.Sprintf("> %s **Superseded** by [%s %s](%s). This decision is no longer in force.",
+			domain.AnchorForSection(domain.AnchorNoticeSuperseded), replacement.ID, replacement.Title, filepath.ToSlash(relPath)),
+	}, nil
+}
+
 func (s *DecisionServiceImplementation) appendToSection(modelPath, decisionID, section string, existingContent, newContent string) error {
 	existing := strings.Split(existingContent, "\n")
 	newLines := strings.Split(newContent, "\n")
@@ -325,6 +1484,36 @@ func (s *DecisionServiceImplementation) appendToSection(modelPath, decisionID, s
 	return s.repo.UpdateSection(modelPath, decisionID, section, lines)
 }
 
+func (s *DecisionServiceImplementation) editSection(modelPath, decisionID, section, text string, replace bool) error {
+	if replace {
+		return s.repo.UpdateSection(modelPath, decisionID, section, strings.Split(text, "\n"))
+	}
+
+	switch section {
+	case domain.AnchorSectionQuestion:
+		return s.appendQuestion(modelPath, decisionID, text)
+	case domain.AnchorSectionCriteria:
+		return s.appendCriteria(modelPath, decisionID, text)
+	default:
+		return fmt.Errorf("section %q cannot be edited", section)
+	}
+}
+
+// editNamedSection appends to or replaces the content of any section, creating it if needed.
+func (s *DecisionServiceImplementation) editNamedSection(modelPath, decisionID string, edit SectionEdit) error {
+	lines := strings.Split(edit.Text, "\n")
+	if !edit.Replace {
+		content, err := s.GetDecisionContent(modelPath, decisionID)
+		if err != nil {
+			return err
+		}
+		if existing := content.Section(edit.Anchor); existing != "" {
+			lines = append(strings.Split(existing, "\n"), lines...)
+		}
+	}
+	return s.repo.UpdateSection(modelPath, decisionID, edit.Anchor, lines)
+}
+
 func (s *DecisionServiceImplementation) appendQuestion(modelPath, decisionID string, question string) error {
 	content, err := s.GetDecisionContent(modelPath, decisionID)
 	if err != nil {
@@ -350,6 +1539,11 @@ func (s *DecisionServiceImplementation) appendOptions(modelPath, decisionID stri
 	lines := strings.Split(content.Options, "\n")
 	optionCount := countExistingOptions(lines)
 
+	// keep the blank line that separates an option with details from the next option
+	if last := lines[len(lines)-1]; optionCount > 0 && strings.HasPrefix(last, " ") {
+		lines = append(lines, "")
+	}
+
 	for i, opt := range newOptions {
 		if err := s.validateOptionDoesNotExist(modelPath, decisionID, opt); err != nil {
 			return err
@@ -362,6 +1556,127 @@ func (s *DecisionServiceImplementation) appendOptions(modelPath, decisionID stri
 	return s.repo.UpdateSection(modelPath, decisionID, domain.AnchorSectionOptions, lines)
 }
 
+// editOptions renames, removes, reorders and adds options, renumbers them and rewrites outcome links,
+// scores and chosen options of renumbered options.
+func (s *DecisionServiceImplementation) editOptions(modelPath string, decision *Decision, edit ContentEdit) error {
+	decisionID := decision.ID
+	content, err := s.GetDecisionContent(modelPath, decisionID)
+	if err != nil {
+		return err
+	}
+
+	preamble, options := parseOptions(content.Options)
+	restructured, mapping, err := restructureOptions(options, edit)
+	if err != nil {
+		return err
+	}
+
+	outcome, err := renumberOptionLinks(content.Outcome, mapping)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateSection(modelPath, decisionID, domain.AnchorSectionOptions, renderOptions(preamble, restructured)); err != nil {
+		return err
+	}
+	if outcome != content.Outcome {
+		if err := s.repo.UpdateSection(modelPath, decisionID, domain.AnchorSectionOutcome, strings.Split(outcome, "\n")); err != nil {
+			return err
+		}
+	}
+
+	if len(decision.Scores) == 0 && len(decision.ChosenOptions) == 0 {
+		return nil
+	}
+	decision.Scores = remapScores(decision.Scores, mapping)
+	decision.ChosenOptions = remapChosenOptions(decision.ChosenOptions, mapping)
+	if err := s.repo.Save(modelPath, decision); err != nil {
+		return fmt.Errorf("failed to save renumbered options: %w", err)
+	}
+	return s.refreshDecisionMatrix(modelPath, decision)
+}
+
+// validateEdit checks the criteria and option changes of an edit against the current content
+// before any section is written, so that a failing edit leaves the decision unchanged.
+func (s *DecisionServiceImplementation) validateEdit(modelPath, decisionID string, edit ContentEdit) error {
+	if !edit.editsCriteria() && !edit.restructuresOptions() && len(edit.AddOptions) == 0 {
+		return nil
+	}
+
+	content, err := s.GetDecisionContent(modelPath, decisionID)
+	if err != nil {
+		return err
+	}
+
+	if edit.editsCriteria() {
+		criteria := content.Criteria
+		if edit.Criteria != nil && edit.ReplaceCriteria {
+			criteria = *edit.Criteria
+		} else if edit.Criteria != nil {
+			criteria += "\n" + *edit.Criteria
+		}
+		if _, err := editCriteriaLines(criteria, edit.AddCriteria, edit.CriterionWeight); err != nil {
+			return err
+		}
+	}
+
+	if edit.restructuresOptions() {
+		_, options := parseOptions(content.Options)
+		_, mapping, err := restructureOptions(options, edit)
+		if err != nil {
+			return err
+		}
+		_, err = renumberOptionLinks(content.Outcome, mapping)
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, opt := range edit.AddOptions {
+		key := strings.ToLower(strings.TrimSpace(opt))
+		if seen[key] {
+			return fmt.Errorf("option %q exists more than once", opt)
+		}
+		seen[key] = true
+		if err := s.validateOptionDoesNotExist(modelPath, decisionID, opt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// editCriteria adds numbered criteria and changes their weights.
+func (s *DecisionServiceImplementation) editCriteria(modelPath string, decision *Decision, edit ContentEdit) error {
+	content, err := s.GetDecisionContent(modelPath, decision.ID)
+	if err != nil {
+		return err
+	}
+
+	lines, err := editCriteriaLines(content.Criteria, edit.AddCriteria, edit.CriterionWeight)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateSection(modelPath, decision.ID, domain.AnchorSectionCriteria, lines); err != nil {
+		return err
+	}
+	return s.refreshDecisionMatrix(modelPath, decision)
+}
+
+// refreshDecisionMatrix renders the decision matrix again after options, criteria or scores changed.
+func (s *DecisionServiceImplementation) refreshDecisionMatrix(modelPath string, decision *Decision) error {
+	if len(decision.Scores) == 0 {
+		return nil
+	}
+
+	content, err := s.GetDecisionContent(modelPath, decision.ID)
+	if err != nil {
+		return err
+	}
+
+	matrix := renderDecisionMatrix(ParseOptions(content.Options), ParseCriteria(content.Criteria), decision.Scores)
+	return s.repo.UpdateSection(modelPath, decision.ID, domain.AnchorSectionDecisionMatrix, matrix)
+}
+
 func countExistingOptions(lines []string) int {
 	count := 0
 	for _, line := range lines {
@@ -387,12 +1702,8 @@ func formatOptionLine(num int, option string) string {
 	return fmt.Sprintf("%d. %s %s", num, domain.AnchorForOption(num), option)
 }
 
-func (s *DecisionServiceImplementation) wouldCreateCycle(modelPath, fromID, toID string) bool {
-	visited := make(map[string]bool)
-	return s.dfs(modelPath, toID, fromID, visited)
-}
-
-func (s *DecisionServiceImplementation) dfs(modelPath, currentID, targetID string, visited map[string]bool) bool {
+// reaches reports whether targetID can be reached from currentID by following links with the given tag.
+func (s *DecisionServiceImplementation) reaches(modelPath, currentID, targetID, tag string, visited map[string]bool) bool {
 	if currentID == targetID {
 		return true
 	}
@@ -406,48 +1717,34 @@ func (s *DecisionServiceImplementation) dfs(modelPath, currentID, targetID strin
 		return false
 	}
 
-	for _, next := range node.Links.Precedes {
-		if s.dfs(modelPath, next, targetID, visited) {
+	for _, next := range linkTargets(node.Links, tag) {
+		if s.reaches(modelPath, next, targetID, tag, visited) {
 			return true
 		}
 	}
 	return false
 }
 
-func expandIDFilters(ids []string) ([]string, error) {
-	var result []string
-	for _, raw := range ids {
+// parseIDFilters splits ID filters into single IDs and ranges such as 0003-0007. IDs containing a
+// dash, e.g. SEC-0003, are not taken for ranges.
+func parseIDFilters(values []string) ([]string, []idRange, error) {
+	var ids []string
+	var ranges []idRange
+	for _, raw := range values {
 		for _, id := range strings.Split(raw, ",") {
 			id = strings.TrimSpace(id)
-			if strings.Contains(id, "-") {
-				expanded, err := expandRange(id)
-				if err != nil {
-					return nil, err
-				}
-				result = append(result, expanded...)
-			} else {
-				result = append(result, id)
+			if !strings.Contains(id, "-") || IsDecisionID(id) {
+				ids = append(ids, id)
+				continue
+			}
+			r, err := parseIDRange(id)
+			if err != nil {
+				return nil, nil, err
 			}
+			ranges = append(ranges, r)
 		}
 	}
-	return result, nil
-}
-
-func expandRange(rng string) ([]string, error) {
-	parts := strings.Split(rng, "-")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("invalid ID range: %s", rng)
-	}
-	start, err1 := strconv.Atoi(parts[0])
-	end, err2 := strconv.Atoi(parts[1])
-	if err1 != nil || err2 != nil || start > end {
-		return nil, fmt.Errorf("invalid ID range: %s", rng)
-	}
-	var out []string
-	for i := start; i <= end; i++ {
-		out = append(out, fmt.Sprintf("%04d", i))
-	}
-	return out, nil
+	return ids, ranges, nil
 }
 
 func containsLetter(s string) bool {
@@ -455,20 +1752,19 @@ func containsLetter(s string) bool {
 	return err == nil && matched
 }
 
-func adjustIDsBy(ids []string, delta int) []string {
+func adjustIDsBy(ids []string, delta int, scheme IDScheme) []string {
 	var updated []string
 	for _, id := range ids {
-		if num, err := strconv.Atoi(id); err == nil {
-			updated = append(updated, fmt.Sprintf("%04d", num+delta))
-		} else {
-			updated = append(updated, id)
-		}
+		updated = append(updated, scheme.shiftID(id, delta))
 	}
 	return updated
 }
 
-func matchesID(d Decision, idSet map[string]bool) bool {
-	return len(idSet) > 0 && idSet[d.ID]
+func matchesID(d Decision, idSet map[string]bool, ranges []idRange) bool {
+	if idSet[d.ID] {
+		return true
+	}
+	return slices.ContainsFunc(ranges, func(r idRange) bool { return r.contains(d.ID) })
 }
 
 func matchesTitle(d Decision, titleRegex *regexp.Regexp) bool {
@@ -500,23 +1796,62 @@ func isNumeric(input string) bool {
 	return err == nil
 }
 
-func formatOutcome(optionNum int, rationale string) string {
-	optionAnchor := domain.AnchorLinkToOption(optionNum) // todo: use name for the displayed option text
+func formatOutcome(optionNums []int, rationale string) string {
+	if len(optionNums) == 1 {
+		optionAnchor := domain.AnchorLinkToOption(optionNums[0]) // todo: use name for the displayed option text
+		if rationale != "" {
+			return fmt.Sprintf("We decided for %s because: %s", optionAnchor, rationale)
+		}
+		return fmt.Sprintf("We decided for %s.", optionAnchor)
+	}
+
+	lines := []string{"We decided for a combination of options:", ""}
+	for _, num := range optionNums {
+		lines = append(lines, "- "+domain.AnchorLinkToOption(num))
+	}
 	if rationale != "" {
-		return fmt.Sprintf("We decided for %s because: %s", optionAnchor, rationale)
+		lines = append(lines, "", "Rationale: "+rationale)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatPreviousOutcome(date, outcome, reason string) []string {
+	lines := []string{fmt.Sprintf("### Reopened on %s", date)}
+	lines = append(lines, strings.Split(strings.TrimSpace(outcome), "\n")...)
+	if reason != "" {
+		lines = append(lines, "", "Reason for reopening: "+reason)
 	}
-	return fmt.Sprintf("We decided for %s.", optionAnchor)
+	return lines
 }
 
-func (s *DecisionServiceImplementation) buildRevisedDecision(original *Decision) *Decision {
+func (s *DecisionServiceImplementation) buildRevisedDecision(original *Decision, status string) *Decision {
 	return &Decision{
-		Title:  original.Title + " (Revised)",
-		Status: "open",
-		Tags:   original.Tags,
+		Title:     original.Title + " (Revised)",
+		Status:    status,
+		Tags:      original.Tags,
+		Created:   today(),
+		Deciders:  slices.Clone(original.Deciders),
+		Consulted: slices.Clone(original.Consulted),
+		Informed:  slices.Clone(original.Informed),
+		Scores:    cloneScores(original.Scores),
+		Fields:    maps.Clone(original.Fields),
+	}
+}
+
+// scores are kept on revision because options and criteria are kept as well
+func cloneScores(scores map[string]map[string]int) map[string]map[string]int {
+	if scores == nil {
+		return nil
+	}
+	clone := make(map[string]map[string]int, len(scores))
+	for option, row := range scores {
+		clone[option] = maps.Clone(row)
 	}
+	return clone
 }
 
 func (s *DecisionServiceImplementation) resetContentForRevision(content *DecisionContent) {
 	content.Outcome = ""
+	content.PreviousOutcomes = ""
 	content.Comments = ""
 }
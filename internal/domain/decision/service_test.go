@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -27,6 +30,7 @@ func TestAddNew_ValidTitle(t *testing.T) {
 	}
 	expectedContent := &DecisionContent{}
 
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
 	mockRepo.On("Create", modelPath, "", mock.Anything, expectedContent).
 		Return(expectedDecision, nil)
 
@@ -77,6 +81,7 @@ func TestAddExisting_Success(t *testing.T) {
 	// stub out path lookup
 	mockRepo.On("FindDecisionFile", sourcePath, decision.ID).
 		Return(filepath.Join(sourcePath, "0012", "index.md"), nil)
+	mockRepo.On("LoadSettings", targetPath).Return(DefaultModelSettings(), nil)
 
 	expectedDecision := &Decision{
 		ID:    "0012",
@@ -99,10 +104,49 @@ func TestAddExisting_Success(t *testing.T) {
 	assert.Equal(t, "0020", result.Links.Precedes[0])
 	assert.Equal(t, "0018", result.Links.Succeeds[0])
 	assert.Equal(t, []string{"0015", "x"}, result.Links.Custom["relates"])
+	assert.Equal(t, "0022", decision.ID)
 
 	mockRepo.AssertExpectations(t)
 }
 
+func TestAddExisting_FormatsIDsWithTargetScheme(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	settings := DefaultModelSettings()
+	settings.IDs = IDScheme{Prefix: "SEC-", Width: 3}
+	decision := &Decision{ID: "0002", Links: Links{Precedes: []string{"0003"}}}
+
+	mockRepo.On("FindDecisionFile", "source", "0002").Return(filepath.Join("source", "AD0002-a.md"), nil)
+	mockRepo.On("LoadSettings", "target").Return(settings, nil)
+	mockRepo.On("Create", "target", mock.Anything, decision, mock.Anything).Return(decision, nil)
+
+	_, err := service.AddExisting("source", "target", decision, &DecisionContent{}, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SEC-012", decision.ID)
+	assert.Equal(t, []string{"SEC-013"}, decision.Links.Precedes)
+}
+
+func TestAddExisting_KeepsIDsForTimestampScheme(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	settings := DefaultModelSettings()
+	settings.IDs = IDScheme{Strategy: IDStrategyTimestamp}
+	decision := &Decision{ID: "20261018080530kqfz", Links: Links{Precedes: []string{"20261018090000abcd"}}}
+
+	mockRepo.On("FindDecisionFile", "source", decision.ID).Return(filepath.Join("source", "AD20261018080530kqfz-a.md"), nil)
+	mockRepo.On("LoadSettings", "target").Return(settings, nil)
+	mockRepo.On("Create", "target", mock.Anything, decision, mock.Anything).Return(decision, nil)
+
+	_, err := service.AddExisting("source", "target", decision, &DecisionContent{}, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "20261018080530kqfz", decision.ID)
+	assert.Equal(t, []string{"20261018090000abcd"}, decision.Links.Precedes)
+}
+
 func TestAddExisting_SubFolderPathError(t *testing.T) {
 	mockRepo := new(MockDecisionRepository)
 	service := NewDecisionService(mockRepo)
@@ -227,6 +271,7 @@ func TestGetDecisionContent(t *testing.T) {
 func TestLink_PrecedesSavesBoth(t *testing.T) {
 	mockRepo := new(MockDecisionRepository)
 	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model/path").Return(DefaultModelSettings(), nil)
 
 	source := &Decision{ID: "001"}
 	target := &Decision{ID: "002"}
@@ -246,6 +291,7 @@ func TestLink_PrecedesSavesBoth(t *testing.T) {
 func TestLink_CustomTagsWithReverse(t *testing.T) {
 	mockRepo := new(MockDecisionRepository)
 	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model/path").Return(DefaultModelSettings(), nil)
 
 	source := &Decision{ID: "D1", Links: Links{Custom: map[string][]string{}}}
 	target := &Decision{ID: "D2", Links: Links{}} // target.Links.Custom nil on purpose
@@ -264,6 +310,7 @@ func TestLink_CustomTagsWithReverse(t *testing.T) {
 func TestLink_CustomTagsWithoutReverse(t *testing.T) {
 	mockRepo := new(MockDecisionRepository)
 	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model/path").Return(DefaultModelSettings(), nil)
 
 	source := &Decision{ID: "X", Links: Links{Custom: map[string][]string{}}}
 	target := &Decision{ID: "Y"} // no reverseTag
@@ -282,6 +329,7 @@ func TestLink_CustomTagsWithoutReverse(t *testing.T) {
 func TestLink_CycleDetected(t *testing.T) {
 	mockRepo := new(MockDecisionRepository)
 	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model/path").Return(DefaultModelSettings(), nil)
 
 	source := &Decision{ID: "A"}
 	target := &Decision{
@@ -297,9 +345,67 @@ func TestLink_CycleDetected(t *testing.T) {
 	assert.Contains(t, err.Error(), "would create a cycle")
 }
 
+func TestLink_AcyclicLinkType(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	settings := DefaultModelSettings()
+	settings.LinkTypes = map[string]LinkType{"depends on": {Inverse: "required by", Acyclic: true}}
+	source := &Decision{ID: "A"}
+	target := &Decision{ID: "B", Links: Links{Custom: map[string][]string{"depends on": {"C"}}}}
+	third := &Decision{ID: "C", Links: Links{Custom: map[string][]string{"depends on": {"A"}}}}
+
+	mockRepo.On("LoadSettings", "model").Return(settings, nil)
+	mockRepo.On("LoadById", "model", "B").Return(target, nil)
+	mockRepo.On("LoadById", "model", "C").Return(third, nil)
+
+	err := service.Link("model", source, target, "depends on", "required by")
+
+	assert.EqualError(t, err, "linking A -> B would create a cycle")
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestLink_CardinalityExceeded(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	settings := DefaultModelSettings()
+	settings.LinkTypes = map[string]LinkType{"replaces": {Inverse: "replaced by", Cardinality: CardinalityOneToOne}}
+	source := &Decision{ID: "A"}
+	target := &Decision{ID: "B", Links: Links{Custom: map[string][]string{"replaced by": {"C"}}}}
+
+	mockRepo.On("LoadSettings", "model").Return(settings, nil)
+
+	err := service.Link("model", source, target, "replaces", "replaced by")
+
+	assert.EqualError(t, err, `link type "replaces" allows only one link to a decision, B is already linked from C`)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestUnlink_UsesInverseOfLinkType(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	settings := DefaultModelSettings()
+	settings.LinkTypes = map[string]LinkType{"depends on": {Inverse: "required by"}}
+	source := &Decision{ID: "0001", Links: Links{Custom: map[string][]string{"depends on": {"0002"}}}}
+	target := &Decision{ID: "0002", Links: Links{Custom: map[string][]string{"required by": {"0001"}}}}
+
+	mockRepo.On("LoadSettings", "model").Return(settings, nil)
+	mockRepo.On("Save", "model", source).Return(nil)
+	mockRepo.On("Save", "model", target).Return(nil)
+
+	err := service.Unlink("model", source, target, "depends on", "")
+
+	assert.NoError(t, err)
+	assert.Empty(t, source.Links.Custom)
+	assert.Empty(t, target.Links.Custom)
+}
+
 func TestLink_SaveSourceFails(t *testing.T) {
 	mockRepo := new(MockDecisionRepository)
 	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model/path").Return(DefaultModelSettings(), nil)
 
 	source := &Decision{ID: "001", Links: Links{Custom: map[string][]string{}}}
 	target := &Decision{ID: "002", Links: Links{Custom: map[string][]string{}}}
@@ -315,6 +421,7 @@ func TestLink_SaveSourceFails(t *testing.T) {
 func TestLink_SaveTargetFails(t *testing.T) {
 	mockRepo := new(MockDecisionRepository)
 	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model/path").Return(DefaultModelSettings(), nil)
 
 	source := &Decision{ID: "001", Links: Links{Custom: map[string][]string{}}}
 	target := &Decision{ID: "002", Links: Links{Custom: map[string][]string{}}}
@@ -328,6 +435,177 @@ func TestLink_SaveTargetFails(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to save target")
 }
 
+func platformSettings() *ModelSettings {
+	settings := DefaultModelSettings()
+	settings.Models = map[string]string{"platform": "../platform"}
+	return settings
+}
+
+func TestLinkExternal_StoresLinkOnSourceOnly(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+	platformPath := filepath.Join("model", "..", "platform")
+	mockRepo.On("LoadSettings", "model").Return(platformSettings(), nil)
+	mockRepo.On("LoadById", platformPath, "0012").Return(&Decision{ID: "0012"}, nil)
+
+	source := &Decision{ID: "0001"}
+	mockRepo.On("Save", "model", source).Return(nil)
+
+	err := service.LinkExternal("model", source, "platform:0012", "depends on")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"platform:0012"}, source.Links.Custom["depends on"])
+	mockRepo.AssertExpectations(t)
+}
+
+func TestLinkExternal_MissingTargetFails(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model").Return(platformSettings(), nil)
+	mockRepo.On("LoadById", filepath.Join("model", "..", "platform"), "0099").Return(nil, errors.New("not found"))
+
+	err := service.LinkExternal("model", &Decision{ID: "0001"}, "platform:0099", "depends on")
+
+	assert.ErrorContains(t, err, `decision 0099 not found in model "platform"`)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestLinkExternal_OneToOneCardinality(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+	settings := platformSettings()
+	settings.LinkTypes = map[string]LinkType{"implements": {Inverse: "implemented by", Cardinality: CardinalityOneToOne}}
+	mockRepo.On("LoadSettings", "model").Return(settings, nil)
+	mockRepo.On("LoadById", filepath.Join("model", "..", "platform"), mock.Anything).Return(&Decision{ID: "0013"}, nil)
+
+	source := &Decision{ID: "0001", Links: Links{Custom: map[string][]string{"implements": {"platform:0012"}}}}
+	err := service.LinkExternal("model", source, "platform:0013", "implements")
+	assert.EqualError(t, err, `link type "implements" allows only one link per decision, 0001 already links to platform:0012`)
+
+	target := &Decision{ID: "0002", Links: Links{Custom: map[string][]string{"implemented by": {"platform:0012"}}}}
+	err = service.LinkExternal("model", target, "platform:0013", "implemented by")
+	assert.EqualError(t, err, `link type "implements" allows only one link to a decision, 0002 is already linked from platform:0012`)
+
+	// linking the same decision again is not a second link
+	err = service.LinkExternal("model", source, "platform:0012", "implements")
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestLinkExternal_BuiltinTagFails(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	err := service.LinkExternal("model", &Decision{ID: "0001"}, "platform:0012", "precedes")
+
+	assert.ErrorContains(t, err, "need a custom tag")
+}
+
+func TestResolveReference_UnknownAlias(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model").Return(platformSettings(), nil)
+
+	_, err := service.ResolveReference("model", "billing:0001")
+
+	assert.EqualError(t, err, `unknown model alias "billing"`)
+}
+
+func TestResolveLinks(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model").Return(platformSettings(), nil)
+	mockRepo.On("LoadById", "model", "0002").Return(&Decision{ID: "0002", Title: "Use REST", Status: "open"}, nil)
+	mockRepo.On("LoadById", filepath.Join("model", "..", "platform"), "0012").Return(&Decision{ID: "0012", Title: "Use Kafka", Status: "decided"}, nil)
+	mockRepo.On("LoadById", filepath.Join("model", "..", "platform"), "0099").Return(nil, errors.New("not found"))
+
+	d := &Decision{ID: "0001", Links: Links{
+		Precedes: []string{"0002"},
+		Custom:   map[string][]string{"depends on": {"platform:0012", "platform:0099"}},
+	}}
+
+	links := service.ResolveLinks("model", d)
+
+	assert.Equal(t, []ResolvedLink{
+		{Type: "precedes", Target: "0002", Title: "Use REST", Status: "open"},
+		{Type: "depends on", Target: "platform:0012", Model: "platform", Title: "Use Kafka", Status: "decided"},
+		{Type: "depends on", Target: "platform:0099", Model: "platform", Missing: true},
+	}, links)
+}
+
+func TestUnlink_PrecedesRemovesBothDirections(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+
+	source := &Decision{ID: "0001", Links: Links{Precedes: []string{"0002", "0003"}}}
+	target := &Decision{ID: "0002", Links: Links{Succeeds: []string{"0001"}}}
+
+	mockRepo.On("Save", "model", source).Return(nil)
+	mockRepo.On("Save", "model", target).Return(nil)
+
+	err := service.Unlink("model", source, target, "precedes", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0003"}, source.Links.Precedes)
+	assert.Empty(t, target.Links.Succeeds)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUnlink_CustomTagsDropEmptyEntries(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+
+	source := &Decision{ID: "0001", Links: Links{Custom: map[string][]string{"depends on": {"0002"}, "relates": {"0002"}}}}
+	target := &Decision{ID: "0002", Links: Links{Custom: map[string][]string{"required by": {"0001"}, "relates": {"0001"}}}}
+
+	mockRepo.On("Save", "model", source).Return(nil)
+	mockRepo.On("Save", "model", target).Return(nil)
+
+	err := service.Unlink("model", source, target, "depends on", "required by")
+
+	assert.NoError(t, err)
+	assert.NotContains(t, source.Links.Custom, "depends on")
+	assert.NotContains(t, target.Links.Custom, "required by")
+	assert.Equal(t, []string{"0002"}, source.Links.Custom["relates"])
+	assert.Equal(t, []string{"0001"}, target.Links.Custom["relates"])
+}
+
+func TestUnlink_WithoutTagRemovesAllLinks(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+
+	source := &Decision{ID: "0001", Links: Links{Precedes: []string{"0002"}, Custom: map[string][]string{"relates": {"0002"}}}}
+	target := &Decision{ID: "0002", Links: Links{Succeeds: []string{"0001"}, Custom: map[string][]string{"relates": {"0001"}}}}
+
+	mockRepo.On("Save", "model", source).Return(nil)
+	mockRepo.On("Save", "model", target).Return(nil)
+
+	err := service.Unlink("model", source, target, "", "")
+
+	assert.NoError(t, err)
+	assert.Empty(t, source.Links.Precedes)
+	assert.Empty(t, source.Links.Custom)
+	assert.Empty(t, target.Links.Succeeds)
+	assert.Empty(t, target.Links.Custom)
+}
+
+func TestUnlink_NoMatchingLinkFails(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+
+	source := &Decision{ID: "0001", Links: Links{Precedes: []string{"0003"}}}
+	target := &Decision{ID: "0002"}
+
+	err := service.Unlink("model", source, target, "precedes", "succeeds")
+
+	assert.ErrorContains(t, err, "no matching link")
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
 func TestTag_AddsNewTag(t *testing.T) {
 	mockRepo := new(MockDecisionRepository)
 	service := NewDecisionService(mockRepo)
@@ -380,6 +658,76 @@ func TestTag_SaveFails(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestUntag_RemovesTag(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0001", Tags: []string{"security", "performance"}}
+
+	mockRepo.On("Save", "model", decision).Return(nil)
+
+	err := service.Untag("model", decision, "security")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"performance"}, decision.Tags)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUntag_MissingTagFails(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0001", Tags: []string{"security"}}
+
+	err := service.Untag("model", decision, "performance")
+
+	assert.ErrorContains(t, err, "does not exist")
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+func TestReplaceTags_MergesIntoTarget(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	mockRepo.On("LoadAllByIndex", "model").Return([]Decision{
+		{ID: "0001", Tags: []string{"sec", "performance"}},
+		{ID: "0002", Tags: []string{"security", "secu"}},
+		{ID: "0003", Tags: []string{"performance"}},
+	}, nil)
+	mockRepo.On("Save", "model", mock.MatchedBy(func(d *Decision) bool {
+		return d.ID == "0001" && slices.Equal(d.Tags, []string{"security", "performance"})
+	})).Return(nil).Once()
+	mockRepo.On("Save", "model", mock.MatchedBy(func(d *Decision) bool {
+		return d.ID == "0002" && slices.Equal(d.Tags, []string{"security"})
+	})).Return(nil).Once()
+
+	updated, err := service.ReplaceTags("model", []string{"sec", "secu"}, "security")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0001", "0002"}, updated)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReplaceTags_UnknownTagFails(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	mockRepo.On("LoadAllByIndex", "model").Return([]Decision{{ID: "0001", Tags: []string{"security"}}}, nil)
+
+	_, err := service.ReplaceTags("model", []string{"perf"}, "performance")
+
+	assert.ErrorContains(t, err, `no decision is tagged with "perf"`)
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+func TestReplaceTags_EmptyTargetFails(t *testing.T) {
+	service := NewDecisionService(new(MockDecisionRepository))
+
+	_, err := service.ReplaceTags("model", []string{"perf"}, " ")
+
+	assert.ErrorContains(t, err, "must not be empty")
+}
+
 func TestFilterDecisions_ByID(t *testing.T) {
 	service := &DecisionServiceImplementation{}
 	decisions := []Decision{
@@ -392,7 +740,7 @@ func TestFilterDecisions_ByID(t *testing.T) {
 		"id": {"0001,0003"},
 	}
 
-	filtered, err := service.FilterDecisions(decisions, filters)
+	filtered, err := service.FilterDecisions("model", decisions, filters)
 	assert.NoError(t, err)
 	assert.Len(t, filtered, 2)
 	assert.Equal(t, "0001", filtered[0].ID)
@@ -411,7 +759,7 @@ func TestFilterDecisions_ByTitle(t *testing.T) {
 		"title": {"Kafka"},
 	}
 
-	filtered, err := service.FilterDecisions(decisions, filters)
+	filtered, err := service.FilterDecisions("model", decisions, filters)
 	assert.NoError(t, err)
 	assert.Len(t, filtered, 1)
 	assert.Equal(t, "001", filtered[0].ID)
@@ -429,7 +777,7 @@ func TestFilterDecisions_ByTag(t *testing.T) {
 		"tag": {"infra"},
 	}
 
-	filtered, err := service.FilterDecisions(decisions, filters)
+	filtered, err := service.FilterDecisions("model", decisions, filters)
 	assert.NoError(t, err)
 	assert.Len(t, filtered, 2)
 	assert.Equal(t, "1", filtered[0].ID)
@@ -448,11 +796,111 @@ func TestFilterDecisions_ByStatus(t *testing.T) {
 		"status": {"open"},
 	}
 
-	filtered, err := service.FilterDecisions(decisions, filters)
+	filtered, err := service.FilterDecisions("model", decisions, filters)
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 2)
+	assert.Equal(t, "1", filtered[0].ID)
+	assert.Equal(t, "3", filtered[1].ID)
+}
+
+func TestFilterDecisions_ByPeople(t *testing.T) {
+	service := &DecisionServiceImplementation{}
+	decisions := []Decision{
+		{ID: "1", Deciders: []string{"Alice"}},
+		{ID: "2", Consulted: []string{"alice"}},
+		{ID: "3", Informed: []string{"bob"}},
+		{ID: "4", Deciders: []string{"alice"}, Informed: []string{"Bob"}},
+	}
+
+	filtered, err := service.FilterDecisions("model", decisions, map[string][]string{"decider": {"alice"}})
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 2)
+	assert.Equal(t, "1", filtered[0].ID)
+	assert.Equal(t, "4", filtered[1].ID)
+
+	filtered, err = service.FilterDecisions("model", decisions, map[string][]string{"decider": {"alice"}, "informed": {"bob"}})
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "4", filtered[0].ID)
+}
+
+func TestFilterDecisions_CombinesFiltersWithAnd(t *testing.T) {
+	service := &DecisionServiceImplementation{}
+	decisions := []Decision{
+		{ID: "1", Status: "open", Tags: []string{"security"}},
+		{ID: "2", Status: "open", Tags: []string{"frontend"}},
+		{ID: "3", Status: "decided", Tags: []string{"security"}},
+		{ID: "4", Status: "open", Tags: []string{"infra"}},
+	}
+
+	filtered, err := service.FilterDecisions("model", decisions, map[string][]string{"status": {"open"}, "tag": {"security", "infra"}})
 	assert.NoError(t, err)
 	assert.Len(t, filtered, 2)
 	assert.Equal(t, "1", filtered[0].ID)
+	assert.Equal(t, "4", filtered[1].ID)
+}
+
+func TestFilterDecisions_Where(t *testing.T) {
+	service := &DecisionServiceImplementation{}
+	decisions := []Decision{
+		{ID: "1", Status: "open", Tags: []string{"security"}},
+		{ID: "2", Status: "open", Links: Links{Precedes: []string{"0003"}}},
+		{ID: "3", Status: "decided", Tags: []string{"security"}},
+	}
+
+	filtered, err := service.FilterDecisions("model", decisions, map[string][]string{"where": {"status = open and not links"}, "tag": {"security"}})
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "1", filtered[0].ID)
+
+	_, err = service.FilterDecisions("model", decisions, map[string][]string{"where": {"status ="}})
+	assert.EqualError(t, err, `invalid query "status =": unexpected end of expression`)
+}
+
+func TestFilterDecisions_WhereLinkTypes(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+	decisions := []Decision{
+		{ID: "1", Links: Links{Custom: map[string][]string{"relates to": {"2"}}}},
+		{ID: "2", Links: Links{Custom: map[string][]string{"depends on": {"1"}}}},
+	}
+	mockRepo.On("LoadSettings", "model").Return(&ModelSettings{LinkTypes: map[string]LinkType{"blocks": {Inverse: "blocked by"}}}, nil)
+
+	filtered, err := service.FilterDecisions("model", decisions, map[string][]string{"where": {`links."relates to" or links."blocked by" or links."superseded by"`}})
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "1", filtered[0].ID)
+
+	_, err = service.FilterDecisions("model", decisions, map[string][]string{"where": {"links.preceeds"}})
+	assert.EqualError(t, err, `unknown link type "preceeds" in query "links.preceeds"`)
+	mockRepo.AssertNumberOfCalls(t, "LoadSettings", 2)
+}
+
+func TestFilterDecisions_ByDateRange(t *testing.T) {
+	service := &DecisionServiceImplementation{}
+	decisions := []Decision{
+		{ID: "1", Created: "2025-01-10", DecidedAt: "2025-02-01"},
+		{ID: "2", Created: "2025-03-05"},
+		{ID: "3", Created: "2025-06-30", DecidedAt: "2025-07-01"},
+	}
+
+	filtered, err := service.FilterDecisions("model", decisions, map[string][]string{"created": {"2025-03-01..2025-06-30"}})
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 2)
+	assert.Equal(t, "2", filtered[0].ID)
 	assert.Equal(t, "3", filtered[1].ID)
+
+	filtered, err = service.FilterDecisions("model", decisions, map[string][]string{"decided": {"..2025-06-30"}})
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "1", filtered[0].ID)
+}
+
+func TestFilterDecisions_InvalidDate(t *testing.T) {
+	service := &DecisionServiceImplementation{}
+
+	_, err := service.FilterDecisions("model", []Decision{{ID: "1"}}, map[string][]string{"created": {"01.02.2025"}})
+	assert.EqualError(t, err, `invalid filter date "01.02.2025", expected YYYY-MM-DD`)
 }
 
 func TestFilterDecisions_InvalidTitleRegex(t *testing.T) {
@@ -463,7 +911,7 @@ func TestFilterDecisions_InvalidTitleRegex(t *testing.T) {
 		"title": {"*["}, // invalid regex
 	}
 
-	filtered, err := service.FilterDecisions(decisions, filters)
+	filtered, err := service.FilterDecisions("model", decisions, filters)
 	assert.Nil(t, filtered)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid title regex")
@@ -477,12 +925,34 @@ func TestFilterDecisions_InvalidIDRange(t *testing.T) {
 		"id": {"0010-0005"}, // invalid range
 	}
 
-	filtered, err := service.FilterDecisions(decisions, filters)
+	filtered, err := service.FilterDecisions("model", decisions, filters)
 	assert.Nil(t, filtered)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid ID range")
 }
 
+func TestFilterDecisions_PrefixedIDRange(t *testing.T) {
+	service := &DecisionServiceImplementation{}
+	decisions := []Decision{{ID: "SEC-0002"}, {ID: "SEC-0009"}, {ID: "SEC-0010"}, {ID: "0009"}}
+
+	filtered, err := service.FilterDecisions("model", decisions, map[string][]string{"id": {"SEC-0003-SEC-0010"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []Decision{{ID: "SEC-0009"}, {ID: "SEC-0010"}}, filtered)
+
+	filtered, err = service.FilterDecisions("model", decisions, map[string][]string{"id": {"SEC-0002,SEC-0009-0009"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []Decision{{ID: "SEC-0002"}, {ID: "SEC-0009"}}, filtered)
+}
+
+func TestFilterDecisions_RangeBeyondFourDigits(t *testing.T) {
+	service := &DecisionServiceImplementation{}
+	decisions := []Decision{{ID: "9998"}, {ID: "9999"}, {ID: "10000"}, {ID: "10001"}}
+
+	filtered, err := service.FilterDecisions("model", decisions, map[string][]string{"id": {"9999-10000"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []Decision{{ID: "9999"}, {ID: "10000"}}, filtered)
+}
+
 func TestFilterDecisions_MultipleMatches(t *testing.T) {
 	service := &DecisionServiceImplementation{}
 	decisions := []Decision{
@@ -496,9 +966,16 @@ func TestFilterDecisions_MultipleMatches(t *testing.T) {
 		"status": {"closed"},
 	}
 
-	filtered, err := service.FilterDecisions(decisions, filters)
+	// every filter must match
+	filtered, err := service.FilterDecisions("model", decisions, filters)
 	assert.NoError(t, err)
-	assert.Len(t, filtered, 2)
+	assert.Empty(t, filtered)
+
+	filters["id"] = []string{"0001-0002"}
+	filtered, err = service.FilterDecisions("model", decisions, filters)
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "0002", filtered[0].ID)
 }
 
 func TestDecide_ExistingOption(t *testing.T) {
@@ -510,12 +987,13 @@ func TestDecide_ExistingOption(t *testing.T) {
 	option := "Option A"
 	rationale := "it’s the best fit"
 
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
 	mockRepo.On("OptionExists", modelPath, decision.ID, option).Return(true, nil)
 	mockRepo.On("ResolveOptionNumber", modelPath, decision.ID, option).Return(1, nil)
 	mockRepo.On("AppendOutcomeSection", modelPath, decision.ID, "We decided for [Option 1](#option-1) because: it’s the best fit").Return(nil)
 	mockRepo.On("Save", modelPath, decision).Return(nil)
 
-	err := service.Decide(modelPath, decision, option, rationale, false)
+	err := service.Decide(modelPath, decision, []string{option}, rationale, "", "", false)
 	assert.NoError(t, err)
 	assert.Equal(t, "decided", decision.Status)
 	mockRepo.AssertExpectations(t)
@@ -529,9 +1007,10 @@ func TestDecide_NonExistentOption_WithoutEnforce(t *testing.T) {
 	decision := &Decision{ID: "001"}
 	option := "non-existent"
 
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
 	mockRepo.On("OptionExists", modelPath, decision.ID, option).Return(false, nil)
 
-	err := service.Decide(modelPath, decision, option, "", false)
+	err := service.Decide(modelPath, decision, []string{option}, "", "", "", false)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "option does not exist")
 	mockRepo.AssertExpectations(t)
@@ -545,45 +1024,92 @@ func TestDecide_NumericOptionWithForce(t *testing.T) {
 	decision := &Decision{ID: "001"}
 	option := "2"
 
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
 	mockRepo.On("OptionExists", modelPath, decision.ID, option).Return(false, nil)
 
-	err := service.Decide(modelPath, decision, option, "", true)
+	err := service.Decide(modelPath, decision, []string{option}, "", "", "", true)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "cannot auto-create numeric option")
 	mockRepo.AssertExpectations(t)
 }
 
-func TestDecide_AppendOutcomeFails(t *testing.T) {
+func TestDecide_InvalidOptionAddsNoOption(t *testing.T) {
 	mockRepo := new(MockDecisionRepository)
 	service := NewDecisionService(mockRepo)
 
 	modelPath := "path"
 	decision := &Decision{ID: "001"}
-	option := "Option A"
 
-	mockRepo.On("OptionExists", modelPath, decision.ID, option).Return(true, nil)
-	mockRepo.On("ResolveOptionNumber", modelPath, decision.ID, option).Return(1, nil)
-	mockRepo.On("AppendOutcomeSection", modelPath, decision.ID, mock.Anything).Return(errors.New("write error"))
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
+	mockRepo.On("OptionExists", modelPath, decision.ID, "Kafka").Return(false, nil)
+	mockRepo.On("OptionExists", modelPath, decision.ID, "7").Return(false, nil)
 
-	err := service.Decide(modelPath, decision, option, "", false)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "write error")
-	mockRepo.AssertExpectations(t)
+	err := service.Decide(modelPath, decision, []string{"Kafka", "7"}, "", "", "", true)
+
+	assert.ErrorContains(t, err, "cannot auto-create numeric option")
+	mockRepo.AssertNotCalled(t, "UpdateSection", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "AppendOutcomeSection", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func TestRevise_Success(t *testing.T) {
+func TestDecide_AddsMissingOptionsTogether(t *testing.T) {
 	mockRepo := new(MockDecisionRepository)
 	service := NewDecisionService(mockRepo)
 
-	modelPath := "test/model"
-	original := &Decision{
-		ID:    "0001",
-		Title: "Make a choice",
-		Tags:  []string{"important"},
-	}
+	modelPath := "path"
+	decision := &Decision{ID: "001"}
 
-	content := &DecisionContent{
-		Outcome:  "Some outcome",
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
+	mockRepo.On("OptionExists", modelPath, decision.ID, mock.Anything).Return(false, nil)
+	mockRepo.On("LoadDecisionContent", modelPath, decision.ID).Return(&DecisionContent{Options: ""}, nil)
+	mockRepo.On("UpdateSection", modelPath, decision.ID, domain.AnchorSectionOptions, []string{
+		"",
+		"1. " + domain.AnchorForOption(1) + " Kafka",
+		"2. " + domain.AnchorForOption(2) + " RabbitMQ",
+	}).Return(nil).Once()
+	mockRepo.On("ResolveOptionNumber", modelPath, decision.ID, "Kafka").Return(1, nil)
+	mockRepo.On("ResolveOptionNumber", modelPath, decision.ID, "RabbitMQ").Return(2, nil)
+	mockRepo.On("AppendOutcomeSection", modelPath, decision.ID, mock.Anything).Return(nil)
+	mockRepo.On("Save", modelPath, decision).Return(nil)
+
+	err := service.Decide(modelPath, decision, []string{"Kafka", "RabbitMQ"}, "", "", "", true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, decision.ChosenOptions)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDecide_AppendOutcomeFails(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	modelPath := "path"
+	decision := &Decision{ID: "001"}
+	option := "Option A"
+
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
+	mockRepo.On("OptionExists", modelPath, decision.ID, option).Return(true, nil)
+	mockRepo.On("ResolveOptionNumber", modelPath, decision.ID, option).Return(1, nil)
+	mockRepo.On("AppendOutcomeSection", modelPath, decision.ID, mock.Anything).Return(errors.New("write error"))
+
+	err := service.Decide(modelPath, decision, []string{option}, "", "", "", false)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "write error")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRevise_Success(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	modelPath := "test/model"
+	original := &Decision{
+		ID:    "0001",
+		Title: "Make a choice",
+		Tags:  []string{"important"},
+	}
+
+	content := &DecisionContent{
+		Outcome:  "Some outcome",
 		Comments: "Some comments",
 	}
 
@@ -598,6 +1124,7 @@ func TestRevise_Success(t *testing.T) {
 		Comments: "",
 	}
 
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
 	mockRepo.On("LoadDecisionContent", modelPath, original.ID).Return(content, nil)
 	mockRepo.On("FindDecisionFile", modelPath, original.ID).
 		Return(filepath.Join(modelPath, original.ID, "index.md"), nil)
@@ -621,6 +1148,7 @@ func TestRevise_LoadContentError(t *testing.T) {
 	modelPath := "test/model"
 	original := &Decision{ID: "0001"}
 
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
 	mockRepo.On("LoadDecisionContent", modelPath, original.ID).
 		Return(nil, errors.New("failed to load"))
 
@@ -640,6 +1168,7 @@ func TestRevise_GetSubFolderPathError(t *testing.T) {
 
 	content := &DecisionContent{}
 
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
 	mockRepo.On("LoadDecisionContent", modelPath, original.ID).Return(content, nil)
 	mockRepo.On("FindDecisionFile", modelPath, original.ID).
 		Return("", errors.New("not found"))
@@ -680,16 +1209,22 @@ func TestComment_Success(t *testing.T) {
 		Comments: []Comment{},
 	}
 
+	var section []string
 	mockRepo.On("Save", modelPath, decision).Return(nil)
-	mockRepo.On("AppendCommentSection", modelPath, decision.ID, commentText, 1, author, mock.AnythingOfType("string")).
-		Return(nil)
+	mockRepo.On("UpdateSection", modelPath, decision.ID, domain.AnchorSectionComments, mock.Anything).Run(func(args mock.Arguments) {
+		section = args.Get(3).([]string)
+	}).Return(nil)
 
 	err := service.Comment(modelPath, decision, author, commentText)
 
 	assert.NoError(t, err)
 	assert.Len(t, decision.Comments, 1)
+	assert.Equal(t, 1, decision.Comments[0].ID)
 	assert.Equal(t, author, decision.Comments[0].Author)
-	assert.Equal(t, "1", decision.Comments[0].Comment)
+	assert.Equal(t, commentText, decision.Comments[0].Comment)
+	assert.Len(t, section, 1)
+	assert.Contains(t, section[0], `<a name="comment-1"></a>1. (`)
+	assert.True(t, strings.HasSuffix(section[0], ") Jane: Looks good"))
 
 	mockRepo.AssertExpectations(t)
 }
@@ -713,7 +1248,7 @@ func TestComment_SaveFails(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "save failed")
-	mockRepo.AssertNotCalled(t, "AppendCommentSection")
+	mockRepo.AssertNotCalled(t, "UpdateSection")
 }
 
 func TestComment_FailsToAppendSection(t *testing.T) {
@@ -730,13 +1265,13 @@ func TestComment_FailsToAppendSection(t *testing.T) {
 
 	mockRepo.On("Save", modelPath, decision).Return(nil)
 
-	mockRepo.On("AppendCommentSection", modelPath, decision.ID, commentText, 1, author, mock.AnythingOfType("string")).
+	mockRepo.On("UpdateSection", modelPath, decision.ID, domain.AnchorSectionComments, mock.Anything).
 		Return(errors.New("append error"))
 
 	err := service.Comment(modelPath, decision, author, commentText)
 
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to append comment section")
+	assert.Contains(t, err.Error(), "failed to update comment section")
 	mockRepo.AssertExpectations(t)
 }
 
@@ -927,3 +1462,1238 @@ func Test_appendOptions_OptionExistsError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "exist check error")
 }
+
+func TestDecide_TransitionNotAllowed(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	modelPath := "path"
+	decision := &Decision{ID: "001", Status: "rejected"}
+
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
+
+	err := service.Decide(modelPath, decision, []string{"1"}, "", "", "", false)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `status "rejected" cannot be decided`)
+	mockRepo.AssertNotCalled(t, "OptionExists", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTransition_Success(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	modelPath := "model"
+	decision := &Decision{ID: "0007", Status: "decided"}
+
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
+	mockRepo.On("Save", modelPath, decision).Return(nil)
+
+	err := service.Transition(modelPath, decision, "deprecated")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "deprecated", decision.Status)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransition_UnknownStatus(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	modelPath := "model"
+	decision := &Decision{ID: "0007", Status: "open"}
+
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
+
+	err := service.Transition(modelPath, decision, "archived-ish")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown status "archived-ish"`)
+	assert.Equal(t, "open", decision.Status)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestTransition_NotAllowed(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	modelPath := "model"
+	decision := &Decision{ID: "0007", Status: "open"}
+
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
+
+	err := service.Transition(modelPath, decision, "deprecated")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `cannot change status from "open" to "deprecated" (allowed: decided, rejected)`)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestTransition_FromFinalStatus(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	modelPath := "model"
+	decision := &Decision{ID: "0007", Status: "superseded"}
+
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
+
+	err := service.Transition(modelPath, decision, "decided")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "final status")
+}
+
+func TestTransition_SameStatus(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	modelPath := "model"
+	decision := &Decision{ID: "0007", Status: "decided"}
+
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
+
+	err := service.Transition(modelPath, decision, "decided")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `already has status "decided"`)
+}
+
+func TestSupersede_Success(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	modelPath := "model"
+	original := &Decision{ID: "0003", Status: "decided"}
+	replacement := &Decision{ID: "0011", Title: "use managed database", Status: "open"}
+
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
+	mockRepo.On("Save", modelPath, original).Return(nil)
+	mockRepo.On("Save", modelPath, replacement).Return(nil)
+	mockRepo.On("FindDecisionFile", modelPath, "0003").Return("model/db/AD0003-use-database.md", nil)
+	mockRepo.On("FindDecisionFile", modelPath, "0011").Return("model/AD0011-use-managed-database.md", nil)
+	mockRepo.On("SetNotice", modelPath, "0003", domain.AnchorNoticeSuperseded, mock.MatchedBy(func(lines []string) bool {
+		return len(lines) == 1 &&
+			strings.HasPrefix(lines[0], "> "+domain.AnchorForSection(domain.AnchorNoticeSuperseded)) &&
+			strings.Contains(lines[0], "[0011 use managed database](../AD0011-use-managed-database.md)")
+	})).Return(nil)
+
+	err := service.Supersede(modelPath, original, replacement)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "superseded", original.Status)
+	assert.Equal(t, []string{"0011"}, original.Links.Custom["superseded by"])
+	assert.Equal(t, []string{"0003"}, replacement.Links.Custom["supersedes"])
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSupersede_Self(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0003", Status: "decided"}
+
+	err := service.Supersede("model", decision, decision)
+
+	assert.ErrorContains(t, err, "cannot supersede itself")
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+func TestSupersede_TransitionNotAllowed(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	original := &Decision{ID: "0003", Status: "open"}
+	replacement := &Decision{ID: "0011"}
+
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+
+	err := service.Supersede("model", original, replacement)
+
+	assert.ErrorContains(t, err, `cannot change status from "open" to "superseded"`)
+	assert.Equal(t, "open", original.Status)
+	mockRepo.AssertNotCalled(t, "Save")
+	mockRepo.AssertNotCalled(t, "SetNotice")
+}
+
+func TestSupersede_InvalidLinkKeepsStatus(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	settings := DefaultModelSettings()
+	settings.LinkTypes = map[string]LinkType{"superseded by": {Inverse: "supersedes", Cardinality: CardinalityManyToOne}}
+	original := &Decision{ID: "0003", Status: "decided", Links: Links{Custom: map[string][]string{"superseded by": {"0012"}}}}
+	replacement := &Decision{ID: "0011"}
+
+	mockRepo.On("LoadSettings", "model").Return(settings, nil)
+
+	err := service.Supersede("model", original, replacement)
+
+	assert.ErrorContains(t, err, "failed to link replacement decision")
+	assert.Equal(t, "decided", original.Status)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "SetNotice", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRemove_StripsIncomingLinks(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	modelPath := "model"
+	target := &Decision{ID: "0003"}
+	all := []Decision{
+		{ID: "0001", Links: Links{Precedes: []string{"0003", "0004"}}},
+		{ID: "0002", Links: Links{Precedes: []string{"0004"}}},
+		{ID: "0003", Links: Links{Succeeds: []string{"0001"}}},
+		{ID: "0004", Links: Links{Succeeds: []string{"0001", "0002"}, Custom: map[string][]string{"supersedes": {"0003"}}}},
+	}
+
+	mockRepo.On("LoadAllByIndex", modelPath).Return(all, nil)
+	mockRepo.On("Save", modelPath, mock.MatchedBy(func(d *Decision) bool {
+		return d.ID == "0001" && slices.Equal(d.Links.Precedes, []string{"0004"})
+	})).Return(nil).Once()
+	mockRepo.On("Save", modelPath, mock.MatchedBy(func(d *Decision) bool {
+		return d.ID == "0004" && len(d.Links.Custom["supersedes"]) == 0
+	})).Return(nil).Once()
+	mockRepo.On("Delete", modelPath, "0003").Return(nil)
+
+	updated, err := service.Remove(modelPath, target, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0001", "0004"}, updated)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRemove_DeleteFails(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	mockRepo.On("LoadAllByIndex", "model").Return([]Decision{{ID: "0003"}}, nil)
+	mockRepo.On("Delete", "model", "0003").Return(errors.New("permission denied"))
+
+	_, err := service.Remove("model", &Decision{ID: "0003"}, false)
+
+	assert.ErrorContains(t, err, "failed to delete decision")
+}
+
+func TestRemove_SupersedingDecisionNeedsForce(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	target := &Decision{ID: "0004", Links: Links{Custom: map[string][]string{"supersedes": {"0003"}}}}
+
+	_, err := service.Remove("model", target, false)
+
+	assert.ErrorContains(t, err, "decision 0004 supersedes 0003, use --force")
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestRemove_ForceRewritesSupersededNotice(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	modelPath := "model"
+	target := &Decision{ID: "0004", Title: "Use Postgres", Links: Links{Custom: map[string][]string{"supersedes": {"0003"}}}}
+	all := []Decision{
+		{ID: "0003", Status: "superseded", Links: Links{Custom: map[string][]string{"superseded by": {"0004"}}}},
+		*target,
+	}
+
+	mockRepo.On("LoadAllByIndex", modelPath).Return(all, nil)
+	mockRepo.On("Save", modelPath, mock.MatchedBy(func(d *Decision) bool {
+		return d.ID == "0003" && d.Status == "superseded" && len(d.Links.Custom) == 0
+	})).Return(nil).Once()
+	mockRepo.On("SetNotice", modelPath, "0003", domain.AnchorNoticeSuperseded, []string{
+		`> <a name="superseded-notice"></a> **Superseded** by the decision "Use Postgres", which was removed. This decision is no longer in force.`,
+	}).Return(nil)
+	mockRepo.On("Delete", modelPath, "0004").Return(nil)
+
+	updated, err := service.Remove(modelPath, target, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0003"}, updated)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestArchive_SetsStatusAndMovesDecision(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	modelPath := "model"
+	target := &Decision{ID: "0003", Status: "decided"}
+	all := []Decision{
+		{ID: "0001", Links: Links{Custom: map[string][]string{"relates": {"0003"}}}},
+		{ID: "0003", Status: "decided"},
+	}
+
+	mockRepo.On("LoadSettings", modelPath).Return(DefaultModelSettings(), nil)
+	mockRepo.On("LoadAllByIndex", modelPath).Return(all, nil)
+	mockRepo.On("Save", modelPath, mock.MatchedBy(func(d *Decision) bool { return d.ID == "0001" })).Return(nil).Once()
+	mockRepo.On("Save", modelPath, target).Return(nil).Once()
+	mockRepo.On("Archive", modelPath, "0003").Return(nil)
+
+	updated, err := service.Archive(modelPath, target, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0001"}, updated)
+	assert.Equal(t, "archived", target.Status)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRemoveLinksTo_NoLinks(t *testing.T) {
+	links := Links{Precedes: []string{"0002"}, Custom: map[string][]string{"relates": {"0004"}}}
+
+	changed := removeLinksTo(&links, "0003")
+
+	assert.False(t, changed)
+	assert.Equal(t, []string{"0002"}, links.Precedes)
+	assert.Equal(t, []string{"0004"}, links.Custom["relates"])
+}
+
+func TestRename_Success(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0004", Title: "old title"}
+
+	mockRepo.On("LoadAllByIndex", "model").Return([]Decision{{ID: "0004", Title: "old title"}, {ID: "0005", Title: "other"}}, nil)
+	mockRepo.On("Rename", "model", decision).Return(nil)
+
+	err := service.Rename("model", decision, "  New title ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "New title", decision.Title)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRename_TitleTaken(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0004", Title: "old title"}
+
+	mockRepo.On("LoadAllByIndex", "model").Return([]Decision{{ID: "0005", Title: "Other"}}, nil)
+
+	err := service.Rename("model", decision, "other")
+
+	assert.ErrorContains(t, err, `decision 0005 already has the title "Other"`)
+	assert.Equal(t, "old title", decision.Title)
+	mockRepo.AssertNotCalled(t, "Rename")
+}
+
+func TestRename_InvalidTitle(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	err := service.Rename("model", &Decision{ID: "0004", Title: "old"}, "1234")
+
+	assert.ErrorContains(t, err, "title must contain at least one letter")
+}
+
+func TestRename_UpdatesSupersededNotice(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0011", Title: "old title", Links: Links{Custom: map[string][]string{"supersedes": {"0003"}}}}
+	superseded := &Decision{ID: "0003", Status: "superseded"}
+
+	mockRepo.On("LoadAllByIndex", "model").Return([]Decision{*superseded}, nil)
+	mockRepo.On("Rename", "model", decision).Return(nil)
+	mockRepo.On("LoadById", "model", "0003").Return(superseded, nil)
+	mockRepo.On("FindDecisionFile", "model", "0003").Return("model/AD0003-a.md", nil)
+	mockRepo.On("FindDecisionFile", "model", "0011").Return("model/AD0011-new-title.md", nil)
+	mockRepo.On("SetNotice", "model", "0003", domain.AnchorNoticeSuperseded, mock.MatchedBy(func(lines []string) bool {
+		return len(lines) == 1 && strings.Contains(lines[0], "[0011 New title](AD0011-new-title.md)")
+	})).Return(nil)
+
+	err := service.Rename("model", decision, "New title")
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRenumber_RemapsIDsAndLinks(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	all := []Decision{
+		{ID: "0002", Title: "A", Links: Links{Precedes: []string{"0005"}}},
+		{ID: "0005", Title: "B", Links: Links{Succeeds: []string{"0002"}}},
+	}
+
+	mockRepo.On("LoadAllByData", "model").Return(all, nil)
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+	mockRepo.On("ExistingIDs", "model").Return([]string{"0001", "0002", "0005"}, nil)
+	mockRepo.On("Renumber", "model", map[string]string{"0005": "0003"}, []Decision{
+		{ID: "0002", Title: "A", Links: Links{Precedes: []string{"0003"}}},
+		{ID: "0003", Title: "B", Links: Links{Succeeds: []string{"0002"}}},
+	}).Return(nil)
+
+	changes, err := service.Renumber("model", RenumberByID, nil, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []IDChange{{From: "0005", To: "0003", Title: "B"}}, changes)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRenumber_DryRunDoesNotWrite(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	mockRepo.On("LoadAllByData", "model").Return([]Decision{{ID: "0004", Title: "A"}}, nil)
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+	mockRepo.On("ExistingIDs", "model").Return([]string{"0004"}, nil)
+
+	changes, err := service.Renumber("model", RenumberByID, nil, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []IDChange{{From: "0004", To: "0001", Title: "A"}}, changes)
+	mockRepo.AssertNotCalled(t, "Renumber", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRenumber_RepositoryError(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	mockRepo.On("LoadAllByData", "model").Return([]Decision{{ID: "0002"}}, nil)
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+	mockRepo.On("ExistingIDs", "model").Return([]string{"0002"}, nil)
+	mockRepo.On("Renumber", "model", mock.Anything, mock.Anything).Return(errors.New("disk full"))
+
+	_, err := service.Renumber("model", RenumberByID, nil, false)
+
+	assert.EqualError(t, err, "failed to renumber decisions: disk full")
+}
+
+func TestResolveCollisions_MovesNewerDecision(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	base := Decision{ID: "0001", Links: Links{Precedes: []string{"0002"}}}
+	older := Decision{ID: "0002", Title: "Older", Created: "2026-10-01"}
+	newer := Decision{ID: "0002", Title: "Newer", Created: "2026-10-18", Links: Links{Succeeds: []string{"0001"}}}
+
+	mockRepo.On("LoadAllByData", "model").Return([]Decision{base, older, newer}, nil)
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+	mockRepo.On("ExistingIDs", "model").Return([]string{"0001", "0002", "0002"}, nil)
+	mockRepo.On("LoadDecisionFiles", "model", "0002").Return([]DecisionFile{
+		{Path: "model/AD0002-newer.md", Decision: newer},
+		{Path: "model/AD0002-older.md", Decision: older},
+	}, nil)
+	mockRepo.On("MoveDecisionFile", "model", "model/AD0002-newer.md", mock.MatchedBy(func(d *Decision) bool {
+		return d.ID == "0003" && d.Title == "Newer"
+	})).Return(nil)
+	mockRepo.On("Save", "model", mock.MatchedBy(func(d *Decision) bool {
+		return d.ID == "0001" && slices.Equal(d.Links.Precedes, []string{"0003"})
+	})).Return(nil)
+
+	changes, ambiguous, err := service.ResolveCollisions("model", false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []IDChange{{From: "0002", To: "0003", Title: "Newer"}}, changes)
+	assert.Empty(t, ambiguous)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestResolveCollisions_NoDuplicates(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	mockRepo.On("LoadAllByData", "model").Return([]Decision{{ID: "0001"}, {ID: "0002"}}, nil)
+
+	changes, _, err := service.ResolveCollisions("model", false)
+
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+	mockRepo.AssertNotCalled(t, "LoadSettings", mock.Anything)
+}
+
+func TestResolveCollisions_DryRunDoesNotWrite(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	a := Decision{ID: "0001", Title: "A"}
+	b := Decision{ID: "0001", Title: "B"}
+
+	mockRepo.On("LoadAllByData", "model").Return([]Decision{a, b}, nil)
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+	mockRepo.On("ExistingIDs", "model").Return([]string{"0001", "0001"}, nil)
+	mockRepo.On("LoadDecisionFiles", "model", "0001").Return([]DecisionFile{
+		{Path: "model/AD0001-a.md", Decision: a},
+		{Path: "model/AD0001-b.md", Decision: b},
+	}, nil)
+
+	changes, _, err := service.ResolveCollisions("model", true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []IDChange{{From: "0001", To: "0002", Title: "B"}}, changes)
+	mockRepo.AssertNotCalled(t, "MoveDecisionFile", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEdit_ReplaceQuestionAndAppendCriteria(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	question := "Which database?\nIt must scale."
+	criteria := "And secure"
+	decision := &Decision{ID: "0001"}
+
+	mockRepo.On("UpdateSection", "model", "0001", domain.AnchorSectionQuestion, []string{"Which database?", "It must scale."}).Return(nil)
+	mockRepo.On("LoadDecisionContent", "model", "0001").Return(&DecisionContent{Criteria: "Must be fast"}, nil)
+	mockRepo.On("UpdateSection", "model", "0001", domain.AnchorSectionCriteria, []string{"Must be fast", "And secure"}).Return(nil)
+
+	err := service.Edit("model", decision, ContentEdit{Question: &question, ReplaceQuestion: true, Criteria: &criteria})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestEdit_RemoveOptionRenumbersOutcome(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	content := &DecisionContent{
+		Options: "1. <a name=\"option-1\"></a> A\n2. <a name=\"option-2\"></a> B",
+		Outcome: "We decided for [Option 2](#option-2).",
+	}
+
+	mockRepo.On("LoadDecisionContent", "model", "0001").Return(content, nil)
+	mockRepo.On("UpdateSection", "model", "0001", domain.AnchorSectionOptions, []string{"1. <a name=\"option-1\"></a> B"}).Return(nil)
+	mockRepo.On("UpdateSection", "model", "0001", domain.AnchorSectionOutcome, []string{"We decided for [Option 1](#option-1)."}).Return(nil)
+
+	err := service.Edit("model", &Decision{ID: "0001"}, ContentEdit{RemoveOptions: []string{"A"}})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestEdit_RemoveChosenOptionFails(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	content := &DecisionContent{
+		Options: "1. <a name=\"option-1\"></a> A\n2. <a name=\"option-2\"></a> B",
+		Outcome: "We decided for [Option 2](#option-2).",
+	}
+
+	mockRepo.On("LoadDecisionContent", "model", "0001").Return(content, nil)
+
+	err := service.Edit("model", &Decision{ID: "0001"}, ContentEdit{RemoveOptions: []string{"2"}})
+
+	assert.ErrorContains(t, err, "referenced by the outcome")
+	mockRepo.AssertNotCalled(t, "UpdateSection")
+}
+
+func TestEdit_AddOptionsWithPro(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	content := &DecisionContent{Options: "1. <a name=\"option-1\"></a> Sessions"}
+
+	mockRepo.On("LoadDecisionContent", "model", "0001").Return(content, nil)
+	mockRepo.On("UpdateSection", "model", "0001", domain.AnchorSectionOptions, []string{
+		"1. <a name=\"option-1\"></a> Sessions",
+		"2. <a name=\"option-2\"></a> OAuth",
+		"",
+		"   - Pro: standard",
+		"",
+		"3. <a name=\"option-3\"></a> Kafka sessions",
+	}).Return(nil)
+
+	err := service.Edit("model", &Decision{ID: "0001"}, ContentEdit{
+		AddOptions: []string{"OAuth", "Kafka sessions"},
+		AddPros:    []OptionText{{Option: "2", Text: "standard"}},
+	})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestEdit_InvalidOptionWritesNothing(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	question := "Which login?"
+	content := &DecisionContent{Options: "1. <a name=\"option-1\"></a> Sessions"}
+
+	mockRepo.On("LoadDecisionContent", "model", "0001").Return(content, nil)
+
+	err := service.Edit("model", &Decision{ID: "0001"}, ContentEdit{
+		Question:   &question,
+		AddOptions: []string{"OAuth"},
+		AddPros:    []OptionText{{Option: "3", Text: "standard"}},
+	})
+
+	assert.ErrorContains(t, err, "option number 3 not found")
+	mockRepo.AssertNotCalled(t, "UpdateSection")
+}
+
+func TestReopen_MovesOutcomeToHistory(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0005", Status: "decided", ChosenOptions: []int{2}, ReviewBy: "2030-01-01"}
+	content := &DecisionContent{
+		Outcome:          "We decided for [Option 2](#option-2).",
+		PreviousOutcomes: "### Reopened on 2026-01-01 10:00:00\nWe decided for [Option 1](#option-1).",
+	}
+
+	var history []string
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+	mockRepo.On("LoadDecisionContent", "model", "0005").Return(content, nil)
+	mockRepo.On("UpdateSection", "model", "0005", domain.AnchorSectionPreviousOutcomes, mock.Anything).Run(func(args mock.Arguments) {
+		history = args.Get(3).([]string)
+	}).Return(nil)
+	mockRepo.On("RemoveSection", "model", "0005", domain.AnchorSectionOutcome).Return(nil)
+	mockRepo.On("Save", "model", decision).Return(nil)
+
+	err := service.Reopen("model", decision, "typo")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "open", decision.Status)
+	assert.Nil(t, decision.ChosenOptions)
+	assert.Empty(t, decision.ReviewBy)
+	assert.Len(t, history, 7)
+	assert.Equal(t, "We decided for [Option 1](#option-1).", history[1])
+	assert.True(t, strings.HasPrefix(history[3], "### Reopened on "))
+	assert.Equal(t, "We decided for [Option 2](#option-2).", history[4])
+	assert.Equal(t, "Reason for reopening: typo", history[6])
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReopen_WithoutOutcome(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0005", Status: "decided"}
+
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+	mockRepo.On("LoadDecisionContent", "model", "0005").Return(&DecisionContent{}, nil)
+	mockRepo.On("Save", "model", decision).Return(nil)
+
+	err := service.Reopen("model", decision, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "open", decision.Status)
+	mockRepo.AssertNotCalled(t, "UpdateSection")
+	mockRepo.AssertNotCalled(t, "RemoveSection")
+}
+
+func TestReopen_NotDecided(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0005", Status: "open"}
+
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+
+	err := service.Reopen("model", decision, "")
+
+	assert.ErrorContains(t, err, "can be reopened")
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+func TestReopen_IgnoresLifecycleTransitions(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	settings := DefaultModelSettings()
+	settings.Lifecycle = Lifecycle{
+		Initial:     "proposed",
+		Decided:     "accepted",
+		Superseded:  "superseded",
+		Archived:    "archived",
+		States:      []string{"proposed", "accepted", "superseded"},
+		Transitions: map[string][]string{"proposed": {"accepted"}, "accepted": {"superseded"}},
+	}
+	decision := &Decision{ID: "0005", Status: "accepted"}
+
+	mockRepo.On("LoadSettings", "model").Return(settings, nil)
+	mockRepo.On("LoadDecisionContent", "model", "0005").Return(&DecisionContent{}, nil)
+	mockRepo.On("Save", "model", decision).Return(nil)
+
+	err := service.Reopen("model", decision, "")
+
+	assert.NoError(t, err)
+	assert.False(t, settings.Lifecycle.CanTransition("accepted", "proposed"))
+	assert.Equal(t, "proposed", decision.Status)
+}
+
+func TestScore_UpdatesScoresAndMatrix(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0002"}
+	content := &DecisionContent{
+		Options:  "1. <a name=\"option-1\"></a> A\n2. <a name=\"option-2\"></a> B",
+		Criteria: "1. <a name=\"criterion-1\"></a> Performance (weight: 2)",
+	}
+
+	mockRepo.On("LoadDecisionContent", "model", "0002").Return(content, nil)
+	mockRepo.On("Save", "model", decision).Return(nil)
+	mockRepo.On("UpdateSection", "model", "0002", domain.AnchorSectionDecisionMatrix, []string{
+		"| Option | [Performance](#criterion-1) (weight 2) | Weighted score |",
+		"| --- | ---: | ---: |",
+		"| [A](#option-1) | - | 0 |",
+		"| [B](#option-2) | 4 | 8 |",
+	}).Return(nil)
+
+	err := service.Score("model", decision, "B", "performance", 4)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]map[string]int{"2": {"1": 4}}, decision.Scores)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestScore_OutOfRange(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	err := service.Score("model", &Decision{ID: "0002"}, "1", "1", 6)
+
+	assert.EqualError(t, err, "score must be between 0 and 5, got 6")
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+func TestRankOptions_NoScores(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	_, err := service.RankOptions("model", &Decision{ID: "0002"})
+
+	assert.EqualError(t, err, "decision 0002 has no scores, score its options against the criteria first")
+}
+
+func TestRecordRecommendation_AppendsToOutcome(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	mockRepo.On("LoadDecisionContent", "model", "0002").Return(&DecisionContent{Outcome: "We decided for [Option 2](#option-2)."}, nil)
+	mockRepo.On("UpdateSection", "model", "0002", domain.AnchorSectionOutcome, []string{
+		"We decided for [Option 2](#option-2).",
+		"",
+		"The decision matrix recommended [Option 2](#option-2) (B) with a weighted score of 8.",
+	}).Return(nil)
+
+	err := service.RecordRecommendation("model", &Decision{ID: "0002"}, OptionScore{Option: Option{Number: 2, Title: "B"}, Total: 8})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDecide_RecordsDateAndDecider(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0001", Status: "open", Deciders: []string{"alice"}}
+
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+	mockRepo.On("OptionExists", "model", "0001", "1").Return(true, nil)
+	mockRepo.On("ResolveOptionNumber", "model", "0001", "1").Return(1, nil)
+	mockRepo.On("AppendOutcomeSection", "model", "0001", mock.Anything).Return(nil)
+	mockRepo.On("Save", "model", decision).Return(nil)
+
+	err := service.Decide("model", decision, []string{"1"}, "", "bob", "", false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Now().Format(DateLayout), decision.DecidedAt)
+	assert.Equal(t, []string{"alice", "bob"}, decision.Deciders)
+}
+
+func TestEditMetadata_ReplacesFields(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0001", Created: "2025-01-01", Deciders: []string{"alice"}, Informed: []string{"team"}}
+	created := "2024-12-24"
+	deciders := []string{" bob ", "carol", "bob", ""}
+	informed := []string{}
+
+	mockRepo.On("Save", "model", decision).Return(nil)
+
+	err := service.EditMetadata("model", decision, MetadataEdit{Created: &created, Deciders: &deciders, Informed: &informed})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-12-24", decision.Created)
+	assert.Equal(t, []string{"bob", "carol"}, decision.Deciders)
+	assert.Empty(t, decision.Informed)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestEditMetadata_InvalidDate(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0001", Created: "2025-01-01"}
+	decidedAt := "yesterday"
+	deciders := []string{"bob"}
+
+	err := service.EditMetadata("model", decision, MetadataEdit{DecidedAt: &decidedAt, Deciders: &deciders})
+
+	assert.EqualError(t, err, `invalid decided_at date "yesterday", expected YYYY-MM-DD`)
+	assert.Empty(t, decision.Deciders)
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+func TestSetFields_ParsesAndRemoves(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	settings := DefaultModelSettings()
+	settings.Fields = map[string]FieldDefinition{
+		"risk":        {Type: FieldTypeString, Values: []string{"low", "high"}},
+		"cost_center": {Type: FieldTypeNumber},
+	}
+	decision := &Decision{ID: "0003", Fields: map[string]any{"risk": "low"}}
+
+	mockRepo.On("LoadSettings", "model").Return(settings, nil)
+	mockRepo.On("Save", "model", decision).Return(nil)
+
+	err := service.SetFields("model", decision, map[string]string{"risk": "", "cost_center": "4711"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"risk": nil, "cost_center": 4711}, decision.Fields)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSetFields_Errors(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	settings := DefaultModelSettings()
+	settings.Fields = map[string]FieldDefinition{
+		"risk": {Type: FieldTypeString, Values: []string{"low", "high"}, Required: true},
+	}
+	decision := &Decision{ID: "0003"}
+
+	mockRepo.On("LoadSettings", "model").Return(settings, nil)
+
+	err := service.SetFields("model", decision, map[string]string{"owner": "jane"})
+	assert.EqualError(t, err, `field "owner" is not declared in the fields of model.yaml`)
+
+	err = service.SetFields("model", decision, map[string]string{"risk": "medium"})
+	assert.EqualError(t, err, `invalid value for field "risk": "medium" is not allowed (allowed: low, high)`)
+
+	err = service.SetFields("model", decision, map[string]string{"risk": ""})
+	assert.EqualError(t, err, `field "risk" is required and cannot be removed`)
+
+	assert.Nil(t, decision.Fields)
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+func TestEdit_NamedSections(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	content := &DecisionContent{Sections: []Section{{Anchor: "consequences", Title: "Consequences", Body: "Backups needed"}}}
+
+	mockRepo.On("LoadDecisionContent", "model", "0001").Return(content, nil)
+	mockRepo.On("UpdateSection", "model", "0001", "consequences", []string{"Backups needed", "Monitoring needed"}).Return(nil)
+	mockRepo.On("UpdateSection", "model", "0001", "assumptions", []string{"Low load"}).Return(nil)
+	mockRepo.On("RemoveSection", "model", "0001", "notes").Return(nil)
+
+	err := service.Edit("model", &Decision{ID: "0001"}, ContentEdit{
+		Sections: []SectionEdit{
+			{Anchor: "consequences", Text: "Monitoring needed"},
+			{Anchor: "assumptions", Text: "Low load", Replace: true},
+		},
+		RemoveSections: []string{"notes"},
+	})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestEdit_NamedSectionErrors(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	err := service.Edit("model", &Decision{ID: "0001"}, ContentEdit{Sections: []SectionEdit{{Anchor: "comments", Text: "x"}}})
+	assert.EqualError(t, err, `section "comments" is maintained by adg and cannot be edited with --section`)
+
+	err = service.Edit("model", &Decision{ID: "0001"}, ContentEdit{RemoveSections: []string{"criteria"}})
+	assert.EqualError(t, err, `section "criteria" is required and cannot be removed`)
+
+	mockRepo.AssertNotCalled(t, "UpdateSection")
+	mockRepo.AssertNotCalled(t, "RemoveSection")
+}
+
+func TestRevise_KeepsCustomSections(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	original := &Decision{ID: "0001", Title: "db", Status: "decided"}
+	content := &DecisionContent{
+		Outcome: "We decided for [Option 1](#option-1).",
+		Sections: []Section{
+			{Anchor: "outcome", Title: "Outcome", Body: "We decided for [Option 1](#option-1)."},
+			{Anchor: "consequences", Title: "Consequences", Body: "Backups needed"},
+		},
+	}
+
+	var created *DecisionContent
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+	mockRepo.On("LoadDecisionContent", "model", "0001").Return(content, nil)
+	mockRepo.On("FindDecisionFile", "model", "0001").Return(filepath.Join("model", "AD0001-db.md"), nil)
+	mockRepo.On("Create", "model", ".", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(3).(*DecisionContent)
+	}).Return(&Decision{ID: "0002"}, nil)
+
+	_, err := service.Revise("model", original)
+
+	assert.NoError(t, err)
+	sections := created.OrderedSections()
+	assert.Equal(t, "consequences", sections[len(sections)-1].Anchor)
+	assert.Equal(t, "", created.Section("outcome"))
+}
+
+func TestDecide_MultipleOptions(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0001", Status: "open"}
+
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+	mockRepo.On("OptionExists", "model", "0001", mock.Anything).Return(true, nil)
+	mockRepo.On("ResolveOptionNumber", "model", "0001", "Caching").Return(1, nil)
+	mockRepo.On("ResolveOptionNumber", "model", "0001", "3").Return(3, nil)
+	mockRepo.On("ResolveOptionNumber", "model", "0001", "1").Return(1, nil)
+	mockRepo.On("AppendOutcomeSection", "model", "0001",
+		"We decided for a combination of options:\n\n- [Option 1](#option-1)\n- [Option 3](#option-3)\n\nRationale: they complement each other").Return(nil)
+	mockRepo.On("Save", "model", decision).Return(nil)
+
+	err := service.Decide("model", decision, []string{"Caching", "3", "1"}, "they complement each other", "", "", false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, decision.ChosenOptions)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDecide_NoOption(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+
+	err := service.Decide("model", &Decision{ID: "0001", Status: "open"}, nil, "", "", "", false)
+
+	assert.EqualError(t, err, "at least one option must be chosen")
+	mockRepo.AssertNotCalled(t, "AppendOutcomeSection")
+}
+
+func TestEdit_ReorderOptionsRenumbersChosenOptions(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	content := &DecisionContent{
+		Options: "1. <a name=\"option-1\"></a> A\n2. <a name=\"option-2\"></a> B\n3. <a name=\"option-3\"></a> C",
+		Outcome: "We decided for a combination of options:\n\n- [Option 1](#option-1)\n- [Option 3](#option-3)",
+	}
+	decision := &Decision{ID: "0001", ChosenOptions: []int{1, 3}}
+
+	mockRepo.On("LoadDecisionContent", "model", "0001").Return(content, nil)
+	mockRepo.On("UpdateSection", "model", "0001", domain.AnchorSectionOptions, mock.Anything).Return(nil)
+	mockRepo.On("UpdateSection", "model", "0001", domain.AnchorSectionOutcome,
+		[]string{"We decided for a combination of options:", "", "- [Option 2](#option-2)", "- [Option 1](#option-1)"}).Return(nil)
+	mockRepo.On("Save", "model", decision).Return(nil)
+
+	err := service.Edit("model", decision, ContentEdit{OptionOrder: []string{"3", "1", "2"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 1}, decision.ChosenOptions)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDecide_ReviewByFromInterval(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	settings := DefaultModelSettings()
+	settings.ReviewInterval = "1y"
+	decision := &Decision{ID: "0001", Status: "open"}
+
+	mockRepo.On("LoadSettings", "model").Return(settings, nil)
+	mockRepo.On("OptionExists", "model", "0001", "1").Return(true, nil)
+	mockRepo.On("ResolveOptionNumber", "model", "0001", "1").Return(1, nil)
+	mockRepo.On("AppendOutcomeSection", "model", "0001", mock.Anything).Return(nil)
+	mockRepo.On("Save", "model", decision).Return(nil)
+
+	err := service.Decide("model", decision, []string{"1"}, "", "", "", false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Now().AddDate(1, 0, 0).Format(DateLayout), decision.ReviewBy)
+}
+
+func TestDecide_ExplicitReviewBy(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	settings := DefaultModelSettings()
+	settings.ReviewInterval = "1y"
+	decision := &Decision{ID: "0001", Status: "open"}
+
+	mockRepo.On("LoadSettings", "model").Return(settings, nil)
+	mockRepo.On("OptionExists", "model", "0001", "1").Return(true, nil)
+	mockRepo.On("ResolveOptionNumber", "model", "0001", "1").Return(1, nil)
+	mockRepo.On("AppendOutcomeSection", "model", "0001", mock.Anything).Return(nil)
+	mockRepo.On("Save", "model", decision).Return(nil)
+
+	err := service.Decide("model", decision, []string{"1"}, "", "", "2030-01-01", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "2030-01-01", decision.ReviewBy)
+
+	err = service.Decide("model", &Decision{ID: "0001", Status: "open"}, []string{"1"}, "", "", "next year", false)
+	assert.EqualError(t, err, `invalid review_by date "next year", expected YYYY-MM-DD`)
+}
+
+func TestReview(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	settings := DefaultModelSettings()
+	settings.ReviewInterval = "6m"
+	decision := &Decision{ID: "0004", Status: "decided", ReviewBy: "2025-01-01"}
+
+	mockRepo.On("LoadSettings", "model").Return(settings, nil)
+	mockRepo.On("Save", "model", decision).Return(nil)
+
+	err := service.Review("model", decision, ReviewStillValid, "")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Now().AddDate(0, 6, 0).Format(DateLayout), decision.ReviewBy)
+
+	err = service.Review("model", decision, ReviewNeedsRevision, "2030-06-30")
+	assert.NoError(t, err)
+	assert.Equal(t, "2030-06-30", decision.ReviewBy)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReview_Errors(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+
+	err := service.Review("model", &Decision{ID: "0004", Status: "decided"}, ReviewStillValid, "")
+	assert.EqualError(t, err, "no review_interval is set in model.yaml, provide the next review date with --next")
+
+	err = service.Review("model", &Decision{ID: "0004", Status: "open"}, ReviewStillValid, "2030-01-01")
+	assert.EqualError(t, err, `only decisions with status "decided" can be reviewed, decision 0004 has status "open"`)
+
+	err = service.Review("model", &Decision{ID: "0004", Status: "decided"}, "fine", "")
+	assert.EqualError(t, err, `invalid review result "fine" (allowed: still-valid, needs-revision)`)
+
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+func TestDueForReview(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	day := func(offset int) string { return time.Now().AddDate(0, 0, offset).Format(DateLayout) }
+	decisions := []Decision{
+		{ID: "0001", Status: "decided", ReviewBy: day(5)},
+		{ID: "0002", Status: "decided", ReviewBy: day(-3)},
+		{ID: "0003", Status: "decided", ReviewBy: day(40)},
+		{ID: "0004", Status: "superseded", ReviewBy: day(-10)},
+		{ID: "0005", Status: "decided"},
+		{ID: "0006", Status: "decided", ReviewBy: day(0)},
+	}
+
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+	mockRepo.On("LoadAllByIndex", "model").Return(decisions, nil)
+
+	due, err := service.DueForReview("model", 0)
+	assert.NoError(t, err)
+	assert.Len(t, due, 2)
+	assert.Equal(t, "0002", due[0].Decision.ID)
+	assert.Equal(t, -3, due[0].DaysLeft)
+	assert.Equal(t, "0006", due[1].Decision.ID)
+	assert.Equal(t, 0, due[1].DaysLeft)
+
+	due, err = service.DueForReview("model", 30)
+	assert.NoError(t, err)
+	assert.Len(t, due, 3)
+	assert.Equal(t, "0001", due[2].Decision.ID)
+	assert.Equal(t, 5, due[2].DaysLeft)
+}
+
+func TestNext(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decisions := []Decision{
+		{ID: "0001", Status: "open", Links: Links{Precedes: []string{"0002"}}},
+		{ID: "0002", Status: "open", Links: Links{Succeeds: []string{"0001"}}},
+	}
+
+	mockRepo.On("LoadSettings", "model").Return(DefaultModelSettings(), nil)
+	mockRepo.On("LoadAllByIndex", "model").Return(decisions, nil)
+
+	readiness, err := service.Next("model")
+	assert.NoError(t, err)
+	assert.Equal(t, "0001", readiness.Ready[0].ID)
+	assert.Equal(t, "0002", readiness.Blocked[0].Decision.ID)
+
+	plan, err := service.Plan("model")
+	assert.NoError(t, err)
+	assert.Len(t, plan.Waves, 2)
+}
+
+func TestReply(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0001", Comments: []Comment{{ID: 1, Author: "al", Comment: "first"}, {ID: 3, Author: "bo", Comment: "second"}}}
+
+	mockRepo.On("Save", "model", decision).Return(nil)
+	mockRepo.On("UpdateSection", "model", "0001", domain.AnchorSectionComments, mock.Anything).Return(nil)
+
+	err := service.Reply("model", decision, 1, "cy", "agreed")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, decision.Comments[2].ID)
+	assert.Equal(t, 1, decision.Comments[2].ReplyTo)
+	assert.Equal(t, "agreed", decision.Comments[2].Comment)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReply_UnknownParent(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	err := service.Reply("model", &Decision{ID: "0001", Comments: []Comment{{ID: 1}}}, 2, "cy", "agreed")
+
+	assert.EqualError(t, err, "cannot reply, comment 2 does not exist")
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+func TestComment_UpgradesLegacyComments(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0001", Comments: []Comment{{Author: "al", Date: "2025-01-01 10:00:00", Comment: "1"}}}
+	content := &DecisionContent{Comments: `<a name="comment-1"></a>1. (2025-01-01 10:00:00) al: looks good`}
+
+	var section []string
+	mockRepo.On("LoadDecisionContent", "model", "0001").Return(content, nil)
+	mockRepo.On("Save", "model", decision).Return(nil)
+	mockRepo.On("UpdateSection", "model", "0001", domain.AnchorSectionComments, mock.Anything).Run(func(args mock.Arguments) {
+		section = args.Get(3).([]string)
+	}).Return(nil)
+
+	err := service.Comment("model", decision, "bo", "thanks")
+
+	assert.NoError(t, err)
+	assert.Equal(t, Comment{ID: 1, Author: "al", Date: "2025-01-01 10:00:00", Comment: "looks good"}, decision.Comments[0])
+	assert.Equal(t, 2, decision.Comments[1].ID)
+	assert.Equal(t, `<a name="comment-1"></a>1. (2025-01-01 10:00:00) al: looks good`, section[0])
+	assert.Len(t, section, 3)
+}
+
+func TestEditComment(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0001", Comments: []Comment{{ID: 1, Author: "al", Comment: "frist"}}}
+
+	mockRepo.On("Save", "model", decision).Return(nil)
+	mockRepo.On("UpdateSection", "model", "0001", domain.AnchorSectionComments, mock.Anything).Return(nil)
+
+	err := service.EditComment("model", decision, 1, "first")
+	assert.NoError(t, err)
+	assert.Equal(t, "first", decision.Comments[0].Comment)
+	assert.NotEmpty(t, decision.Comments[0].Edited)
+
+	err = service.EditComment("model", decision, 2, "first")
+	assert.EqualError(t, err, "comment 2 does not exist")
+
+	err = service.EditComment("model", decision, 1, " ")
+	assert.EqualError(t, err, "comment text must not be empty")
+}
+
+func TestDeleteComment(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	decision := &Decision{ID: "0001", Comments: []Comment{{ID: 1, Comment: "first"}, {ID: 2, Comment: "reply", ReplyTo: 1}}}
+
+	mockRepo.On("Save", "model", decision).Return(nil)
+	mockRepo.On("UpdateSection", "model", "0001", domain.AnchorSectionComments, mock.Anything).Return(nil)
+	mockRepo.On("RemoveSection", "model", "0001", domain.AnchorSectionComments).Return(nil)
+
+	err := service.DeleteComment("model", decision, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []Comment{{ID: 2, Comment: "reply"}}, decision.Comments)
+	mockRepo.AssertNotCalled(t, "RemoveSection", "model", "0001", domain.AnchorSectionComments)
+
+	err = service.DeleteComment("model", decision, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, decision.Comments)
+	mockRepo.AssertCalled(t, "RemoveSection", "model", "0001", domain.AnchorSectionComments)
+}
+
+func TestSearch_RefreshesChangedDecisions(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	cached := &SearchIndex{Version: searchIndexVersion, Documents: map[string]SearchDocument{
+		"0001": {Stamp: "a", Title: "Message broker", Sections: []SearchSection{{Anchor: "question", Text: "Kafka", Terms: map[string]int{"kafka": 1}}}},
+		"0002": {Stamp: "b", Title: "Old"},
+		"0003": {Stamp: "c", Title: "Archived"},
+	}}
+
+	mockRepo.On("DecisionFileStamps", "model").Return(map[string]string{"0001": "a", "0002": "b2"}, nil)
+	mockRepo.On("LoadSearchIndex", "model").Return(cached, nil)
+	mockRepo.On("LoadAllByIndex", "model").Return([]Decision{{ID: "0001", Title: "Message broker"}, {ID: "0002", Title: "Event store"}}, nil)
+	mockRepo.On("LoadDecisionContent", "model", "0002").Return(&DecisionContent{Question: "Events are stored in Kafka"}, nil)
+	mockRepo.On("SaveSearchIndex", "model", mock.MatchedBy(func(index *SearchIndex) bool {
+		return len(index.Documents) == 2 && index.Documents["0002"].Stamp == "b2" && index.Documents["0002"].Title == "Event store"
+	})).Return(nil)
+
+	results, err := service.Search("model", SearchQuery{Terms: []string{"kafka"}})
+
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	mockRepo.AssertNotCalled(t, "LoadDecisionContent", "model", "0001")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSearch_UnchangedIndexIsNotSaved(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	cached := &SearchIndex{Version: searchIndexVersion, Documents: map[string]SearchDocument{
+		"0001": {Stamp: "a", Title: "Message broker"},
+	}}
+
+	mockRepo.On("DecisionFileStamps", "model").Return(map[string]string{"0001": "a"}, nil)
+	mockRepo.On("LoadSearchIndex", "model").Return(cached, nil)
+
+	results, err := service.Search("model", SearchQuery{Terms: []string{"broker"}})
+
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	mockRepo.AssertNotCalled(t, "SaveSearchIndex", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "LoadAllByIndex", mock.Anything)
+}
+
+func TestSearch_RebuildsOutdatedIndex(t *testing.T) {
+	mockRepo := new(MockDecisionRepository)
+	service := NewDecisionService(mockRepo)
+
+	outdated := &SearchIndex{Version: 0, Documents: map[string]SearchDocument{"0001": {Stamp: "a"}}}
+
+	mockRepo.On("DecisionFileStamps", "model").Return(map[string]string{"0001": "a"}, nil)
+	mockRepo.On("LoadSearchIndex", "model").Return(outdated, nil)
+	mockRepo.On("LoadAllByIndex", "model").Return([]Decision{{ID: "0001", Title: "Message broker"}}, nil)
+	mockRepo.On("LoadDecisionContent", "model", "0001").Return(&DecisionContent{}, nil)
+	mockRepo.On("SaveSearchIndex", "model", mock.MatchedBy(func(index *SearchIndex) bool {
+		return index.Version == searchIndexVersion && index.Documents["0001"].Title == "Message broker"
+	})).Return(nil)
+
+	_, err := service.Search("model", SearchQuery{Terms: []string{"broker"}})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
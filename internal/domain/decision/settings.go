@@ -0,0 +1,49 @@
+package decision
+
+// ModelSettingsFile is the name of the optional per-model settings file located next to the index.
+const ModelSettingsFile = "model.yaml"
+
+// ModelSettings holds the configuration of a single model.
+// ReviewInterval is the default time until a decided decision is due for review, e.g. 6m.
+// LinkTypes declares custom link types by name. Models maps aliases to the paths of other models
+// that can be linked to, relative paths are resolved against the directory of the model. IDs
+// configures the IDs of new decisions.
+type ModelSettings struct {
+	Lifecycle      Lifecycle                  `yaml:"lifecycle"`
+	Fields         map[string]FieldDefinition `yaml:"fields,omitempty"`
+	ReviewInterval string                     `yaml:"review_interval,omitempty"`
+	LinkTypes      map[string]LinkType        `yaml:"link_types,omitempty"`
+	Models         map[string]string          `yaml:"models,omitempty"`
+	IDs            IDScheme                   `yaml:"ids,omitempty"`
+}
+
+func DefaultModelSettings() *ModelSettings {
+	return &ModelSettings{
+		Lifecycle: DefaultLifecycle(),
+	}
+}
+
+// ApplyDefaults fills every setting that was not provided with its default value.
+func (s *ModelSettings) ApplyDefaults() {
+	s.Lifecycle.applyDefaults()
+}
+
+// Validate checks the settings for internal consistency.
+func (s *ModelSettings) Validate() error {
+	if err := s.Lifecycle.Validate(); err != nil {
+		return err
+	}
+	if err := validateFieldDefinitions(s.Fields); err != nil {
+		return err
+	}
+	if err := validateLinkTypes(s.linkTypes()); err != nil {
+		return err
+	}
+	if err := validateModelAliases(s.Models); err != nil {
+		return err
+	}
+	if err := s.IDs.validate(); err != nil {
+		return err
+	}
+	return validateReviewInterval(s.ReviewInterval)
+}
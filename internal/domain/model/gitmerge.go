@@ -0,0 +1,227 @@
+package model
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+)
+
+const indexFileName = "index.yaml"
+
+// MergeResult is the outcome of merging a file of a model with the git merge driver.
+type MergeResult struct {
+	// Collisions holds the IDs both branches used for different new decisions.
+	Collisions []string
+	// Conflicts holds the keys both branches changed differently, or "frontmatter" and "text"
+	// for conflicts marked in the file.
+	Conflicts []string
+	// Index reports whether the file is the index of a model.
+	Index bool
+}
+
+// FileVersion is one version of a decision file passed to the git merge driver. Metadata is nil
+// if the file has no frontmatter or it cannot be parsed, RawMetadata holds its text.
+type FileVersion struct {
+	Metadata    map[string]any
+	RawMetadata string
+	Body        string
+}
+
+// MergeFile merges the changes of both branches to a file of a model and writes the result to
+// oursPath, as expected from a git merge driver. filePath is the path of the file in the
+// repository. The decisions of the index are merged by ID and the frontmatter of decision files
+// by key, the text of decision files line by line. As the index can always be rebuilt from the
+// decision files, conflicts in the index keep our version and do not have to be resolved.
+func (s *ModelServiceImplementation) MergeFile(basePath, oursPath, theirsPath, filePath string) (*MergeResult, error) {
+	if filepath.Base(filePath) == indexFileName {
+		return s.mergeIndexFile(basePath, oursPath, theirsPath)
+	}
+	return s.mergeDecisionFile(basePath, oursPath, theirsPath)
+}
+
+func (s *ModelServiceImplementation) mergeIndexFile(basePath, oursPath, theirsPath string) (*MergeResult, error) {
+	var versions [3]map[string]any
+	for i, path := range []string{basePath, oursPath, theirsPath} {
+		entries, err := s.modelRepo.LoadIndexVersion(path)
+		if err != nil {
+			return nil, err
+		}
+		versions[i] = entries
+	}
+
+	merged, result := mergeIndex(versions[0], versions[1], versions[2])
+	if err := s.modelRepo.SaveIndexVersion(oursPath, merged); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *ModelServiceImplementation) mergeDecisionFile(basePath, oursPath, theirsPath string) (*MergeResult, error) {
+	var versions [3]*FileVersion
+	for i, path := range []string{basePath, oursPath, theirsPath} {
+		version, err := s.modelRepo.LoadFileVersion(path)
+		if err != nil {
+			return nil, err
+		}
+		versions[i] = version
+	}
+	base, ours, theirs := versions[0], versions[1], versions[2]
+
+	result := &MergeResult{}
+	merged := &FileVersion{}
+	var conflicts []string
+	if base.Metadata != nil && ours.Metadata != nil && theirs.Metadata != nil {
+		merged.Metadata = mergeMaps("", base.Metadata, ours.Metadata, theirs.Metadata, &conflicts)
+	}
+
+	// fall back to a line by line merge, which marks the conflicts in the file
+	if merged.Metadata == nil || len(conflicts) > 0 {
+		text, clean, err := s.modelRepo.MergeText(base.RawMetadata, ours.RawMetadata, theirs.RawMetadata)
+		if err != nil {
+			return nil, err
+		}
+		merged.Metadata, merged.RawMetadata = nil, text
+		if !clean {
+			if len(conflicts) == 0 {
+				conflicts = []string{"frontmatter"}
+			}
+			result.Conflicts = append(result.Conflicts, conflicts...)
+		}
+	}
+
+	body, clean, err := s.modelRepo.MergeText(base.Body, ours.Body, theirs.Body)
+	if err != nil {
+		return nil, err
+	}
+	merged.Body = body
+	if !clean {
+		result.Conflicts = append(result.Conflicts, "text")
+	}
+
+	if err := s.modelRepo.SaveFileVersion(oursPath, merged); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// InstallMergeDriver registers the merge driver in the git repository of the model and assigns it
+// to the index and decision files of the model. It returns the path of the attributes file.
+func (s *ModelServiceImplementation) InstallMergeDriver(modelPath string) (string, error) {
+	return s.modelRepo.InstallMergeDriver(modelPath)
+}
+
+// mergeIndex merges the decisions of two versions of an index by ID. A decision added by both
+// branches under the same ID is a collision, the index keeps ours.
+func mergeIndex(base, ours, theirs map[string]any) (map[string]any, *MergeResult) {
+	result := &MergeResult{Index: true}
+	merged := make(map[string]any)
+	for _, id := range unionKeys(base, ours, theirs) {
+		b, o, t := lookup(base, id), lookup(ours, id), lookup(theirs, id)
+		if !b.present && o.present && t.present && !reflect.DeepEqual(o.value, t.value) {
+			result.Collisions = append(result.Collisions, id)
+			merged[id] = o.value
+			continue
+		}
+		if v := mergeValues(id, b, o, t, &result.Conflicts); v.present {
+			merged[id] = v.value
+		}
+	}
+	return merged, result
+}
+
+// version is a value of a key in one version of a document. present is false if the key is
+// missing.
+type version struct {
+	value   any
+	present bool
+}
+
+func lookup(values map[string]any, key string) version {
+	value, present := values[key]
+	return version{value: value, present: present}
+}
+
+func (v version) equals(other version) bool {
+	return v.present == other.present && reflect.DeepEqual(v.value, other.value)
+}
+
+// mergeValues takes the change of the branch that changed a value. If both did, maps are merged
+// by key and lists by item, other values conflict and keep ours.
+func mergeValues(key string, base, ours, theirs version, conflicts *[]string) version {
+	switch {
+	case ours.equals(theirs), base.equals(theirs):
+		return ours
+	case base.equals(ours):
+		return theirs
+	}
+
+	if ours.present && theirs.present {
+		oursMap, oursIsMap := ours.value.(map[string]any)
+		theirsMap, theirsIsMap := theirs.value.(map[string]any)
+		if oursIsMap && theirsIsMap {
+			baseMap, _ := base.value.(map[string]any)
+			return version{value: mergeMaps(key, baseMap, oursMap, theirsMap, conflicts), present: true}
+		}
+
+		oursList, oursIsList := ours.value.([]any)
+		theirsList, theirsIsList := theirs.value.([]any)
+		if oursIsList && theirsIsList {
+			baseList, _ := base.value.([]any)
+			return version{value: mergeLists(baseList, oursList, theirsList), present: true}
+		}
+	}
+
+	*conflicts = append(*conflicts, key)
+	return ours
+}
+
+func mergeMaps(prefix string, base, ours, theirs map[string]any, conflicts *[]string) map[string]any {
+	merged := make(map[string]any)
+	for _, key := range unionKeys(base, ours, theirs) {
+		path := key
+		if prefix != "" {
+			path = fmt.Sprintf("%s.%s", prefix, key)
+		}
+		if v := mergeValues(path, lookup(base, key), lookup(ours, key), lookup(theirs, key), conflicts); v.present {
+			merged[key] = v.value
+		}
+	}
+	return merged
+}
+
+// mergeLists keeps the items of ours in their order, drops the ones theirs removed and appends
+// the ones theirs added.
+func mergeLists(base, ours, theirs []any) []any {
+	contains := func(items []any, item any) bool {
+		return slices.ContainsFunc(items, func(other any) bool { return reflect.DeepEqual(other, item) })
+	}
+
+	merged := []any{}
+	for _, item := range ours {
+		if contains(base, item) && !contains(theirs, item) {
+			continue
+		}
+		merged = append(merged, item)
+	}
+	for _, item := range theirs {
+		if !contains(base, item) && !contains(merged, item) {
+			merged = append(merged, item)
+		}
+	}
+	return merged
+}
+
+func unionKeys(values ...map[string]any) []string {
+	var keys []string
+	for _, m := range values {
+		for key := range m {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
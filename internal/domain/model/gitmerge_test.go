@@ -0,0 +1,128 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/adr/ad-guidance-tool/internal/domain/decision"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMergeIndex_AddsDecisionsOfBothBranches(t *testing.T) {
+	base := map[string]any{"0001": map[string]any{"title": "Base", "status": "open"}}
+	ours := map[string]any{
+		"0001": map[string]any{"title": "Base", "status": "decided"},
+		"0002": map[string]any{"title": "Ours"},
+	}
+	theirs := map[string]any{
+		"0001": map[string]any{"title": "Base", "status": "open", "tags": []any{"db"}},
+		"0003": map[string]any{"title": "Theirs"},
+	}
+
+	merged, result := mergeIndex(base, ours, theirs)
+
+	assert.Equal(t, map[string]any{
+		"0001": map[string]any{"title": "Base", "status": "decided", "tags": []any{"db"}},
+		"0002": map[string]any{"title": "Ours"},
+		"0003": map[string]any{"title": "Theirs"},
+	}, merged)
+	assert.Empty(t, result.Collisions)
+	assert.Empty(t, result.Conflicts)
+	assert.True(t, result.Index)
+}
+
+func TestMergeIndex_ReportsCollisionsAndConflicts(t *testing.T) {
+	base := map[string]any{"0001": map[string]any{"title": "Base"}, "0005": map[string]any{"title": "Gone"}}
+	ours := map[string]any{"0001": map[string]any{"title": "Ours"}, "0002": map[string]any{"title": "Kafka"}}
+	theirs := map[string]any{"0001": map[string]any{"title": "Theirs"}, "0002": map[string]any{"title": "RabbitMQ"}}
+
+	merged, result := mergeIndex(base, ours, theirs)
+
+	assert.Equal(t, map[string]any{"0001": map[string]any{"title": "Ours"}, "0002": map[string]any{"title": "Kafka"}}, merged)
+	assert.Equal(t, []string{"0002"}, result.Collisions)
+	assert.Equal(t, []string{"0001.title"}, result.Conflicts)
+}
+
+func TestMergeMaps_MergesListsByItem(t *testing.T) {
+	base := map[string]any{"tags": []any{"a", "b"}, "links": map[string]any{"precedes": []any{"0002"}}}
+	ours := map[string]any{"tags": []any{"a", "c"}, "links": map[string]any{"precedes": []any{"0002", "0003"}}}
+	theirs := map[string]any{"tags": []any{"b", "d"}, "links": map[string]any{"precedes": []any{"0002", "0004"}}}
+
+	var conflicts []string
+	merged := mergeMaps("", base, ours, theirs, &conflicts)
+
+	assert.Empty(t, conflicts)
+	assert.Equal(t, map[string]any{
+		"tags":  []any{"c", "d"},
+		"links": map[string]any{"precedes": []any{"0002", "0003", "0004"}},
+	}, merged)
+}
+
+func TestMergeFile_Index(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	service := NewModelService(mockModelRepo, new(decision.MockDecisionRepository))
+
+	mockModelRepo.On("LoadIndexVersion", "base").Return(map[string]any{}, nil)
+	mockModelRepo.On("LoadIndexVersion", "ours").Return(map[string]any{"0001": map[string]any{"title": "A"}}, nil)
+	mockModelRepo.On("LoadIndexVersion", "theirs").Return(map[string]any{"0001": map[string]any{"title": "B"}}, nil)
+	mockModelRepo.On("SaveIndexVersion", "ours", map[string]any{"0001": map[string]any{"title": "A"}}).Return(nil)
+
+	result, err := service.MergeFile("base", "ours", "theirs", "models/platform/index.yaml")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0001"}, result.Collisions)
+	mockModelRepo.AssertExpectations(t)
+}
+
+func TestMergeFile_DecisionMergesFrontmatterByKey(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	service := NewModelService(mockModelRepo, new(decision.MockDecisionRepository))
+
+	mockModelRepo.On("LoadFileVersion", "base").Return(&FileVersion{Metadata: map[string]any{"status": "open"}, Body: "text\n"}, nil)
+	mockModelRepo.On("LoadFileVersion", "ours").Return(&FileVersion{Metadata: map[string]any{"status": "decided"}, Body: "text\n"}, nil)
+	mockModelRepo.On("LoadFileVersion", "theirs").Return(&FileVersion{Metadata: map[string]any{"status": "open", "tags": []any{"db"}}, Body: "more text\n"}, nil)
+	mockModelRepo.On("MergeText", "text\n", "text\n", "more text\n").Return("more text\n", true, nil)
+	mockModelRepo.On("SaveFileVersion", "ours", &FileVersion{
+		Metadata: map[string]any{"status": "decided", "tags": []any{"db"}},
+		Body:     "more text\n",
+	}).Return(nil)
+
+	result, err := service.MergeFile("base", "ours", "theirs", "models/platform/AD0001-a.md")
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Conflicts)
+	mockModelRepo.AssertExpectations(t)
+}
+
+func TestMergeFile_DecisionConflictFallsBackToText(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	service := NewModelService(mockModelRepo, new(decision.MockDecisionRepository))
+
+	mockModelRepo.On("LoadFileVersion", "base").Return(&FileVersion{Metadata: map[string]any{"title": "A"}, RawMetadata: "title: A\n"}, nil)
+	mockModelRepo.On("LoadFileVersion", "ours").Return(&FileVersion{Metadata: map[string]any{"title": "B"}, RawMetadata: "title: B\n"}, nil)
+	mockModelRepo.On("LoadFileVersion", "theirs").Return(&FileVersion{Metadata: map[string]any{"title": "C"}, RawMetadata: "title: C\n"}, nil)
+	mockModelRepo.On("MergeText", "title: A\n", "title: B\n", "title: C\n").Return("<<<<<<< ours\n", false, nil)
+	mockModelRepo.On("MergeText", "", "", "").Return("", true, nil)
+	mockModelRepo.On("SaveFileVersion", "ours", &FileVersion{RawMetadata: "<<<<<<< ours\n"}).Return(nil)
+
+	result, err := service.MergeFile("base", "ours", "theirs", "AD0001-a.md")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"title"}, result.Conflicts)
+	assert.False(t, result.Index)
+	mockModelRepo.AssertExpectations(t)
+}
+
+func TestInstallMergeDriver(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	service := NewModelService(mockModelRepo, new(decision.MockDecisionRepository))
+
+	mockModelRepo.On("InstallMergeDriver", "models/platform").Return(".gitattributes", nil)
+
+	path, err := service.InstallMergeDriver("models/platform")
+
+	assert.NoError(t, err)
+	assert.Equal(t, ".gitattributes", path)
+	mock.AssertExpectationsForObjects(t, mockModelRepo)
+}
@@ -67,6 +67,129 @@ func (_m *MockModelRepository) Exists(modelPath string) bool {
 	return r0
 }
 
+// InstallMergeDriver provides a mock function with given fields: modelPath
+func (_m *MockModelRepository) InstallMergeDriver(modelPath string) (string, error) {
+	ret := _m.Called(modelPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstallMergeDriver")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(modelPath)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(modelPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(modelPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoadFileVersion provides a mock function with given fields: path
+func (_m *MockModelRepository) LoadFileVersion(path string) (*FileVersion, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadFileVersion")
+	}
+
+	var r0 *FileVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*FileVersion, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(string) *FileVersion); ok {
+		r0 = rf(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FileVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoadIndexVersion provides a mock function with given fields: path
+func (_m *MockModelRepository) LoadIndexVersion(path string) (map[string]interface{}, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadIndexVersion")
+	}
+
+	var r0 map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string]interface{}, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string]interface{}); ok {
+		r0 = rf(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeText provides a mock function with given fields: base, ours, theirs
+func (_m *MockModelRepository) MergeText(base string, ours string, theirs string) (string, bool, error) {
+	ret := _m.Called(base, ours, theirs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeText")
+	}
+
+	var r0 string
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (string, bool, error)); ok {
+		return rf(base, ours, theirs)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(base, ours, theirs)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) bool); ok {
+		r1 = rf(base, ours, theirs)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, string) error); ok {
+		r2 = rf(base, ours, theirs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // RebuildIndex provides a mock function with given fields: modelPath, decisions
 func (_m *MockModelRepository) RebuildIndex(modelPath string, decisions []decision.Decision) error {
 	ret := _m.Called(modelPath, decisions)
@@ -85,6 +208,42 @@ func (_m *MockModelRepository) RebuildIndex(modelPath string, decisions []decisi
 	return r0
 }
 
+// SaveFileVersion provides a mock function with given fields: path, version
+func (_m *MockModelRepository) SaveFileVersion(path string, version *FileVersion) error {
+	ret := _m.Called(path, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveFileVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *FileVersion) error); ok {
+		r0 = rf(path, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveIndexVersion provides a mock function with given fields: path, decisions
+func (_m *MockModelRepository) SaveIndexVersion(path string, decisions map[string]interface{}) error {
+	ret := _m.Called(path, decisions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveIndexVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) error); ok {
+		r0 = rf(path, decisions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewMockModelRepository creates a new instance of MockModelRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockModelRepository(t interface {
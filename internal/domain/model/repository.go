@@ -7,4 +7,10 @@ type ModelRepository interface {
 	CreateIndex(modelPath string) error
 	RebuildIndex(modelPath string, decisions []domain.Decision) error
 	Exists(modelPath string) bool
+	LoadIndexVersion(path string) (map[string]any, error)
+	SaveIndexVersion(path string, decisions map[string]any) error
+	LoadFileVersion(path string) (*FileVersion, error)
+	SaveFileVersion(path string, version *FileVersion) error
+	MergeText(base, ours, theirs string) (string, bool, error)
+	InstallMergeDriver(modelPath string) (string, error)
 }
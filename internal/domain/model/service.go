@@ -16,6 +16,9 @@ type ModelService interface {
 	Exists(modelPath string) bool
 	ValidateIndexDataCorrectness(modelPath string) error
 	ValidateDecisionDataCorrectness(modelPath string) error
+	ValidateLinkIntegrity(modelPath string, fix bool) error
+	MergeFile(basePath, oursPath, theirsPath, filePath string) (*MergeResult, error)
+	InstallMergeDriver(modelPath string) (string, error)
 }
 
 type ModelServiceImplementation struct {
@@ -47,7 +50,7 @@ func (s *ModelServiceImplementation) RebuildIndex(modelPath string) error {
 	seen := make(map[string]bool)
 	for _, d := range decisions {
 		if seen[d.ID] {
-			return fmt.Errorf("cannot rebuild index, duplicate decision ID %s detected in directory or sub directories", d.ID)
+			return fmt.Errorf("cannot rebuild index, duplicate decision ID %s detected in directory or sub directories (run 'resolve-collisions' to renumber the newer decision)", d.ID)
 		}
 		seen[d.ID] = true
 	}
@@ -66,7 +69,7 @@ func (s *ModelServiceImplementation) ValidateIndexDataCorrectness(modelPath stri
 	}
 
 	if dupID, ok := findDuplicateID(dataDecisions); ok {
-		return fmt.Errorf("duplicate decision ID found: %s", dupID)
+		return fmt.Errorf("duplicate decision ID found: %s (run 'resolve-collisions' to renumber the newer decision)", dupID)
 	}
 
 	indexDecisions, err := s.decisionRepo.LoadAllByIndex(modelPath)
@@ -116,6 +119,12 @@ func (s *ModelServiceImplementation) ValidateDecisionDataCorrectness(modelPath s
 		return decisions[i].ID < decisions[j].ID
 	})
 
+	settings, err := s.decisionRepo.LoadSettings(modelPath)
+	if err != nil {
+		return fmt.Errorf("failed to load model settings: %w", err)
+	}
+	lifecycle := settings.Lifecycle
+
 	var errorsFound bool
 
 	for _, d := range decisions {
@@ -133,6 +142,21 @@ func (s *ModelServiceImplementation) ValidateDecisionDataCorrectness(modelPath s
 		} else {
 			fmt.Printf("ID %s has valid section tags\n", d.ID)
 		}
+
+		if !lifecycle.HasState(d.Status) {
+			errorsFound = true
+			fmt.Printf("ID %s has unknown status %q (allowed: %s)\n", d.ID, d.Status, strings.Join(lifecycle.States, ", "))
+		}
+
+		for _, problem := range settings.ValidateFields(d) {
+			errorsFound = true
+			fmt.Printf("ID %s has %v\n", d.ID, problem)
+		}
+
+		for _, anchor := range missingChosenOptionAnchors(d, content) {
+			errorsFound = true
+			fmt.Printf("ID %s has a chosen option without anchor: %s\n", d.ID, anchor)
+		}
 	}
 
 	if errorsFound {
@@ -142,6 +166,59 @@ func (s *ModelServiceImplementation) ValidateDecisionDataCorrectness(modelPath s
 	return nil
 }
 
+// ValidateLinkIntegrity reports inconsistent links, including links to missing decisions of other
+// models. With fix, missing reverse entries are added.
+func (s *ModelServiceImplementation) ValidateLinkIntegrity(modelPath string, fix bool) error {
+	decisions, err := s.decisionRepo.LoadAllByData(modelPath)
+	if err != nil {
+		return fmt.Errorf("failed to load decisions: %w", err)
+	}
+
+	settings, err := s.decisionRepo.LoadSettings(modelPath)
+	if err != nil {
+		return fmt.Errorf("failed to load model settings: %w", err)
+	}
+
+	exists := func(path, id string) bool {
+		_, err := s.decisionRepo.LoadById(path, id)
+		return err == nil
+	}
+	problems := append(settings.CheckLinks(decisions), settings.CheckReferences(modelPath, decisions, exists)...)
+	sort.SliceStable(problems, func(i, j int) bool { return decisiondomain.CompareIDs(problems[i].ID, problems[j].ID) < 0 })
+	byID := indexByID(decisions)
+	repaired := make(map[string]decisiondomain.Decision)
+	var errorsFound bool
+
+	for _, problem := range problems {
+		if !fix || problem.Repair == nil {
+			errorsFound = true
+			fmt.Printf("ID %s has %s\n", problem.ID, problem.Problem)
+			continue
+		}
+
+		target, ok := repaired[problem.Repair.ID]
+		if !ok {
+			target = byID[problem.Repair.ID]
+		}
+		problem.Repair.Apply(&target)
+		repaired[target.ID] = target
+		fmt.Printf("ID %s has %s, added the link back to %s\n", problem.ID, problem.Problem, target.ID)
+	}
+
+	for _, id := range uniqueKeys(repaired, nil) {
+		d := repaired[id]
+		if err := s.decisionRepo.Save(modelPath, &d); err != nil {
+			return fmt.Errorf("failed to save decision %s: %w", id, err)
+		}
+	}
+
+	if errorsFound {
+		return fmt.Errorf("validation of links completed with errors")
+	}
+
+	return nil
+}
+
 // Helper methods
 func findDuplicateID(decisions []decisiondomain.Decision) (string, bool) {
 	seen := make(map[string]bool)
@@ -184,7 +261,8 @@ func metadataEqual(a, b decisiondomain.Decision) bool {
 		a.Status == b.Status &&
 		stringSlicesEqualIgnoreNil(a.Tags, b.Tags) &&
 		linksEqual(a.Links, b.Links) &&
-		commentSlicesEqualIgnoreNil(a.Comments, b.Comments)
+		commentSlicesEqualIgnoreNil(a.Comments, b.Comments) &&
+		fieldsEqualIgnoreNil(a.Fields, b.Fields)
 }
 
 // stringSlicesEqualIgnoreNil treats nil and empty slice as equal.
@@ -203,6 +281,14 @@ func commentSlicesEqualIgnoreNil(a, b []decisiondomain.Comment) bool {
 	return reflect.DeepEqual(a, b)
 }
 
+// fieldsEqualIgnoreNil treats nil and empty map as equal.
+func fieldsEqualIgnoreNil(a, b map[string]any) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
 // linksEqual compares Links treating nil and empty slices/maps as equal.
 func linksEqual(a, b decisiondomain.Links) bool {
 	return stringSlicesEqualIgnoreNil(a.Precedes, b.Precedes) &&
@@ -245,3 +331,15 @@ func validateRequiredAnchors(content string) []string {
 	}
 	return missing
 }
+
+// missingChosenOptionAnchors returns the anchors of chosen options that do not exist in the content.
+func missingChosenOptionAnchors(d decisiondomain.Decision, content string) []string {
+	var missing []string
+	for _, number := range d.ChosenOptions {
+		anchor := domain.AnchorForOption(number)
+		if !strings.Contains(content, anchor) {
+			missing = append(missing, anchor)
+		}
+	}
+	return missing
+}
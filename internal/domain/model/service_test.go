@@ -324,11 +324,12 @@ func TestValidateDecisionDataCorrectness_Success(t *testing.T) {
 	}, "\n")
 
 	decisions := []decision.Decision{
-		{ID: "0002"},
-		{ID: "0001"},
+		{ID: "0002", Status: "decided"},
+		{ID: "0001", Status: "open"},
 	}
 
 	mockDecisionRepo.On("LoadAllByIndex", modelPath).Return(decisions, nil)
+	mockDecisionRepo.On("LoadSettings", modelPath).Return(decision.DefaultModelSettings(), nil)
 	mockDecisionRepo.On("LoadDecisionContentRaw", modelPath, "0001").Return(content, nil)
 	mockDecisionRepo.On("LoadDecisionContentRaw", modelPath, "0002").Return(content, nil)
 
@@ -345,7 +346,8 @@ func TestValidateDecisionDataCorrectness_MissingAnchors(t *testing.T) {
 	modelPath := "test/path"
 	content := domain.AnchorForSection(domain.AnchorSectionQuestion) // missing options and criteria
 
-	mockDecisionRepo.On("LoadAllByIndex", modelPath).Return([]decision.Decision{{ID: "d2"}}, nil)
+	mockDecisionRepo.On("LoadAllByIndex", modelPath).Return([]decision.Decision{{ID: "d2", Status: "open"}}, nil)
+	mockDecisionRepo.On("LoadSettings", modelPath).Return(decision.DefaultModelSettings(), nil)
 	mockDecisionRepo.On("LoadDecisionContentRaw", modelPath, "d2").Return(content, nil)
 
 	err := svc.ValidateDecisionDataCorrectness(modelPath)
@@ -354,6 +356,140 @@ func TestValidateDecisionDataCorrectness_MissingAnchors(t *testing.T) {
 	assert.Contains(t, err.Error(), "validation of file contents completed with errors")
 }
 
+func TestValidateDecisionDataCorrectness_InvalidFields(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	mockDecisionRepo := new(decision.MockDecisionRepository)
+	svc := NewModelService(mockModelRepo, mockDecisionRepo)
+
+	modelPath := "test/path"
+	content := strings.Join([]string{
+		domain.AnchorForSection(domain.AnchorSectionQuestion),
+		domain.AnchorForSection(domain.AnchorSectionOptions),
+		domain.AnchorForSection(domain.AnchorSectionCriteria),
+	}, "\n")
+
+	settings := decision.DefaultModelSettings()
+	settings.Fields = map[string]decision.FieldDefinition{
+		"risk":      {Type: decision.FieldTypeString, Values: []string{"low", "high"}, Required: true},
+		"component": {Type: decision.FieldTypeString},
+	}
+
+	mockDecisionRepo.On("LoadAllByIndex", modelPath).Return([]decision.Decision{
+		{ID: "0001", Status: "open", Fields: map[string]any{"risk": "low"}},
+		{ID: "0002", Status: "open", Fields: map[string]any{"risk": "medium"}},
+	}, nil)
+	mockDecisionRepo.On("LoadSettings", modelPath).Return(settings, nil)
+	mockDecisionRepo.On("LoadDecisionContentRaw", modelPath, "0001").Return(content, nil)
+	mockDecisionRepo.On("LoadDecisionContentRaw", modelPath, "0002").Return(content, nil)
+
+	err := svc.ValidateDecisionDataCorrectness(modelPath)
+
+	assert.EqualError(t, err, "validation of file contents completed with errors")
+}
+
+func TestValidateLinkIntegrity_ReportsProblems(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	mockDecisionRepo := new(decision.MockDecisionRepository)
+	svc := NewModelService(mockModelRepo, mockDecisionRepo)
+
+	modelPath := "test/path"
+	mockDecisionRepo.On("LoadAllByData", modelPath).Return([]decision.Decision{
+		{ID: "0001", Links: decision.Links{Precedes: []string{"0002"}}},
+		{ID: "0002"},
+	}, nil)
+	mockDecisionRepo.On("LoadSettings", modelPath).Return(decision.DefaultModelSettings(), nil)
+
+	err := svc.ValidateLinkIntegrity(modelPath, false)
+
+	assert.EqualError(t, err, "validation of links completed with errors")
+	mockDecisionRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestValidateLinkIntegrity_ChecksExternalTargets(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	mockDecisionRepo := new(decision.MockDecisionRepository)
+	svc := NewModelService(mockModelRepo, mockDecisionRepo)
+
+	modelPath := "test/path"
+	settings := decision.DefaultModelSettings()
+	settings.Models = map[string]string{"platform": "/models/platform"}
+	mockDecisionRepo.On("LoadAllByData", modelPath).Return([]decision.Decision{
+		{ID: "0001", Links: decision.Links{Custom: map[string][]string{"depends on": {"platform:0012"}}}},
+	}, nil)
+	mockDecisionRepo.On("LoadSettings", modelPath).Return(settings, nil)
+	mockDecisionRepo.On("LoadById", "/models/platform", "0012").Return(&decision.Decision{ID: "0012"}, nil)
+
+	err := svc.ValidateLinkIntegrity(modelPath, false)
+
+	assert.NoError(t, err)
+	mockDecisionRepo.AssertExpectations(t)
+}
+
+func TestValidateLinkIntegrity_ReportsMissingExternalTarget(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	mockDecisionRepo := new(decision.MockDecisionRepository)
+	svc := NewModelService(mockModelRepo, mockDecisionRepo)
+
+	modelPath := "test/path"
+	settings := decision.DefaultModelSettings()
+	settings.Models = map[string]string{"platform": "/models/platform"}
+	mockDecisionRepo.On("LoadAllByData", modelPath).Return([]decision.Decision{
+		{ID: "0001", Links: decision.Links{Custom: map[string][]string{"depends on": {"platform:0099"}}}},
+	}, nil)
+	mockDecisionRepo.On("LoadSettings", modelPath).Return(settings, nil)
+	mockDecisionRepo.On("LoadById", "/models/platform", "0099").Return(nil, errors.New("not found"))
+
+	err := svc.ValidateLinkIntegrity(modelPath, true)
+
+	assert.EqualError(t, err, "validation of links completed with errors")
+	mockDecisionRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestValidateLinkIntegrity_FixAddsReverseEntries(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	mockDecisionRepo := new(decision.MockDecisionRepository)
+	svc := NewModelService(mockModelRepo, mockDecisionRepo)
+
+	modelPath := "test/path"
+	settings := decision.DefaultModelSettings()
+	settings.LinkTypes = map[string]decision.LinkType{"depends on": {Inverse: "required by"}}
+
+	mockDecisionRepo.On("LoadAllByData", modelPath).Return([]decision.Decision{
+		{ID: "0001", Links: decision.Links{Precedes: []string{"0002"}}},
+		{ID: "0002", Links: decision.Links{Custom: map[string][]string{"depends on": {"0001"}}}},
+	}, nil)
+	mockDecisionRepo.On("LoadSettings", modelPath).Return(settings, nil)
+
+	var saved []decision.Decision
+	mockDecisionRepo.On("Save", modelPath, mock.Anything).Run(func(args mock.Arguments) {
+		saved = append(saved, *args.Get(1).(*decision.Decision))
+	}).Return(nil)
+
+	err := svc.ValidateLinkIntegrity(modelPath, true)
+
+	assert.NoError(t, err)
+	assert.Len(t, saved, 2)
+	assert.Equal(t, []string{"0002"}, saved[0].Links.Custom["required by"])
+	assert.Equal(t, []string{"0001"}, saved[1].Links.Succeeds)
+}
+
+func TestValidateLinkIntegrity_FixLeavesOtherProblems(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	mockDecisionRepo := new(decision.MockDecisionRepository)
+	svc := NewModelService(mockModelRepo, mockDecisionRepo)
+
+	modelPath := "test/path"
+	mockDecisionRepo.On("LoadAllByData", modelPath).Return([]decision.Decision{
+		{ID: "0001", Links: decision.Links{Precedes: []string{"0099"}}},
+	}, nil)
+	mockDecisionRepo.On("LoadSettings", modelPath).Return(decision.DefaultModelSettings(), nil)
+
+	err := svc.ValidateLinkIntegrity(modelPath, true)
+
+	assert.EqualError(t, err, "validation of links completed with errors")
+	mockDecisionRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
 func TestValidateDecisionDataCorrectness_LoadIndexFails(t *testing.T) {
 	mockModelRepo := new(MockModelRepository)
 	mockDecisionRepo := new(decision.MockDecisionRepository)
@@ -375,6 +511,7 @@ func TestValidateDecisionDataCorrectness_LoadContentFails(t *testing.T) {
 
 	modelPath := "test/path"
 	mockDecisionRepo.On("LoadAllByIndex", modelPath).Return([]decision.Decision{{ID: "d3"}}, nil)
+	mockDecisionRepo.On("LoadSettings", modelPath).Return(decision.DefaultModelSettings(), nil)
 	mockDecisionRepo.On("LoadDecisionContentRaw", modelPath, "d3").Return("", errors.New("read error"))
 
 	err := svc.ValidateDecisionDataCorrectness(modelPath)
@@ -382,3 +519,63 @@ func TestValidateDecisionDataCorrectness_LoadContentFails(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to load decision content from files")
 }
+
+func TestValidateDecisionDataCorrectness_UnknownStatus(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	mockDecisionRepo := new(decision.MockDecisionRepository)
+	svc := NewModelService(mockModelRepo, mockDecisionRepo)
+
+	modelPath := "test/path"
+	content := strings.Join([]string{
+		domain.AnchorForSection(domain.AnchorSectionQuestion),
+		domain.AnchorForSection(domain.AnchorSectionOptions),
+		domain.AnchorForSection(domain.AnchorSectionCriteria),
+	}, "\n")
+
+	mockDecisionRepo.On("LoadAllByIndex", modelPath).Return([]decision.Decision{{ID: "0001", Status: "approved"}}, nil)
+	mockDecisionRepo.On("LoadSettings", modelPath).Return(decision.DefaultModelSettings(), nil)
+	mockDecisionRepo.On("LoadDecisionContentRaw", modelPath, "0001").Return(content, nil)
+
+	err := svc.ValidateDecisionDataCorrectness(modelPath)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "validation of file contents completed with errors")
+}
+
+func TestValidateDecisionDataCorrectness_LoadSettingsFails(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	mockDecisionRepo := new(decision.MockDecisionRepository)
+	svc := NewModelService(mockModelRepo, mockDecisionRepo)
+
+	modelPath := "test/path"
+	mockDecisionRepo.On("LoadAllByIndex", modelPath).Return([]decision.Decision{{ID: "0001"}}, nil)
+	mockDecisionRepo.On("LoadSettings", modelPath).Return(nil, errors.New("bad lifecycle"))
+
+	err := svc.ValidateDecisionDataCorrectness(modelPath)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load model settings")
+}
+
+func TestValidateDecisionDataCorrectness_MissingChosenOption(t *testing.T) {
+	mockModelRepo := new(MockModelRepository)
+	mockDecisionRepo := new(decision.MockDecisionRepository)
+	svc := NewModelService(mockModelRepo, mockDecisionRepo)
+
+	modelPath := "test/path"
+	content := strings.Join([]string{
+		domain.AnchorForSection(domain.AnchorSectionQuestion),
+		domain.AnchorForSection(domain.AnchorSectionOptions),
+		"1. " + domain.AnchorForOption(1) + " Caching",
+		domain.AnchorForSection(domain.AnchorSectionCriteria),
+	}, "\n")
+
+	mockDecisionRepo.On("LoadAllByIndex", modelPath).Return([]decision.Decision{{ID: "0001", Status: "decided", ChosenOptions: []int{1, 3}}}, nil)
+	mockDecisionRepo.On("LoadSettings", modelPath).Return(decision.DefaultModelSettings(), nil)
+	mockDecisionRepo.On("LoadDecisionContentRaw", modelPath, "0001").Return(content, nil)
+
+	err := svc.ValidateDecisionDataCorrectness(modelPath)
+
+	assert.EqualError(t, err, "validation of file contents completed with errors")
+	assert.Equal(t, []string{domain.AnchorForOption(3)}, missingChosenOptionAnchors(decision.Decision{ChosenOptions: []int{1, 3}}, content))
+}
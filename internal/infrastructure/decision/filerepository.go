@@ -10,14 +10,34 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
+var sectionAnchorPattern = regexp.MustCompile(`<a name="([^"]+)"></a>`)
+
+// decisionFilenamePattern matches decision files named AD<id>-<slug>.md and captures the ID.
+var decisionFilenamePattern = regexp.MustCompile(`^AD(` + domain.IDPattern + `)-.*\.md$`)
+
+// archiveDir is the folder inside a model that holds archived decisions. Decisions in it are
+// no longer part of the model but their IDs are never reused.
+const archiveDir = "archive"
+
+// renumberSuffix marks the files written by Renumber before they replace the old ones.
+const renumberSuffix = ".renumber"
+
+const (
+	previousOutcomesHeader = "Previous Outcomes"
+	decisionMatrixHeader   = "Decision Matrix"
+)
+
 type FileDecisionRepository struct {
 	config config.ConfigService
 }
@@ -27,14 +47,21 @@ func NewFileDecisionRepository(config config.ConfigService) *FileDecisionReposit
 }
 
 func (r *FileDecisionRepository) Create(modelPath, subFolderPath string, decision *domain.Decision, content *domain.DecisionContent) (*domain.Decision, error) {
-	newID, err := r.generateNextID(modelPath)
+	existing, err := r.ExistingIDs(modelPath)
 	if err != nil {
 		return nil, err
 	}
-	decision.ID = newID
+	if decision.ID == "" {
+		settings, err := r.LoadSettings(modelPath)
+		if err != nil {
+			return nil, err
+		}
+		decision.ID = settings.IDs.Next(existing, time.Now())
+	} else if slices.Contains(existing, decision.ID) {
+		return nil, fmt.Errorf("decision %s already exists in %s", decision.ID, modelPath)
+	}
 
-	slug := strings.ReplaceAll(strings.ToLower(decision.Title), " ", "-")
-	filename := fmt.Sprintf("AD%s-%s.md", decision.ID, slug)
+	filename := decisionFilename(decision.ID, decision.Title)
 	fullPath := filepath.Join(modelPath, filepath.Join(subFolderPath, filename))
 
 	markdown, err := r.composeDecisionFileContent(decision, content)
@@ -69,6 +96,7 @@ func (r *FileDecisionRepository) Save(modelPath string, decision *domain.Decisio
 	if err != nil {
 		return err
 	}
+	dropRemovedFields(decision)
 
 	finalContent := constructMarkdownWithMetaAndBody(mergedMeta, body)
 
@@ -185,6 +213,9 @@ func (r *FileDecisionRepository) LoadAllByData(modelPath string) ([]domain.Decis
 		if err != nil {
 			return fmt.Errorf("error accessing %s: %w", path, err)
 		}
+		if isArchiveDir(modelPath, path, d) {
+			return filepath.SkipDir
+		}
 		if d.IsDir() || filepath.Ext(d.Name()) != ".md" || !isValidDecisionFilename(d.Name()) {
 			return nil
 		}
@@ -224,15 +255,21 @@ func (r *FileDecisionRepository) LoadDecisionContent(modelPath, decisionID strin
 	}
 
 	sections := extractSections(body)
+	content := &domain.DecisionContent{ID: decisionID, Sections: sections}
 
-	return &domain.DecisionContent{
-		ID:       decisionID,
-		Question: stripHeader(sections["question"]),
-		Options:  stripHeader(sections["options"]),
-		Criteria: stripHeader(sections["criteria"]),
-		Outcome:  stripHeader(sections["outcome"]),
-		Comments: stripHeader(sections["comments"]),
-	}, nil
+	bodies := make(map[string]string)
+	for _, sec := range sections {
+		bodies[sec.Anchor] = sec.Body
+	}
+	content.Question = bodies[util.AnchorSectionQuestion]
+	content.Options = bodies[util.AnchorSectionOptions]
+	content.Criteria = bodies[util.AnchorSectionCriteria]
+	content.DecisionMatrix = bodies[util.AnchorSectionDecisionMatrix]
+	content.Outcome = bodies[util.AnchorSectionOutcome]
+	content.PreviousOutcomes = bodies[util.AnchorSectionPreviousOutcomes]
+	content.Comments = bodies[util.AnchorSectionComments]
+
+	return content, nil
 }
 
 func (r *FileDecisionRepository) UpdateSection(modelPath, decisionID, anchorName string, lines []string) error {
@@ -246,7 +283,7 @@ func (r *FileDecisionRepository) UpdateSection(modelPath, decisionID, anchorName
 		return err
 	}
 
-	linesIn := strings.Split(body, "\n")
+	linesIn := addAnchorToHeader(strings.Split(body, "\n"), anchorName)
 	var updated []string
 	var skipping bool
 	var foundAnchor bool
@@ -296,15 +333,334 @@ func (r *FileDecisionRepository) UpdateSection(modelPath, decisionID, anchorName
 	return writeFinalContent(filePath, []byte(metadata), updated)
 }
 
+// RemoveSection deletes a section including its header. A missing section is not an error.
+func (r *FileDecisionRepository) RemoveSection(modelPath, decisionID, anchorName string) error {
+	filePath, err := r.FindDecisionFile(modelPath, decisionID)
+	if err != nil {
+		return err
+	}
+
+	metadata, body, err := getFileParts(filePath)
+	if err != nil {
+		return err
+	}
+
+	anchor := util.AnchorForSection(anchorName)
+	linesIn := addAnchorToHeader(strings.Split(body, "\n"), anchorName)
+	var updated []string
+
+	for i := 0; i < len(linesIn); i++ {
+		if strings.HasPrefix(linesIn[i], "## ") && strings.Contains(linesIn[i], anchor) {
+			for i+1 < len(linesIn) && !strings.HasPrefix(linesIn[i+1], "## ") {
+				i++
+			}
+			continue
+		}
+		updated = append(updated, linesIn[i])
+	}
+
+	return writeFinalContent(filePath, []byte(metadata), updated)
+}
+
 // TODO: call UpdateSection directly and completely remove these two specific section functions
 func (r *FileDecisionRepository) AppendOutcomeSection(modelPath, decisionID, outcome string) error {
 	lines := strings.Split(outcome, "\n")
 	return r.UpdateSection(modelPath, decisionID, util.AnchorSectionOutcome, lines)
 }
 
-func (r *FileDecisionRepository) AppendCommentSection(modelPath, decisionID, commentText string, commentNumber int, author, date string) error {
-	commentLine := util.AnchorForComment(commentNumber, author, date, commentText)
-	return r.UpdateSection(modelPath, decisionID, util.AnchorSectionComments, []string{commentLine})
+// Rename saves the decision and moves its file (and rule file, if any) to the file name derived from its current title.
+// References to the old file name in other decisions of the model are updated as well.
+func (r *FileDecisionRepository) Rename(modelPath string, decision *domain.Decision) error {
+	oldPath, err := r.FindDecisionFile(modelPath, decision.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := r.Save(modelPath, decision); err != nil {
+		return err
+	}
+
+	newPath := filepath.Join(filepath.Dir(oldPath), decisionFilename(decision.ID, decision.Title))
+	if newPath == oldPath {
+		return r.updateRuleHeader(oldPath, decision.ID, decision)
+	}
+	if _, err := os.Stat(newPath); err == nil {
+		return fmt.Errorf("cannot rename decision, file %s already exists", newPath)
+	}
+
+	if err := os.Rename(oldPath, newPath); err != nil {
+		return fmt.Errorf("failed to rename decision file: %w", err)
+	}
+	if err := os.Rename(ruleFilePath(oldPath), ruleFilePath(newPath)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to rename rule file: %w", err)
+	}
+	if err := r.updateRuleHeader(newPath, decision.ID, decision); err != nil {
+		return err
+	}
+
+	return replaceInDecisionFiles(modelPath, filepath.Base(oldPath), filepath.Base(newPath))
+}
+
+// Renumber moves the decision files (and rule files) to their new IDs. ids maps old to new IDs,
+// decisions holds every decision of the model with its new ID and links. All files are read
+// before any is written, so IDs can be swapped. Links to renumbered decision files in the text
+// of the decisions are updated, including the ID at the start of their link text. The index is
+// left to be rebuilt.
+//
+// The new files are first written next to the old ones under temporary names, which are then
+// renamed to the new names. Old files that no longer belong to a decision are removed last, so a
+// failed write leaves the model unchanged.
+func (r *FileDecisionRepository) Renumber(modelPath string, ids map[string]string, decisions []domain.Decision) error {
+	oldIDs := make(map[string]string, len(ids))
+	for from, to := range ids {
+		oldIDs[to] = from
+	}
+
+	type renumberedFile struct {
+		decision domain.Decision
+		oldID    string
+		oldPath  string
+		newPath  string
+		meta     string
+		body     string
+		rule     []byte
+	}
+
+	var files []renumberedFile
+	names := make(map[string]string)
+	for _, d := range decisions {
+		oldID := d.ID
+		if from, ok := oldIDs[d.ID]; ok {
+			oldID = from
+		}
+		oldPath, err := r.FindDecisionFile(modelPath, oldID)
+		if err != nil {
+			return err
+		}
+		meta, body, err := getFileParts(oldPath)
+		if err != nil {
+			return err
+		}
+		rule, err := os.ReadFile(ruleFilePath(oldPath))
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to read rule file: %w", err)
+		}
+
+		newPath := oldPath
+		if oldID != d.ID {
+			newPath = filepath.Join(filepath.Dir(oldPath), "AD"+d.ID+strings.TrimPrefix(filepath.Base(oldPath), "AD"+oldID))
+			names[filepath.Base(oldPath)] = filepath.Base(newPath)
+		}
+		files = append(files, renumberedFile{decision: d, oldID: oldID, oldPath: oldPath, newPath: newPath, meta: meta, body: body, rule: rule})
+	}
+
+	// contents maps the final paths to the content written to them
+	contents := make(map[string][]byte)
+	var paths []string
+	for _, f := range files {
+		mergedMeta, err := r.mergeMetadata(f.meta, &f.decision)
+		if err != nil {
+			return err
+		}
+		contents[f.newPath] = constructMarkdownWithMetaAndBody(mergedMeta, renumberReferences(f.body, ids, names))
+		paths = append(paths, f.newPath)
+		if f.rule != nil {
+			contents[ruleFilePath(f.newPath)] = replaceRuleHeader(f.rule, f.oldID, &f.decision)
+			paths = append(paths, ruleFilePath(f.newPath))
+		}
+	}
+
+	for i, path := range paths {
+		if err := os.WriteFile(path+renumberSuffix, contents[path], 0644); err != nil {
+			for _, written := range paths[:i+1] {
+				os.Remove(written + renumberSuffix)
+			}
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+	for _, path := range paths {
+		if err := os.Rename(path+renumberSuffix, path); err != nil {
+			return fmt.Errorf("failed to replace %s: %w", path, err)
+		}
+	}
+
+	for _, f := range files {
+		if _, ok := contents[f.oldPath]; !ok {
+			if err := os.Remove(f.oldPath); err != nil {
+				return fmt.Errorf("failed to remove decision file: %w", err)
+			}
+		}
+		if _, ok := contents[ruleFilePath(f.oldPath)]; f.rule != nil && !ok {
+			if err := os.Remove(ruleFilePath(f.oldPath)); err != nil {
+				return fmt.Errorf("failed to remove rule file: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// decisionLinkPattern matches markdown links to decision files and captures the link text, the
+// path up to the file name and the file name.
+var decisionLinkPattern = regexp.MustCompile(`\[([^\]\n]*)\]\(([^)\s]*?)(AD` + domain.IDPattern + `-[^)\s/#]*\.md)`)
+
+// renumberReferences updates links to renamed decision files. A link text starting with the old
+// ID of the linked decision, as in the notices of superseded decisions, gets the new ID.
+func renumberReferences(text string, ids, names map[string]string) string {
+	return decisionLinkPattern.ReplaceAllStringFunc(text, func(link string) string {
+		match := decisionLinkPattern.FindStringSubmatch(link)
+		label, path, name := match[1], match[2], match[3]
+		newName, ok := names[name]
+		if !ok {
+			return link
+		}
+		oldID := decisionFilenamePattern.FindStringSubmatch(name)[1]
+		if rest, found := strings.CutPrefix(label, oldID); found && (rest == "" || strings.HasPrefix(rest, " ")) {
+			label = ids[oldID] + rest
+		}
+		return "[" + label + "](" + path + newName
+	})
+}
+
+// MoveDecisionFile moves the decision file at filePath (and its rule file) to the new ID of the
+// decision. Links to the file in the text of all decisions are updated. Unlike FindDecisionFile,
+// the file is addressed by its path, so one of several files with the same ID can be moved.
+func (r *FileDecisionRepository) MoveDecisionFile(modelPath, filePath string, decision *domain.Decision) error {
+	match := decisionFilenamePattern.FindStringSubmatch(filepath.Base(filePath))
+	if match == nil {
+		return fmt.Errorf("%s is not a decision file", filePath)
+	}
+	oldID := match[1]
+	newPath := filepath.Join(filepath.Dir(filePath), "AD"+decision.ID+strings.TrimPrefix(filepath.Base(filePath), "AD"+oldID))
+	if _, err := os.Stat(newPath); err == nil {
+		return fmt.Errorf("file %s already exists", newPath)
+	}
+
+	meta, body, err := getFileParts(filePath)
+	if err != nil {
+		return err
+	}
+	mergedMeta, err := r.mergeMetadata(meta, decision)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(newPath, constructMarkdownWithMetaAndBody(mergedMeta, body), 0644); err != nil {
+		return fmt.Errorf("failed to write decision file: %w", err)
+	}
+	if err := os.Remove(filePath); err != nil {
+		return fmt.Errorf("failed to remove decision file: %w", err)
+	}
+	if err := os.Rename(ruleFilePath(filePath), ruleFilePath(newPath)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to rename rule file: %w", err)
+	}
+	if err := r.updateRuleHeader(newPath, oldID, decision); err != nil {
+		return err
+	}
+
+	ids := map[string]string{oldID: decision.ID}
+	names := map[string]string{filepath.Base(filePath): filepath.Base(newPath)}
+	return filepath.WalkDir(modelPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if isArchiveDir(modelPath, path, d) {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || !isValidDecisionFilename(d.Name()) {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		updated := renumberReferences(string(content), ids, names)
+		if updated == string(content) {
+			return nil
+		}
+		return os.WriteFile(path, []byte(updated), 0644)
+	})
+}
+
+func (r *FileDecisionRepository) Delete(modelPath, decisionID string) error {
+	filePath, err := r.FindDecisionFile(modelPath, decisionID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filePath); err != nil {
+		return fmt.Errorf("failed to delete decision file: %w", err)
+	}
+	if err := os.Remove(ruleFilePath(filePath)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete rule file: %w", err)
+	}
+
+	return r.removeFromIndex(modelPath, decisionID)
+}
+
+// Archive moves a decision (and its rule file, if any) into the model's archive folder,
+// keeping its relative location, and removes it from the index.
+func (r *FileDecisionRepository) Archive(modelPath, decisionID string) error {
+	filePath, err := r.FindDecisionFile(modelPath, decisionID)
+	if err != nil {
+		return err
+	}
+
+	relPath, err := filepath.Rel(modelPath, filePath)
+	if err != nil {
+		return fmt.Errorf("failed to compute relative path: %w", err)
+	}
+	archivePath := filepath.Join(modelPath, archiveDir, relPath)
+
+	if err := os.MkdirAll(filepath.Dir(archivePath), 0755); err != nil {
+		return fmt.Errorf("failed to create archive directory: %w", err)
+	}
+	if err := os.Rename(filePath, archivePath); err != nil {
+		return fmt.Errorf("failed to move decision to archive: %w", err)
+	}
+	if err := os.Rename(ruleFilePath(filePath), ruleFilePath(archivePath)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to move rule file to archive: %w", err)
+	}
+
+	return r.removeFromIndex(modelPath, decisionID)
+}
+
+// SetNotice places a notice block at the top of the decision body, replacing a previous notice with the same anchor.
+func (r *FileDecisionRepository) SetNotice(modelPath, decisionID, anchorName string, lines []string) error {
+	filePath, err := r.FindDecisionFile(modelPath, decisionID)
+	if err != nil {
+		return err
+	}
+
+	metadata, body, err := getFileParts(filePath)
+	if err != nil {
+		return err
+	}
+
+	anchor := fmt.Sprintf(`name="%s"`, anchorName)
+	linesIn := strings.Split(body, "\n")
+	var remaining []string
+
+	for i := 0; i < len(linesIn); i++ {
+		if strings.Contains(linesIn[i], anchor) {
+			// skip the old notice up to the next blank line
+			for i < len(linesIn) && strings.TrimSpace(linesIn[i]) != "" {
+				i++
+			}
+			continue
+		}
+		remaining = append(remaining, linesIn[i])
+	}
+
+	for len(remaining) > 0 && strings.TrimSpace(remaining[0]) == "" {
+		remaining = remaining[1:]
+	}
+
+	updated := []string{""}
+	updated = append(updated, lines...)
+	updated = append(updated, "")
+	updated = append(updated, remaining...)
+
+	return writeFinalContent(filePath, []byte(metadata), updated)
 }
 
 func (r *FileDecisionRepository) OptionExists(modelPath, decisionID, option string) (bool, error) {
@@ -337,12 +693,15 @@ func (r *FileDecisionRepository) ResolveOptionNumber(modelPath, decisionID, opti
 
 func (r *FileDecisionRepository) FindDecisionFile(modelPath, decisionID string) (string, error) {
 	var foundPath string
-	searchPrefix := "AD" + decisionID
+	searchPrefix := "AD" + decisionID + "-"
 
 	err := filepath.WalkDir(modelPath, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
+		if isArchiveDir(modelPath, path, d) {
+			return filepath.SkipDir
+		}
 		if d.IsDir() {
 			return nil
 		}
@@ -362,6 +721,114 @@ func (r *FileDecisionRepository) FindDecisionFile(modelPath, decisionID string)
 	return foundPath, nil
 }
 
+// LoadDecisionFiles returns every active decision file with the given ID, ordered by path.
+func (r *FileDecisionRepository) LoadDecisionFiles(modelPath, decisionID string) ([]domain.DecisionFile, error) {
+	var files []domain.DecisionFile
+	err := filepath.WalkDir(modelPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("error accessing %s: %w", path, err)
+		}
+		if isArchiveDir(modelPath, path, d) {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || !strings.HasPrefix(d.Name(), "AD"+decisionID+"-") || !isValidDecisionFilename(d.Name()) {
+			return nil
+		}
+
+		meta, err := extractMetadataFromFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		if meta == nil {
+			return nil
+		}
+		files = append(files, domain.DecisionFile{Path: path, Decision: *meta})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("decision %s not found", decisionID)
+	}
+	return files, nil
+}
+
+func (r *FileDecisionRepository) LoadSettings(modelPath string) (*domain.ModelSettings, error) {
+	settings := &domain.ModelSettings{}
+
+	content, err := os.ReadFile(filepath.Join(modelPath, domain.ModelSettingsFile))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read model settings: %w", err)
+	}
+	if err == nil {
+		if err := yaml.Unmarshal(content, settings); err != nil {
+			return nil, fmt.Errorf("invalid model settings format in %s: %w", domain.ModelSettingsFile, err)
+		}
+	}
+
+	settings.ApplyDefaults()
+	if err := settings.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid model settings in %s: %w", domain.ModelSettingsFile, err)
+	}
+	return settings, nil
+}
+
+// DecisionFileStamps returns a stamp of the modification time and size of every decision file by ID.
+func (r *FileDecisionRepository) DecisionFileStamps(modelPath string) (map[string]string, error) {
+	stamps := make(map[string]string)
+	err := filepath.WalkDir(modelPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("error accessing %s: %w", path, err)
+		}
+		if isArchiveDir(modelPath, path, d) {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || !isValidDecisionFilename(d.Name()) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return fmt.Errorf("error accessing %s: %w", path, err)
+		}
+		stamps[decisionFilenamePattern.FindStringSubmatch(d.Name())[1]] = fmt.Sprintf("%d-%d", info.ModTime().UnixNano(), info.Size())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stamps, nil
+}
+
+// LoadSearchIndex reads the cached search index. A missing or unreadable cache yields an empty index.
+func (r *FileDecisionRepository) LoadSearchIndex(modelPath string) (*domain.SearchIndex, error) {
+	index := &domain.SearchIndex{}
+
+	content, err := os.ReadFile(filepath.Join(modelPath, domain.SearchIndexFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return index, nil
+		}
+		return nil, fmt.Errorf("failed to read search index: %w", err)
+	}
+	if err := yaml.Unmarshal(content, index); err != nil {
+		return &domain.SearchIndex{}, nil
+	}
+	return index, nil
+}
+
+func (r *FileDecisionRepository) SaveSearchIndex(modelPath string, index *domain.SearchIndex) error {
+	out, err := yaml.Marshal(index)
+	if err != nil {
+		return fmt.Errorf("failed to marshal search index: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(modelPath, domain.SearchIndexFile), out, 0644); err != nil {
+		return fmt.Errorf("failed to write search index: %w", err)
+	}
+	return nil
+}
+
 // Helpers
 func (r *FileDecisionRepository) updateIndex(modelPath string, decision *domain.Decision) error {
 	indexPath := filepath.Join(modelPath, "index.yaml")
@@ -390,6 +857,98 @@ func (r *FileDecisionRepository) updateIndex(modelPath string, decision *domain.
 	return nil
 }
 
+func (r *FileDecisionRepository) removeFromIndex(modelPath, decisionID string) error {
+	indexPath := filepath.Join(modelPath, "index.yaml")
+
+	var data struct {
+		Decisions map[string]domain.Decision `yaml:"decisions"`
+	}
+
+	content, err := os.ReadFile(indexPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read index: %w", err)
+	}
+	if err := yaml.Unmarshal(content, &data); err != nil {
+		return fmt.Errorf("invalid index format: %w", err)
+	}
+	if data.Decisions == nil {
+		data.Decisions = make(map[string]domain.Decision)
+	}
+	delete(data.Decisions, decisionID)
+
+	out, err := yaml.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal index: %w", err)
+	}
+	if err := os.WriteFile(indexPath, out, 0644); err != nil {
+		return fmt.Errorf("failed to write index: %w", err)
+	}
+	return nil
+}
+
+// updateRuleHeader rewrites the adr header of the decision's rule file, if there is one. oldID is
+// the ID the header refers to, which differs from the decision's ID after renumbering.
+func (r *FileDecisionRepository) updateRuleHeader(decisionFilePath, oldID string, decision *domain.Decision) error {
+	rulePath := ruleFilePath(decisionFilePath)
+	content, err := os.ReadFile(rulePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read rule file: %w", err)
+	}
+
+	if err := os.WriteFile(rulePath, replaceRuleHeader(content, oldID, decision), 0644); err != nil {
+		return fmt.Errorf("failed to write rule file: %w", err)
+	}
+	return nil
+}
+
+// replaceRuleHeader gives the adr header of a rule file the current ID and title of the decision.
+func replaceRuleHeader(content []byte, oldID string, decision *domain.Decision) []byte {
+	header := regexp.MustCompile(`(?m)^adr\s+"` + regexp.QuoteMeta(oldID) + `"\s+"[^"\n]*"`)
+	return header.ReplaceAllLiteral(content, []byte(fmt.Sprintf(`adr "%s" "%s"`, decision.ID, decision.Title)))
+}
+
+// replaceInDecisionFiles replaces every occurrence of a text in the decision files of the model.
+func replaceInDecisionFiles(modelPath, oldText, newText string) error {
+	return filepath.WalkDir(modelPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if isArchiveDir(modelPath, path, d) {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || !isValidDecisionFilename(d.Name()) {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if !bytes.Contains(content, []byte(oldText)) {
+			return nil
+		}
+		return os.WriteFile(path, bytes.ReplaceAll(content, []byte(oldText), []byte(newText)), 0644)
+	})
+}
+
+func decisionFilename(id, title string) string {
+	return fmt.Sprintf("AD%s-%s.md", id, slugify(title))
+}
+
+func isArchiveDir(modelPath, path string, d fs.DirEntry) bool {
+	return d.IsDir() && path == filepath.Join(modelPath, archiveDir)
+}
+
+func ruleFilePath(decisionFilePath string) string {
+	return strings.TrimSuffix(decisionFilePath, filepath.Ext(decisionFilePath)) + ".rule"
+}
+
 func getFileParts(filePath string) (metadata string, body string, err error) {
 	content, err := os.ReadFile(filePath)
 	if err != nil {
@@ -414,23 +973,22 @@ func getFileParts(filePath string) (metadata string, body string, err error) {
 	return string(meta), bodyStr, nil
 }
 
-func (r *FileDecisionRepository) generateNextID(modelPath string) (string, error) {
-	maxID := 0
+// ExistingIDs returns the IDs of all decision files of the model, including archived ones.
+func (r *FileDecisionRepository) ExistingIDs(modelPath string) ([]string, error) {
+	var ids []string
 	err := filepath.WalkDir(modelPath, func(path string, d fs.DirEntry, err error) error {
 		if err != nil || d.IsDir() {
 			return err
 		}
-		if strings.HasPrefix(d.Name(), "AD") && strings.HasSuffix(d.Name(), ".md") && len(d.Name()) >= 6 {
-			if id, err := strconv.Atoi(d.Name()[2:6]); err == nil && id > maxID {
-				maxID = id
-			}
+		if match := decisionFilenamePattern.FindStringSubmatch(d.Name()); match != nil {
+			ids = append(ids, match[1])
 		}
 		return nil
 	})
 	if err != nil && !os.IsNotExist(err) {
-		return "", fmt.Errorf("error scanning model dir: %w", err)
+		return nil, fmt.Errorf("error scanning model dir: %w", err)
 	}
-	return fmt.Sprintf("%04d", maxID+1), nil
+	return ids, nil
 }
 
 func (r *FileDecisionRepository) composeDecisionFileContent(d *domain.Decision, content *domain.DecisionContent) ([]byte, error) {
@@ -444,24 +1002,13 @@ func (r *FileDecisionRepository) composeDecisionFileContent(d *domain.Decision,
 	b.WriteString("---\n\n")
 
 	if content != nil {
-		sections := []struct {
-			Anchor      string
-			Header      string
-			Body        string
-			SkipIfEmpty bool
-		}{
-			{"question", r.config.GetQuestionHeader(), content.Question, false},
-			{"options", r.config.GetOptionsHeader(), content.Options, false},
-			{"criteria", r.config.GetCriteriaHeader(), content.Criteria, false},
-			{"outcome", r.config.GetOutcomeHeader(), content.Outcome, true},
-			{"comments", r.config.GetCommentsHeader(), content.Comments, true},
-		}
-
-		for _, sec := range sections {
-			if sec.SkipIfEmpty && strings.TrimSpace(sec.Body) == "" {
-				continue
+		// unknown sections and custom headers of copied decisions are kept
+		for _, sec := range content.OrderedSections() {
+			header := sec.Title
+			if header == "" {
+				header = r.resolveHeader(sec.Anchor)
 			}
-			b.WriteString(fmt.Sprintf("## %s %s\n", util.AnchorForSection(sec.Anchor), sec.Header))
+			b.WriteString(fmt.Sprintf("## %s %s\n", util.AnchorForSection(sec.Anchor), header))
 			b.WriteString(sec.Body + "\n\n")
 		}
 	}
@@ -491,16 +1038,40 @@ func (r *FileDecisionRepository) mergeMetadata(existingMeta string, decision *do
 		return nil, fmt.Errorf("failed to marshal decision to map: %w", err)
 	}
 
+	// fields that are empty are omitted when marshalling, drop them so cleared values do not survive the merge
+	for _, key := range decisionMetadataKeys() {
+		if _, ok := newMap[key]; !ok {
+			delete(existingMap, key)
+		}
+	}
+
 	merged := mergeMaps(existingMap, newMap)
 
+	// custom fields set to nil have been removed
+	for key, value := range decision.Fields {
+		if value == nil {
+			delete(merged, key)
+		}
+	}
+
 	return yaml.Marshal(merged)
 }
 
+// dropRemovedFields deletes the custom fields set to nil so that they do not end up in the index.
+func dropRemovedFields(decision *domain.Decision) {
+	for key, value := range decision.Fields {
+		if value == nil {
+			delete(decision.Fields, key)
+		}
+	}
+}
+
 func unmarshalToMap(data []byte) (map[string]interface{}, error) {
 	var m map[string]interface{}
 	if err := yaml.Unmarshal(data, &m); err != nil {
 		return nil, err
 	}
+	domain.NormalizeDates(m)
 	return m, nil
 }
 
@@ -512,6 +1083,19 @@ func marshalDecisionToMap(d *domain.Decision) (map[string]interface{}, error) {
 	return unmarshalToMap(b)
 }
 
+// decisionMetadataKeys returns the frontmatter keys managed by the Decision type.
+func decisionMetadataKeys() []string {
+	t := reflect.TypeOf(domain.Decision{})
+	keys := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if name != "" && name != "-" {
+			keys = append(keys, name)
+		}
+	}
+	return keys
+}
+
 func mergeMaps(original, updated map[string]interface{}) map[string]interface{} {
 	for k, v := range updated {
 		original[k] = v
@@ -543,8 +1127,7 @@ func slugify(title string) string {
 }
 
 func isValidDecisionFilename(name string) bool {
-	match := regexp.MustCompile(`^AD\d{4}-.*\.md$`)
-	return match.MatchString(name)
+	return decisionFilenamePattern.MatchString(name)
 }
 
 func extractMetadataFromFile(path string) (*domain.Decision, error) {
@@ -565,56 +1148,62 @@ func extractMetadataFromFile(path string) (*domain.Decision, error) {
 	return &decision, nil
 }
 
-func extractSections(body string) map[string]string {
-	lines := strings.Split(body, "\n")
-	sections := make(map[string]string)
-
-	var currentKey string
+// extractSections splits the body into its level two sections in file order. Sections without an
+// anchor are identified by their header text.
+func extractSections(body string) []domain.Section {
+	var sections []domain.Section
+	var current *domain.Section
 	var buffer []string
 
-	for _, line := range lines {
-		if strings.HasPrefix(line, "## ") {
-			if currentKey != "" {
-				sections[currentKey] = strings.Join(buffer, "\n")
-			}
-			currentKey = parseSectionHeader(line)
-			buffer = []string{line}
-		} else if currentKey != "" {
-			buffer = append(buffer, line)
+	flush := func() {
+		if current != nil {
+			current.Body = strings.TrimSpace(strings.Join(buffer, "\n"))
+			sections = append(sections, *current)
 		}
 	}
 
-	if currentKey != "" {
-		sections[currentKey] = strings.Join(buffer, "\n")
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "## ") {
+			flush()
+			anchor, title := parseSectionHeader(line)
+			current = &domain.Section{Anchor: anchor, Title: title}
+			buffer = nil
+		} else if current != nil {
+			buffer = append(buffer, line)
+		}
 	}
+	flush()
 
 	return sections
 }
 
-func stripHeader(section string) string {
-	lines := strings.Split(section, "\n")
-	if len(lines) <= 1 {
-		return ""
+// parseSectionHeader returns the anchor name and the title of a section header line.
+func parseSectionHeader(header string) (anchor, title string) {
+	title = strings.TrimSpace(strings.TrimPrefix(header, "## "))
+	if m := sectionAnchorPattern.FindStringSubmatch(title); m != nil {
+		return m[1], strings.TrimSpace(strings.Replace(title, m[0], "", 1))
 	}
-	return strings.TrimSpace(strings.Join(lines[1:], "\n"))
+	return domain.SectionAnchorFromTitle(title), title
 }
 
-func parseSectionHeader(header string) string {
-	header = strings.ToLower(header)
-	switch {
-	case strings.Contains(header, "question"):
-		return "question"
-	case strings.Contains(header, "options"):
-		return "options"
-	case strings.Contains(header, "criteria"):
-		return "criteria"
-	case strings.Contains(header, "outcome"):
-		return "outcome"
-	case strings.Contains(header, "comment"):
-		return "comments"
-	default:
-		return ""
+// addAnchorToHeader adds the anchor to a section header that is only identified by its text,
+// so that the section can be found by its anchor afterwards.
+func addAnchorToHeader(lines []string, anchorName string) []string {
+	for _, line := range lines {
+		if strings.HasPrefix(line, "## ") && strings.Contains(line, util.AnchorForSection(anchorName)) {
+			return lines
+		}
+	}
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "## ") {
+			continue
+		}
+		if anchor, title := parseSectionHeader(line); anchor == anchorName && !sectionAnchorPattern.MatchString(strings.TrimPrefix(line, "## ")) {
+			lines[i] = "## " + util.AnchorForSection(anchorName) + " " + title
+			break
+		}
 	}
+	return lines
 }
 
 func (r *FileDecisionRepository) resolveHeader(anchor string) string {
@@ -627,10 +1216,15 @@ func (r *FileDecisionRepository) resolveHeader(anchor string) string {
 		return r.config.GetCriteriaHeader()
 	case util.AnchorSectionOutcome:
 		return r.config.GetOutcomeHeader()
+	case util.AnchorSectionPreviousOutcomes:
+		return previousOutcomesHeader
+	case util.AnchorSectionDecisionMatrix:
+		return decisionMatrixHeader
 	case util.AnchorSectionComments:
 		return r.config.GetCommentsHeader()
 	default:
-		return fmt.Sprintf("<a name=\"%s\"></a>", anchor)
+		title := strings.ReplaceAll(anchor, "-", " ")
+		return strings.ToUpper(title[:1]) + title[1:]
 	}
 }
 
@@ -692,7 +1286,7 @@ func extractOptionNumberFromContent(content, option string) (int, error) {
 
 func findSectionInsertIndex(lines []string, newAnchor string) int {
 	// section order
-	sectionOrder := []string{"question", "options", "criteria", "outcome", "comments"}
+	sectionOrder := []string{"question", "options", "criteria", "decision-matrix", "outcome", "previous-outcomes", "comments"}
 
 	// build map of existing anchors and their line numbers
 	anchorLines := map[string]int{}
@@ -704,8 +1298,8 @@ func findSectionInsertIndex(lines []string, newAnchor string) int {
 		}
 	}
 
-	// find where in the canonical order the new anchor belongs
-	newIndex := -1
+	// find where in the canonical order the new anchor belongs, other sections follow the outcome
+	newIndex := slices.Index(sectionOrder, util.AnchorSectionOutcome)
 	for i, anchor := range sectionOrder {
 		if anchor == newAnchor {
 			newIndex = i
@@ -43,7 +43,10 @@ func (r *FileModelRepository) RebuildIndex(modelPath string, decisions []domain.
 		indexData[decision.ID] = decision
 	}
 
-	// marshal and save
+	return writeIndex(indexPath, indexData)
+}
+
+func writeIndex(indexPath string, indexData map[string]domain.Decision) error {
 	yamlOut, err := yaml.Marshal(map[string]interface{}{"decisions": indexData})
 	if err != nil {
 		return err
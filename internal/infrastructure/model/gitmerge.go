@@ -0,0 +1,205 @@
+package model
+
+import (
+	"bytes"
+	domain "github.com/adr/ad-guidance-tool/internal/domain/decision"
+	modeldomain "github.com/adr/ad-guidance-tool/internal/domain/model"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	mergeDriverName    = "adg"
+	mergeDriverCommand = "adg git-merge-driver %O %A %B %P"
+	attributesFile     = ".gitattributes"
+)
+
+// LoadIndexVersion reads the decisions of an index file. An empty file, as passed by git for a
+// file without a common base, has no decisions.
+func (r *FileModelRepository) LoadIndexVersion(path string) (map[string]any, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var data struct {
+		Decisions map[string]any `yaml:"decisions"`
+	}
+	if err := yaml.Unmarshal(content, &data); err != nil {
+		return nil, fmt.Errorf("invalid index format in %s: %w", path, err)
+	}
+	if data.Decisions == nil {
+		data.Decisions = make(map[string]any)
+	}
+	return data.Decisions, nil
+}
+
+// SaveIndexVersion writes the decisions to an index file in the same form as RebuildIndex.
+func (r *FileModelRepository) SaveIndexVersion(path string, decisions map[string]any) error {
+	content, err := yaml.Marshal(decisions)
+	if err != nil {
+		return fmt.Errorf("failed to serialize index: %w", err)
+	}
+	indexData := make(map[string]domain.Decision)
+	if err := yaml.Unmarshal(content, &indexData); err != nil {
+		return fmt.Errorf("failed to serialize index: %w", err)
+	}
+	return writeIndex(path, indexData)
+}
+
+// LoadFileVersion splits a decision file into its frontmatter and text.
+func (r *FileModelRepository) LoadFileVersion(path string) (*modeldomain.FileVersion, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	parts := strings.SplitN(string(content), "---\n", 3)
+	if len(parts) < 3 || parts[0] != "" {
+		return &modeldomain.FileVersion{Body: string(content)}, nil
+	}
+
+	version := &modeldomain.FileVersion{RawMetadata: parts[1], Body: parts[2]}
+	var metadata map[string]any
+	if err := yaml.Unmarshal([]byte(parts[1]), &metadata); err == nil {
+		if metadata == nil {
+			metadata = make(map[string]any)
+		}
+		domain.NormalizeDates(metadata)
+		version.Metadata = metadata
+	}
+	return version, nil
+}
+
+// SaveFileVersion writes a decision file. The frontmatter is written from the metadata if there
+// is any and as text otherwise.
+func (r *FileModelRepository) SaveFileVersion(path string, version *modeldomain.FileVersion) error {
+	metadata := version.RawMetadata
+	if version.Metadata != nil {
+		content, err := yaml.Marshal(version.Metadata)
+		if err != nil {
+			return fmt.Errorf("failed to serialize frontmatter: %w", err)
+		}
+		metadata = string(content)
+	}
+
+	var buf bytes.Buffer
+	if version.Metadata != nil || metadata != "" {
+		buf.WriteString("---\n")
+		buf.WriteString(metadata)
+		buf.WriteString("---\n")
+	}
+	buf.WriteString(version.Body)
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// MergeText merges two texts line by line with git merge-file. It reports whether the merge was
+// clean, conflicts are marked in the text.
+func (r *FileModelRepository) MergeText(base, ours, theirs string) (string, bool, error) {
+	dir, err := os.MkdirTemp("", "adg-merge-")
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	paths := make([]string, 3)
+	for i, text := range []string{ours, base, theirs} {
+		paths[i] = filepath.Join(dir, []string{"ours", "base", "theirs"}[i])
+		if err := os.WriteFile(paths[i], []byte(text), 0644); err != nil {
+			return "", false, fmt.Errorf("failed to write temporary file: %w", err)
+		}
+	}
+
+	cmd := exec.Command("git", "merge-file", "-p", "-L", "ours", "-L", "base", "-L", "theirs", paths[0], paths[1], paths[2])
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	merged, err := cmd.Output()
+
+	// git merge-file exits with the number of conflicts, negative values are errors
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return string(merged), true, nil
+	case errors.As(err, &exitErr) && exitErr.ExitCode() > 0 && exitErr.ExitCode() < 128:
+		return string(merged), false, nil
+	default:
+		return "", false, fmt.Errorf("git merge-file failed: %w %s", err, strings.TrimSpace(stderr.String()))
+	}
+}
+
+// InstallMergeDriver configures the merge driver in the git repository of the model and adds
+// the index and decision files of the model to the .gitattributes file of the repository.
+func (r *FileModelRepository) InstallMergeDriver(modelPath string) (string, error) {
+	topLevel, err := git(modelPath, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", fmt.Errorf("%s is not in a git repository: %w", modelPath, err)
+	}
+	if _, err := git(topLevel, "config", "merge."+mergeDriverName+".name", "adg decision model merge driver"); err != nil {
+		return "", err
+	}
+	if _, err := git(topLevel, "config", "merge."+mergeDriverName+".driver", mergeDriverCommand); err != nil {
+		return "", err
+	}
+
+	absModelPath, err := filepath.Abs(modelPath)
+	if err != nil {
+		return "", err
+	}
+	absModelPath, err = filepath.EvalSymlinks(absModelPath)
+	if err != nil {
+		return "", err
+	}
+	relModelPath, err := filepath.Rel(topLevel, absModelPath)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := filepath.ToSlash(relModelPath) + "/"
+	if relModelPath == "." {
+		prefix = "/"
+	}
+	lines := []string{
+		prefix + "index.yaml merge=" + mergeDriverName,
+		prefix + "**/AD*.md merge=" + mergeDriverName,
+	}
+
+	attributesPath := filepath.Join(topLevel, attributesFile)
+	content, err := os.ReadFile(attributesPath)
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to read %s: %w", attributesPath, err)
+	}
+	existing := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+
+	var buf bytes.Buffer
+	buf.Write(content)
+	if len(content) > 0 && !bytes.HasSuffix(content, []byte("\n")) {
+		buf.WriteString("\n")
+	}
+	for _, line := range lines {
+		if !slices.Contains(existing, line) {
+			buf.WriteString(line + "\n")
+		}
+	}
+	if err := os.WriteFile(attributesPath, buf.Bytes(), 0644); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", attributesPath, err)
+	}
+	return attributesPath, nil
+}
+
+func git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git %s failed: %s", args[0], strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
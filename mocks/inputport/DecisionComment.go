@@ -9,17 +9,53 @@ type DecisionComment struct {
 	mock.Mock
 }
 
-// Comment provides a mock function with given fields: modelPath, id, title, author, comment
-func (_m *DecisionComment) Comment(modelPath string, id string, title string, author string, comment string) error {
-	ret := _m.Called(modelPath, id, title, author, comment)
+// Comment provides a mock function with given fields: modelPath, id, title, author, comment, replyTo
+func (_m *DecisionComment) Comment(modelPath string, id string, title string, author string, comment string, replyTo int) error {
+	ret := _m.Called(modelPath, id, title, author, comment, replyTo)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Comment")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string, string, string) error); ok {
-		r0 = rf(modelPath, id, title, author, comment)
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, int) error); ok {
+		r0 = rf(modelPath, id, title, author, comment, replyTo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteComment provides a mock function with given fields: modelPath, id, title, commentID
+func (_m *DecisionComment) DeleteComment(modelPath string, id string, title string, commentID int) error {
+	ret := _m.Called(modelPath, id, title, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, int) error); ok {
+		r0 = rf(modelPath, id, title, commentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EditComment provides a mock function with given fields: modelPath, id, title, commentID, comment
+func (_m *DecisionComment) EditComment(modelPath string, id string, title string, commentID int, comment string) error {
+	ret := _m.Called(modelPath, id, title, commentID, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, int, string) error); ok {
+		r0 = rf(modelPath, id, title, commentID, comment)
 	} else {
 		r0 = ret.Error(0)
 	}
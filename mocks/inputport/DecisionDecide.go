@@ -9,17 +9,17 @@ type DecisionDecide struct {
 	mock.Mock
 }
 
-// Decide provides a mock function with given fields: modelPath, id, title, option, reason, author, enforceOption
-func (_m *DecisionDecide) Decide(modelPath string, id string, title string, option string, reason string, author string, enforceOption bool) error {
-	ret := _m.Called(modelPath, id, title, option, reason, author, enforceOption)
+// Decide provides a mock function with given fields: modelPath, id, title, options, reason, author, reviewBy, enforceOption, recommend
+func (_m *DecisionDecide) Decide(modelPath string, id string, title string, options []string, reason string, author string, reviewBy string, enforceOption bool, recommend bool) error {
+	ret := _m.Called(modelPath, id, title, options, reason, author, reviewBy, enforceOption, recommend)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Decide")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string, string, string, string, bool) error); ok {
-		r0 = rf(modelPath, id, title, option, reason, author, enforceOption)
+	if rf, ok := ret.Get(0).(func(string, string, string, []string, string, string, string, bool, bool) error); ok {
+		r0 = rf(modelPath, id, title, options, reason, author, reviewBy, enforceOption, recommend)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2,24 +2,28 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	decision "github.com/adr/ad-guidance-tool/internal/domain/decision"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // DecisionEdit is an autogenerated mock type for the DecisionEdit type
 type DecisionEdit struct {
 	mock.Mock
 }
 
-// Edit provides a mock function with given fields: modelPath, id, title, question, options, criteria
-func (_m *DecisionEdit) Edit(modelPath string, id string, title string, question *string, options *[]string, criteria *string) error {
-	ret := _m.Called(modelPath, id, title, question, options, criteria)
+// Edit provides a mock function with given fields: modelPath, id, title, edit
+func (_m *DecisionEdit) Edit(modelPath string, id string, title string, edit decision.ContentEdit) error {
+	ret := _m.Called(modelPath, id, title, edit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Edit")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string, *string, *[]string, *string) error); ok {
-		r0 = rf(modelPath, id, title, question, options, criteria)
+	if rf, ok := ret.Get(0).(func(string, string, string, decision.ContentEdit) error); ok {
+		r0 = rf(modelPath, id, title, edit)
 	} else {
 		r0 = ret.Error(0)
 	}
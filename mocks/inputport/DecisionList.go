@@ -9,17 +9,17 @@ type DecisionList struct {
 	mock.Mock
 }
 
-// ListDecisions provides a mock function with given fields: modelPath, filters, format
-func (_m *DecisionList) ListDecisions(modelPath string, filters map[string][]string, format string) error {
-	ret := _m.Called(modelPath, filters, format)
+// ListDecisions provides a mock function with given fields: modelPath, filters, format, includeSuperseded
+func (_m *DecisionList) ListDecisions(modelPath string, filters map[string][]string, format string, includeSuperseded bool) error {
+	ret := _m.Called(modelPath, filters, format, includeSuperseded)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListDecisions")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, map[string][]string, string) error); ok {
-		r0 = rf(modelPath, filters, format)
+	if rf, ok := ret.Get(0).(func(string, map[string][]string, string, bool) error); ok {
+		r0 = rf(modelPath, filters, format, includeSuperseded)
 	} else {
 		r0 = ret.Error(0)
 	}
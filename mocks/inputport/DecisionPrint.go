@@ -9,17 +9,17 @@ type DecisionPrint struct {
 	mock.Mock
 }
 
-// Print provides a mock function with given fields: modelPath, ids, titles, sections
-func (_m *DecisionPrint) Print(modelPath string, ids []string, titles []string, sections map[string]bool) error {
-	ret := _m.Called(modelPath, ids, titles, sections)
+// Print provides a mock function with given fields: modelPath, ids, titles, sections, format
+func (_m *DecisionPrint) Print(modelPath string, ids []string, titles []string, sections map[string]bool, format string) error {
+	ret := _m.Called(modelPath, ids, titles, sections, format)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Print")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, []string, []string, map[string]bool) error); ok {
-		r0 = rf(modelPath, ids, titles, sections)
+	if rf, ok := ret.Get(0).(func(string, []string, []string, map[string]bool, string) error); ok {
+		r0 = rf(modelPath, ids, titles, sections, format)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -9,17 +9,17 @@ type ModelValidate struct {
 	mock.Mock
 }
 
-// Validate provides a mock function with given fields: modelPath
-func (_m *ModelValidate) Validate(modelPath string) error {
-	ret := _m.Called(modelPath)
+// Validate provides a mock function with given fields: modelPath, fix
+func (_m *ModelValidate) Validate(modelPath string, fix bool) error {
+	ret := _m.Called(modelPath, fix)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Validate")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(modelPath)
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(modelPath, fix)
 	} else {
 		r0 = ret.Error(0)
 	}
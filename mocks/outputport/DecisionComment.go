@@ -9,6 +9,16 @@ type DecisionComment struct {
 	mock.Mock
 }
 
+// CommentDeleted provides a mock function with given fields: decisionID, commentID
+func (_m *DecisionComment) CommentDeleted(decisionID string, commentID int) {
+	_m.Called(decisionID, commentID)
+}
+
+// CommentEdited provides a mock function with given fields: decisionID, commentID
+func (_m *DecisionComment) CommentEdited(decisionID string, commentID int) {
+	_m.Called(decisionID, commentID)
+}
+
 // Commented provides a mock function with given fields: decisionID, author, comment
 func (_m *DecisionComment) Commented(decisionID string, author string, comment string) {
 	_m.Called(decisionID, author, comment)
@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	decision "github.com/adr/ad-guidance-tool/internal/domain/decision"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // DecisionDecide is an autogenerated mock type for the DecisionDecide type
 type DecisionDecide struct {
@@ -14,6 +18,11 @@ func (_m *DecisionDecide) Decided(decisionID string) {
 	_m.Called(decisionID)
 }
 
+// Recommended provides a mock function with given fields: decisionID, ranking
+func (_m *DecisionDecide) Recommended(decisionID string, ranking []decision.OptionScore) {
+	_m.Called(decisionID, ranking)
+}
+
 // NewDecisionDecide creates a new instance of DecisionDecide. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDecisionDecide(t interface {
@@ -13,9 +13,9 @@ type DecisionList struct {
 	mock.Mock
 }
 
-// Listed provides a mock function with given fields: decisions, format
-func (_m *DecisionList) Listed(decisions []decision.Decision, format string) {
-	_m.Called(decisions, format)
+// Listed provides a mock function with given fields: decisions, options, format
+func (_m *DecisionList) Listed(decisions []decision.Decision, options map[string][]decision.Option, format string) {
+	_m.Called(decisions, options, format)
 }
 
 // NewDecisionList creates a new instance of DecisionList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
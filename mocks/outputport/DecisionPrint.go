@@ -13,9 +13,9 @@ type DecisionPrint struct {
 	mock.Mock
 }
 
-// Printed provides a mock function with given fields: content, sections
-func (_m *DecisionPrint) Printed(content []decision.DecisionContent, sections map[string]bool) {
-	_m.Called(content, sections)
+// Printed provides a mock function with given fields: content, links, sections, format
+func (_m *DecisionPrint) Printed(content []decision.DecisionContent, links map[string][]decision.ResolvedLink, sections map[string]bool, format string) {
+	_m.Called(content, links, sections, format)
 }
 
 // NewDecisionPrint creates a new instance of DecisionPrint. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -9,9 +9,9 @@ type ModelValidate struct {
 	mock.Mock
 }
 
-// ModelValidated provides a mock function with given fields: modelName, indexErr, dataErr
-func (_m *ModelValidate) ModelValidated(modelName string, indexErr error, dataErr error) {
-	_m.Called(modelName, indexErr, dataErr)
+// ModelValidated provides a mock function with given fields: modelName, indexErr, dataErr, linkErr
+func (_m *ModelValidate) ModelValidated(modelName string, indexErr error, dataErr error, linkErr error) {
+	_m.Called(modelName, indexErr, dataErr, linkErr)
 }
 
 // NewModelValidate creates a new instance of ModelValidate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -73,6 +73,36 @@ func (_m *DecisionService) AddNew(modelPath string, title string) (*decision.Dec
 	return r0, r1
 }
 
+// Archive provides a mock function with given fields: modelPath, _a1, force
+func (_m *DecisionService) Archive(modelPath string, _a1 *decision.Decision, force bool) ([]string, error) {
+	ret := _m.Called(modelPath, _a1, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, bool) ([]string, error)); ok {
+		return rf(modelPath, _a1, force)
+	}
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, bool) []string); ok {
+		r0 = rf(modelPath, _a1, force)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *decision.Decision, bool) error); ok {
+		r1 = rf(modelPath, _a1, force)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Comment provides a mock function with given fields: modelPath, _a1, author, comment
 func (_m *DecisionService) Comment(modelPath string, _a1 *decision.Decision, author string, comment string) error {
 	ret := _m.Called(modelPath, _a1, author, comment)
@@ -109,17 +139,17 @@ func (_m *DecisionService) Copy(sourceModelPath string, targetPath string, decis
 	return r0
 }
 
-// Decide provides a mock function with given fields: modelPath, _a1, option, rationale, enforceOption
-func (_m *DecisionService) Decide(modelPath string, _a1 *decision.Decision, option string, rationale string, enforceOption bool) error {
-	ret := _m.Called(modelPath, _a1, option, rationale, enforceOption)
+// Decide provides a mock function with given fields: modelPath, _a1, options, rationale, decider, reviewBy, enforceOption
+func (_m *DecisionService) Decide(modelPath string, _a1 *decision.Decision, options []string, rationale string, decider string, reviewBy string, enforceOption bool) error {
+	ret := _m.Called(modelPath, _a1, options, rationale, decider, reviewBy, enforceOption)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Decide")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, *decision.Decision, string, string, bool) error); ok {
-		r0 = rf(modelPath, _a1, option, rationale, enforceOption)
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, []string, string, string, string, bool) error); ok {
+		r0 = rf(modelPath, _a1, options, rationale, decider, reviewBy, enforceOption)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -127,17 +157,101 @@ func (_m *DecisionService) Decide(modelPath string, _a1 *decision.Decision, opti
 	return r0
 }
 
-// Edit provides a mock function with given fields: modelPath, _a1, question, options, criteria
-func (_m *DecisionService) Edit(modelPath string, _a1 *decision.Decision, question *string, options *[]string, criteria *string) error {
-	ret := _m.Called(modelPath, _a1, question, options, criteria)
+// DeleteComment provides a mock function with given fields: modelPath, _a1, commentID
+func (_m *DecisionService) DeleteComment(modelPath string, _a1 *decision.Decision, commentID int) error {
+	ret := _m.Called(modelPath, _a1, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, int) error); ok {
+		r0 = rf(modelPath, _a1, commentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DueForReview provides a mock function with given fields: modelPath, within
+func (_m *DecisionService) DueForReview(modelPath string, within int) ([]decision.DueDecision, error) {
+	ret := _m.Called(modelPath, within)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DueForReview")
+	}
+
+	var r0 []decision.DueDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]decision.DueDecision, error)); ok {
+		return rf(modelPath, within)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []decision.DueDecision); ok {
+		r0 = rf(modelPath, within)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]decision.DueDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(modelPath, within)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Edit provides a mock function with given fields: modelPath, _a1, edit
+func (_m *DecisionService) Edit(modelPath string, _a1 *decision.Decision, edit decision.ContentEdit) error {
+	ret := _m.Called(modelPath, _a1, edit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Edit")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, *decision.Decision, *string, *[]string, *string) error); ok {
-		r0 = rf(modelPath, _a1, question, options, criteria)
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, decision.ContentEdit) error); ok {
+		r0 = rf(modelPath, _a1, edit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EditComment provides a mock function with given fields: modelPath, _a1, commentID, comment
+func (_m *DecisionService) EditComment(modelPath string, _a1 *decision.Decision, commentID int, comment string) error {
+	ret := _m.Called(modelPath, _a1, commentID, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, int, string) error); ok {
+		r0 = rf(modelPath, _a1, commentID, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EditMetadata provides a mock function with given fields: modelPath, _a1, edit
+func (_m *DecisionService) EditMetadata(modelPath string, _a1 *decision.Decision, edit decision.MetadataEdit) error {
+	ret := _m.Called(modelPath, _a1, edit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMetadata")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, decision.MetadataEdit) error); ok {
+		r0 = rf(modelPath, _a1, edit)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -145,9 +259,9 @@ func (_m *DecisionService) Edit(modelPath string, _a1 *decision.Decision, questi
 	return r0
 }
 
-// FilterDecisions provides a mock function with given fields: decisions, filters
-func (_m *DecisionService) FilterDecisions(decisions []decision.Decision, filters map[string][]string) ([]decision.Decision, error) {
-	ret := _m.Called(decisions, filters)
+// FilterDecisions provides a mock function with given fields: modelPath, decisions, filters
+func (_m *DecisionService) FilterDecisions(modelPath string, decisions []decision.Decision, filters map[string][]string) ([]decision.Decision, error) {
+	ret := _m.Called(modelPath, decisions, filters)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FilterDecisions")
@@ -155,19 +269,19 @@ func (_m *DecisionService) FilterDecisions(decisions []decision.Decision, filter
 
 	var r0 []decision.Decision
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]decision.Decision, map[string][]string) ([]decision.Decision, error)); ok {
-		return rf(decisions, filters)
+	if rf, ok := ret.Get(0).(func(string, []decision.Decision, map[string][]string) ([]decision.Decision, error)); ok {
+		return rf(modelPath, decisions, filters)
 	}
-	if rf, ok := ret.Get(0).(func([]decision.Decision, map[string][]string) []decision.Decision); ok {
-		r0 = rf(decisions, filters)
+	if rf, ok := ret.Get(0).(func(string, []decision.Decision, map[string][]string) []decision.Decision); ok {
+		r0 = rf(modelPath, decisions, filters)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]decision.Decision)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]decision.Decision, map[string][]string) error); ok {
-		r1 = rf(decisions, filters)
+	if rf, ok := ret.Get(1).(func(string, []decision.Decision, map[string][]string) error); ok {
+		r1 = rf(modelPath, decisions, filters)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -323,6 +437,36 @@ func (_m *DecisionService) GetDecisionFilePath(modelPath string, decisionID stri
 	return r0, r1
 }
 
+// GetSettings provides a mock function with given fields: modelPath
+func (_m *DecisionService) GetSettings(modelPath string) (*decision.ModelSettings, error) {
+	ret := _m.Called(modelPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSettings")
+	}
+
+	var r0 *decision.ModelSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*decision.ModelSettings, error)); ok {
+		return rf(modelPath)
+	}
+	if rf, ok := ret.Get(0).(func(string) *decision.ModelSettings); ok {
+		r0 = rf(modelPath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*decision.ModelSettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(modelPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Link provides a mock function with given fields: modelPath, source, target, forwardTag, reverseTag
 func (_m *DecisionService) Link(modelPath string, source *decision.Decision, target *decision.Decision, forwardTag string, reverseTag string) error {
 	ret := _m.Called(modelPath, source, target, forwardTag, reverseTag)
@@ -341,6 +485,379 @@ func (_m *DecisionService) Link(modelPath string, source *decision.Decision, tar
 	return r0
 }
 
+// LinkExternal provides a mock function with given fields: modelPath, source, reference, tag
+func (_m *DecisionService) LinkExternal(modelPath string, source *decision.Decision, reference string, tag string) error {
+	ret := _m.Called(modelPath, source, reference, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkExternal")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, string, string) error); ok {
+		r0 = rf(modelPath, source, reference, tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Next provides a mock function with given fields: modelPath
+func (_m *DecisionService) Next(modelPath string) (decision.Readiness, error) {
+	ret := _m.Called(modelPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Next")
+	}
+
+	var r0 decision.Readiness
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (decision.Readiness, error)); ok {
+		return rf(modelPath)
+	}
+	if rf, ok := ret.Get(0).(func(string) decision.Readiness); ok {
+		r0 = rf(modelPath)
+	} else {
+		r0 = ret.Get(0).(decision.Readiness)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(modelPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Plan provides a mock function with given fields: modelPath
+func (_m *DecisionService) Plan(modelPath string) (decision.Plan, error) {
+	ret := _m.Called(modelPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Plan")
+	}
+
+	var r0 decision.Plan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (decision.Plan, error)); ok {
+		return rf(modelPath)
+	}
+	if rf, ok := ret.Get(0).(func(string) decision.Plan); ok {
+		r0 = rf(modelPath)
+	} else {
+		r0 = ret.Get(0).(decision.Plan)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(modelPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RankOptions provides a mock function with given fields: modelPath, _a1
+func (_m *DecisionService) RankOptions(modelPath string, _a1 *decision.Decision) ([]decision.OptionScore, error) {
+	ret := _m.Called(modelPath, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RankOptions")
+	}
+
+	var r0 []decision.OptionScore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision) ([]decision.OptionScore, error)); ok {
+		return rf(modelPath, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision) []decision.OptionScore); ok {
+		r0 = rf(modelPath, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]decision.OptionScore)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *decision.Decision) error); ok {
+		r1 = rf(modelPath, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordRecommendation provides a mock function with given fields: modelPath, _a1, recommended
+func (_m *DecisionService) RecordRecommendation(modelPath string, _a1 *decision.Decision, recommended decision.OptionScore) error {
+	ret := _m.Called(modelPath, _a1, recommended)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordRecommendation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, decision.OptionScore) error); ok {
+		r0 = rf(modelPath, _a1, recommended)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Remove provides a mock function with given fields: modelPath, _a1, force
+func (_m *DecisionService) Remove(modelPath string, _a1 *decision.Decision, force bool) ([]string, error) {
+	ret := _m.Called(modelPath, _a1, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, bool) ([]string, error)); ok {
+		return rf(modelPath, _a1, force)
+	}
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, bool) []string); ok {
+		r0 = rf(modelPath, _a1, force)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *decision.Decision, bool) error); ok {
+		r1 = rf(modelPath, _a1, force)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rename provides a mock function with given fields: modelPath, _a1, newTitle
+func (_m *DecisionService) Rename(modelPath string, _a1 *decision.Decision, newTitle string) error {
+	ret := _m.Called(modelPath, _a1, newTitle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rename")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, string) error); ok {
+		r0 = rf(modelPath, _a1, newTitle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Renumber provides a mock function with given fields: modelPath, order, mapping, dryRun
+func (_m *DecisionService) Renumber(modelPath string, order string, mapping map[string]string, dryRun bool) ([]decision.IDChange, error) {
+	ret := _m.Called(modelPath, order, mapping, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Renumber")
+	}
+
+	var r0 []decision.IDChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, map[string]string, bool) ([]decision.IDChange, error)); ok {
+		return rf(modelPath, order, mapping, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, map[string]string, bool) []decision.IDChange); ok {
+		r0 = rf(modelPath, order, mapping, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]decision.IDChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, map[string]string, bool) error); ok {
+		r1 = rf(modelPath, order, mapping, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reopen provides a mock function with given fields: modelPath, _a1, reason
+func (_m *DecisionService) Reopen(modelPath string, _a1 *decision.Decision, reason string) error {
+	ret := _m.Called(modelPath, _a1, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reopen")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, string) error); ok {
+		r0 = rf(modelPath, _a1, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplaceTags provides a mock function with given fields: modelPath, sourceTags, targetTag
+func (_m *DecisionService) ReplaceTags(modelPath string, sourceTags []string, targetTag string) ([]string, error) {
+	ret := _m.Called(modelPath, sourceTags, targetTag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string, string) ([]string, error)); ok {
+		return rf(modelPath, sourceTags, targetTag)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string, string) []string); ok {
+		r0 = rf(modelPath, sourceTags, targetTag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string, string) error); ok {
+		r1 = rf(modelPath, sourceTags, targetTag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reply provides a mock function with given fields: modelPath, _a1, parentID, author, comment
+func (_m *DecisionService) Reply(modelPath string, _a1 *decision.Decision, parentID int, author string, comment string) error {
+	ret := _m.Called(modelPath, _a1, parentID, author, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reply")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, int, string, string) error); ok {
+		r0 = rf(modelPath, _a1, parentID, author, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResolveCollisions provides a mock function with given fields: modelPath, dryRun
+func (_m *DecisionService) ResolveCollisions(modelPath string, dryRun bool) ([]decision.IDChange, []decision.AmbiguousLink, error) {
+	ret := _m.Called(modelPath, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveCollisions")
+	}
+
+	var r0 []decision.IDChange
+	var r1 []decision.AmbiguousLink
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, bool) ([]decision.IDChange, []decision.AmbiguousLink, error)); ok {
+		return rf(modelPath, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool) []decision.IDChange); ok {
+		r0 = rf(modelPath, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]decision.IDChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool) []decision.AmbiguousLink); ok {
+		r1 = rf(modelPath, dryRun)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]decision.AmbiguousLink)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, bool) error); ok {
+		r2 = rf(modelPath, dryRun)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ResolveLinks provides a mock function with given fields: modelPath, _a1
+func (_m *DecisionService) ResolveLinks(modelPath string, _a1 *decision.Decision) []decision.ResolvedLink {
+	ret := _m.Called(modelPath, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveLinks")
+	}
+
+	var r0 []decision.ResolvedLink
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision) []decision.ResolvedLink); ok {
+		r0 = rf(modelPath, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]decision.ResolvedLink)
+		}
+	}
+
+	return r0
+}
+
+// ResolveReference provides a mock function with given fields: modelPath, target
+func (_m *DecisionService) ResolveReference(modelPath string, target string) (*decision.Decision, error) {
+	ret := _m.Called(modelPath, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveReference")
+	}
+
+	var r0 *decision.Decision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*decision.Decision, error)); ok {
+		return rf(modelPath, target)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *decision.Decision); ok {
+		r0 = rf(modelPath, target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*decision.Decision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(modelPath, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Review provides a mock function with given fields: modelPath, _a1, result, next
+func (_m *DecisionService) Review(modelPath string, _a1 *decision.Decision, result string, next string) error {
+	ret := _m.Called(modelPath, _a1, result, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Review")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, string, string) error); ok {
+		r0 = rf(modelPath, _a1, result, next)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Revise provides a mock function with given fields: modelPath, original
 func (_m *DecisionService) Revise(modelPath string, original *decision.Decision) (*decision.Decision, error) {
 	ret := _m.Called(modelPath, original)
@@ -371,6 +888,90 @@ func (_m *DecisionService) Revise(modelPath string, original *decision.Decision)
 	return r0, r1
 }
 
+// Score provides a mock function with given fields: modelPath, _a1, option, criterion, value
+func (_m *DecisionService) Score(modelPath string, _a1 *decision.Decision, option string, criterion string, value int) error {
+	ret := _m.Called(modelPath, _a1, option, criterion, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Score")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, string, string, int) error); ok {
+		r0 = rf(modelPath, _a1, option, criterion, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Search provides a mock function with given fields: modelPath, query
+func (_m *DecisionService) Search(modelPath string, query decision.SearchQuery) ([]decision.SearchResult, error) {
+	ret := _m.Called(modelPath, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []decision.SearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, decision.SearchQuery) ([]decision.SearchResult, error)); ok {
+		return rf(modelPath, query)
+	}
+	if rf, ok := ret.Get(0).(func(string, decision.SearchQuery) []decision.SearchResult); ok {
+		r0 = rf(modelPath, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]decision.SearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, decision.SearchQuery) error); ok {
+		r1 = rf(modelPath, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetFields provides a mock function with given fields: modelPath, _a1, values
+func (_m *DecisionService) SetFields(modelPath string, _a1 *decision.Decision, values map[string]string) error {
+	ret := _m.Called(modelPath, _a1, values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFields")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, map[string]string) error); ok {
+		r0 = rf(modelPath, _a1, values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Supersede provides a mock function with given fields: modelPath, original, replacement
+func (_m *DecisionService) Supersede(modelPath string, original *decision.Decision, replacement *decision.Decision) error {
+	ret := _m.Called(modelPath, original, replacement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Supersede")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, *decision.Decision) error); ok {
+		r0 = rf(modelPath, original, replacement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Tag provides a mock function with given fields: modelPath, _a1, tag
 func (_m *DecisionService) Tag(modelPath string, _a1 *decision.Decision, tag string) error {
 	ret := _m.Called(modelPath, _a1, tag)
@@ -389,6 +990,60 @@ func (_m *DecisionService) Tag(modelPath string, _a1 *decision.Decision, tag str
 	return r0
 }
 
+// Transition provides a mock function with given fields: modelPath, _a1, status
+func (_m *DecisionService) Transition(modelPath string, _a1 *decision.Decision, status string) error {
+	ret := _m.Called(modelPath, _a1, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, string) error); ok {
+		r0 = rf(modelPath, _a1, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Unlink provides a mock function with given fields: modelPath, source, target, forwardTag, reverseTag
+func (_m *DecisionService) Unlink(modelPath string, source *decision.Decision, target *decision.Decision, forwardTag string, reverseTag string) error {
+	ret := _m.Called(modelPath, source, target, forwardTag, reverseTag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unlink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, *decision.Decision, string, string) error); ok {
+		r0 = rf(modelPath, source, target, forwardTag, reverseTag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Untag provides a mock function with given fields: modelPath, _a1, tag
+func (_m *DecisionService) Untag(modelPath string, _a1 *decision.Decision, tag string) error {
+	ret := _m.Called(modelPath, _a1, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Untag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *decision.Decision, string) error); ok {
+		r0 = rf(modelPath, _a1, tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewDecisionService creates a new instance of DecisionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDecisionService(t interface {
@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	model "github.com/adr/ad-guidance-tool/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // ModelService is an autogenerated mock type for the ModelService type
 type ModelService struct {
@@ -45,6 +49,64 @@ func (_m *ModelService) Exists(modelPath string) bool {
 	return r0
 }
 
+// InstallMergeDriver provides a mock function with given fields: modelPath
+func (_m *ModelService) InstallMergeDriver(modelPath string) (string, error) {
+	ret := _m.Called(modelPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstallMergeDriver")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(modelPath)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(modelPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(modelPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeFile provides a mock function with given fields: basePath, oursPath, theirsPath, filePath
+func (_m *ModelService) MergeFile(basePath string, oursPath string, theirsPath string, filePath string) (*model.MergeResult, error) {
+	ret := _m.Called(basePath, oursPath, theirsPath, filePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeFile")
+	}
+
+	var r0 *model.MergeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*model.MergeResult, error)); ok {
+		return rf(basePath, oursPath, theirsPath, filePath)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *model.MergeResult); ok {
+		r0 = rf(basePath, oursPath, theirsPath, filePath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MergeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(basePath, oursPath, theirsPath, filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RebuildIndex provides a mock function with given fields: modelPath
 func (_m *ModelService) RebuildIndex(modelPath string) error {
 	ret := _m.Called(modelPath)
@@ -99,6 +161,24 @@ func (_m *ModelService) ValidateIndexDataCorrectness(modelPath string) error {
 	return r0
 }
 
+// ValidateLinkIntegrity provides a mock function with given fields: modelPath, fix
+func (_m *ModelService) ValidateLinkIntegrity(modelPath string, fix bool) error {
+	ret := _m.Called(modelPath, fix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateLinkIntegrity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(modelPath, fix)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewModelService creates a new instance of ModelService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewModelService(t interface {